@@ -0,0 +1,8 @@
+data "octopusdeploy_build_information" "website" {
+  package_id = "Website"
+  version    = "1.2.3"
+}
+
+output "website_commit" {
+  value = data.octopusdeploy_build_information.website.vcs_commit_number
+}
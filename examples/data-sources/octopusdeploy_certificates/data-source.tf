@@ -5,3 +5,12 @@ data "octopusdeploy_certificates" "example" {
   skip         = 5
   take         = 100
 }
+
+data "octopusdeploy_certificates" "all" {}
+
+output "expiring_certificates" {
+  value = {
+    for certificate in data.octopusdeploy_certificates.all.certificates : certificate.name => certificate.not_after
+    if certificate.replaced_by == "" && timecmp(certificate.not_after, timeadd(plantimestamp(), "720h")) < 0
+  }
+}
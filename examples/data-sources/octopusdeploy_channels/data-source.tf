@@ -4,3 +4,8 @@ data "octopusdeploy_channels" "example" {
   skip         = 5
   take         = 100
 }
+
+data "octopusdeploy_channels" "hotfix" {
+  project_id   = "Projects-123"
+  partial_name = "Hotfix"
+}
@@ -0,0 +1,24 @@
+data "octopusdeploy_deployment_process" "example" {
+  project_id = "Projects-123"
+}
+
+locals {
+  package_actions = flatten([
+    for step in data.octopusdeploy_deployment_process.example.step : [
+      for action in step.action : action.slug if length(action.package) > 0
+    ]
+  ])
+}
+
+resource "octopusdeploy_external_feed_create_release_trigger" "example" {
+  name       = "Create a release when a package is pushed"
+  project_id = "Projects-123"
+  channel_id = "Channels-123"
+
+  dynamic "primary_package" {
+    for_each = local.package_actions
+    content {
+      deployment_action_slug = primary_package.value
+    }
+  }
+}
@@ -0,0 +1,11 @@
+data "octopusdeploy_deployment_targets" "example" {
+  communication_styles = ["TentaclePassive"]
+  environments         = ["Environments-123"]
+  health_statuses      = ["Healthy", "HasWarnings"]
+  roles                = ["web-server"]
+  tenant_tags          = ["Regions/North America"]
+}
+
+output "web_server_thumbprints" {
+  value = data.octopusdeploy_deployment_targets.example.deployment_targets[*].thumbprint
+}
@@ -0,0 +1,19 @@
+data "octopusdeploy_deployments" "active" {
+  environments = ["Environments-123"]
+  projects     = ["Projects-123"]
+  task_state   = "Executing"
+  take         = 10
+}
+
+resource "terraform_data" "environment" {
+  lifecycle {
+    precondition {
+      condition     = length(data.octopusdeploy_deployments.active.deployments) == 0
+      error_message = "The environment has deployments that are executing."
+    }
+  }
+}
+
+output "latest_release_version" {
+  value = try(data.octopusdeploy_deployments.active.deployments[0].release_version, null)
+}
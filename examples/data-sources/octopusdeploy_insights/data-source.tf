@@ -0,0 +1,14 @@
+data "octopusdeploy_insights" "production" {
+  project_id      = "Projects-123"
+  environment_ids = ["Environments-123"]
+  from            = "2024-01-01T00:00:00Z"
+  to              = "2024-04-01T00:00:00Z"
+}
+
+output "production_deployment_frequency" {
+  value = data.octopusdeploy_insights.production.deployment_frequency
+}
+
+output "production_lead_time_hours" {
+  value = data.octopusdeploy_insights.production.lead_time_hours
+}
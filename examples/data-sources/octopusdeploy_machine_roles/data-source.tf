@@ -0,0 +1,7 @@
+data "octopusdeploy_machine_roles" "web" {
+  partial_name = "web"
+}
+
+output "web_roles" {
+  value = data.octopusdeploy_machine_roles.web.roles
+}
@@ -0,0 +1,6 @@
+data "octopusdeploy_server" "current" {}
+
+locals {
+  # gate resources that need Octopus Server 2023.2 or later
+  is_2023_2_or_later = data.octopusdeploy_server.current.version_major > 2023 || (data.octopusdeploy_server.current.version_major == 2023 && data.octopusdeploy_server.current.version_minor >= 2)
+}
@@ -0,0 +1,7 @@
+data "octopusdeploy_slug" "project" {
+  name = "Web Application (Production)"
+}
+
+output "project_url" {
+  value = "https://octopus.example.com/app#/Spaces-1/projects/${data.octopusdeploy_slug.project.slug}"
+}
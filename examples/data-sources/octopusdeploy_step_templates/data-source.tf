@@ -0,0 +1,7 @@
+data "octopusdeploy_step_templates" "custom" {
+  name = "Notify Slack"
+}
+
+data "octopusdeploy_step_templates" "community" {
+  website = "https://library.octopus.com/step-templates/99e6f203-3061-4018-9e34-4a3a9c3c3179"
+}
@@ -0,0 +1,7 @@
+data "octopusdeploy_subscriptions" "example" {
+  partial_name = "Failed deployments"
+}
+
+output "failed_deployment_webhooks" {
+  value = data.octopusdeploy_subscriptions.example.subscriptions[*].webhook_uri
+}
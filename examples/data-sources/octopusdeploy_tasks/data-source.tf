@@ -0,0 +1,14 @@
+data "octopusdeploy_tasks" "active_deployments" {
+  is_active  = true
+  name       = "Deploy"
+  project_id = "Projects-123"
+}
+
+resource "terraform_data" "project" {
+  lifecycle {
+    precondition {
+      condition     = length(data.octopusdeploy_tasks.active_deployments.tasks) == 0
+      error_message = "The project has deployments that are queued or executing."
+    }
+  }
+}
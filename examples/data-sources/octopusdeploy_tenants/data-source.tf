@@ -0,0 +1,13 @@
+data "octopusdeploy_tenants" "example" {
+  project_id = "Projects-123"
+  tags       = ["Regions/North America", "Tiers/Gold"]
+}
+
+resource "octopusdeploy_tenant_common_variable" "region" {
+  for_each = { for tenant in data.octopusdeploy_tenants.example.tenants : tenant.id => tenant }
+
+  library_variable_set_id = "LibraryVariableSets-123"
+  template_id             = "0f6cc4e7-1f5a-4d8c-9c8a-4ad2e62f5a8b"
+  tenant_id               = each.key
+  value                   = each.value.name
+}
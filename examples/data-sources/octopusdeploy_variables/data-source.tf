@@ -1,2 +1,8 @@
 data "octopusdeploy_variables" "example" {
+  owner_id = "LibraryVariableSets-123"
+  name     = "Shared.ApiUrl"
+
+  scope {
+    environments = ["Environments-123"]
+  }
 }
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_active_directory_authentication.<name> authentication-directoryservices
@@ -0,0 +1,6 @@
+resource "octopusdeploy_active_directory_authentication" "example" {
+  active_directory_container                  = "OU=Octopus,DC=example,DC=com"
+  allow_forms_authentication_for_domain_users = false
+  authentication_scheme                       = "Negotiate"
+  is_enabled                                  = true
+}
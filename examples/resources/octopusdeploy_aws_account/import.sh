@@ -1 +1,2 @@
-terraform import [options] octopusdeploy_aws_account.<name> <account-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_aws_account.<name> <account-id>
+terraform import [options] octopusdeploy_aws_account.<name> "<account-name-or-slug>"
\ No newline at end of file
@@ -1 +1,2 @@
-terraform import [options] octopusdeploy_azure_web_app_deployment_target.<name> <machine-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_azure_web_app_deployment_target.<name> <machine-id>
+terraform import [options] octopusdeploy_azure_web_app_deployment_target.<name> "<machine-name>"
\ No newline at end of file
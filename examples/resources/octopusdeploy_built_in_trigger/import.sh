@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_built_in_trigger.<name> <project-id>
@@ -0,0 +1,8 @@
+resource "octopusdeploy_built_in_trigger" "example" {
+  project_id = "Projects-123"
+  channel_id = "Channels-123"
+
+  release_creation_package {
+    deployment_action = "Deploy web app"
+  }
+}
@@ -1 +1,2 @@
-terraform import [options] octopusdeploy_certificate.<name> <certificate-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_certificate.<name> <certificate-id>
+terraform import [options] octopusdeploy_certificate.<name> "<certificate-name>"
\ No newline at end of file
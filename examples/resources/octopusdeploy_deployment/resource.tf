@@ -0,0 +1,19 @@
+resource "octopusdeploy_deployment" "example" {
+  environment_id = "Environments-123"
+  release_id     = "Releases-123"
+  tenant_id      = "Tenants-123"
+
+  form_values = {
+    "80b3ad09-eedf-40d6-9b66-cf97f5c0ffee" = "Approved by the change board"
+  }
+
+  specific_machine_ids = ["Machines-123"]
+
+  timeouts {
+    create = "1h"
+  }
+}
+
+output "deployment_task_state" {
+  value = octopusdeploy_deployment.example.task_state
+}
@@ -1 +1,2 @@
-terraform import [options] octopusdeploy_deployment_process.<name> <deployment-process-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_deployment_process.<name> <project-id>
+terraform import [options] octopusdeploy_deployment_process.<name> <project-id>:<branch>
\ No newline at end of file
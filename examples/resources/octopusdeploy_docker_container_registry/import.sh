@@ -1 +1,2 @@
-terraform import [options] octopusdeploy_docker_container_registry.<name> <feed-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_docker_container_registry.<name> <feed-id>
+terraform import [options] octopusdeploy_docker_container_registry.<name> "<feed-name>"
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_entra_id_authentication.<name> authentication-aad
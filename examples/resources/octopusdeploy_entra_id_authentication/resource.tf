@@ -0,0 +1,6 @@
+resource "octopusdeploy_entra_id_authentication" "example" {
+  client_id       = "00000000-0000-0000-0000-000000000000"
+  is_enabled      = true
+  issuer          = "https://login.microsoftonline.com/00000000-0000-0000-0000-000000000000"
+  role_claim_type = "roles"
+}
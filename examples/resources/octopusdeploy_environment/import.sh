@@ -1 +1,2 @@
-terraform import [options] octopusdeploy_environment.<name> <environment-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_environment.<name> <environment-id>
+terraform import [options] octopusdeploy_environment.<name> "<environment-name-or-slug>"
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_event_retention.<name> event-retention
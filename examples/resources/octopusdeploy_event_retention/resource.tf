@@ -0,0 +1,3 @@
+resource "octopusdeploy_event_retention" "example" {
+  retention_days = 365
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_external_feed_create_release_trigger.<name> <project-trigger-id>
@@ -0,0 +1,16 @@
+resource "octopusdeploy_external_feed_create_release_trigger" "example" {
+  name       = "New container image"
+  project_id = "Projects-123"
+  channel_id = "Channels-123"
+
+  # the primary package of the "Deploy container" step
+  primary_package {
+    deployment_action_slug = "deploy-container"
+  }
+
+  # an additional package referenced by the same step
+  package {
+    deployment_action_slug = "deploy-container"
+    package_reference      = "sidecar"
+  }
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_features_configuration.<name> FeaturesConfiguration
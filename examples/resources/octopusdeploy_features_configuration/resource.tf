@@ -0,0 +1,11 @@
+resource "octopusdeploy_features_configuration" "example" {
+  help_sidebar_support_link             = "https://support.example.com/octopus"
+  is_built_in_worker_enabled            = false
+  is_community_action_templates_enabled = true
+  is_help_sidebar_enabled               = true
+
+  # features without a dedicated attribute are set by the name of their property in the features configuration API
+  features = {
+    IsDynamicExtensionsEnabled = false
+  }
+}
@@ -1 +1,2 @@
 terraform import [options] octopusdeploy_gcp_account.<name> <account-id>
+terraform import [options] octopusdeploy_gcp_account.<name> "<account-name-or-slug>"
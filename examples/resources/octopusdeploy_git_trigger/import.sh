@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_git_trigger.<name> <project-trigger-id>
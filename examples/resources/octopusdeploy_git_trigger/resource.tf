@@ -0,0 +1,11 @@
+resource "octopusdeploy_git_trigger" "example" {
+  name       = "Manifests changed"
+  project_id = "Projects-123"
+  channel_id = "Channels-123"
+
+  source {
+    deployment_action_slug = "deploy-manifests"
+    include_file_paths     = ["manifests/**"]
+    exclude_file_paths     = ["manifests/**/*.md"]
+  }
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_google_apps_authentication.<name> authentication-googleapps
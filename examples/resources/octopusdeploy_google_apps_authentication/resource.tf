@@ -0,0 +1,6 @@
+resource "octopusdeploy_google_apps_authentication" "example" {
+  client_id     = "000000000000-example.apps.googleusercontent.com"
+  client_secret = "###########" # get from secure environment/store
+  hosted_domain = "example.com"
+  is_enabled    = true
+}
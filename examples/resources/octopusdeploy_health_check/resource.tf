@@ -0,0 +1,16 @@
+resource "octopusdeploy_health_check" "example" {
+  machine_ids = ["Machines-123", "Machines-456"]
+
+  # run the health check again whenever the certificate is rotated
+  triggers = {
+    certificate_thumbprint = octopusdeploy_certificate.example.thumbprint
+  }
+
+  timeouts {
+    create = "15m"
+  }
+}
+
+resource "octopusdeploy_health_check" "production" {
+  environment_id = "Environments-123"
+}
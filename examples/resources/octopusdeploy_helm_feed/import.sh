@@ -1 +1,2 @@
-terraform import [options] octopusdeploy_helm_feed.<name> <feed-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_helm_feed.<name> <feed-id>
+terraform import [options] octopusdeploy_helm_feed.<name> "<feed-name>"
\ No newline at end of file
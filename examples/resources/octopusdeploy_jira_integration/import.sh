@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_jira_integration.<name> jira-integration
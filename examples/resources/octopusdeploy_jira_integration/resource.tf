@@ -0,0 +1,7 @@
+resource "octopusdeploy_jira_integration" "example" {
+  base_url               = "https://example.atlassian.net"
+  connect_app_password   = "###########" # get from secure environment/store
+  release_note_prefix    = "Release note:"
+  release_notes_password = "###########" # get from secure environment/store
+  release_notes_username = "octopus@example.com"
+}
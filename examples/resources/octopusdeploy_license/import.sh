@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_license.<name> licenses-current
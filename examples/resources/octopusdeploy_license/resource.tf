@@ -0,0 +1,3 @@
+resource "octopusdeploy_license" "example" {
+  license_text = file("${path.module}/octopus.license.xml")
+}
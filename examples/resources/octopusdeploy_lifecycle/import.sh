@@ -1 +1,2 @@
-terraform import [options] octopusdeploy_lifecycle.<name> <lifecycle-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_lifecycle.<name> <lifecycle-id>
+terraform import [options] octopusdeploy_lifecycle.<name> "<lifecycle-name>"
\ No newline at end of file
@@ -3,7 +3,7 @@ resource "octopusdeploy_lifecycle" "example" {
   name        = "Test Lifecycle (OK to Delete)"
 
   release_retention_policy {
-    quantity_to_keep    = 1
+    quantity_to_keep    = 0
     should_keep_forever = true
     unit                = "Days"
   }
@@ -19,7 +19,7 @@ resource "octopusdeploy_lifecycle" "example" {
     name                         = "foo"
 
     release_retention_policy {
-      quantity_to_keep    = 1
+      quantity_to_keep    = 0
       should_keep_forever = true
       unit                = "Days"
     }
@@ -1 +1,2 @@
 terraform import [options] octopusdeploy_listening_tentacle_deployment_target.<name> <machine-id>
+terraform import [options] octopusdeploy_listening_tentacle_deployment_target.<name> "<machine-name>"
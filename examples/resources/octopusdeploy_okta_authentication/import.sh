@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_okta_authentication.<name> authentication-od
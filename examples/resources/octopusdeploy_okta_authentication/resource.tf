@@ -0,0 +1,14 @@
+resource "octopusdeploy_okta_authentication" "example" {
+  client_id       = "0oa0000000000000000"
+  is_enabled      = true
+  issuer          = "https://example.okta.com"
+  role_claim_type = "groups"
+}
+
+# keep the client secret out of state; change the version to send a rotated secret to Octopus
+resource "octopusdeploy_okta_authentication" "write_only" {
+  client_id             = "0oa0000000000000000"
+  client_secret         = var.okta_client_secret
+  client_secret_version = "1"
+  issuer                = "https://example.okta.com"
+}
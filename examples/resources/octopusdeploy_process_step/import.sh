@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_process_step.<name> <project-id>:<step-id>
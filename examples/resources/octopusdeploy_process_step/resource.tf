@@ -0,0 +1,11 @@
+resource "octopusdeploy_process_step" "example" {
+  name         = "Deploy Web App"
+  project_id   = "Projects-123"
+  target_roles = ["web"]
+
+  run_script_action {
+    name          = "Deploy Web App"
+    run_on_server = false
+    script_body   = "Write-Host 'Deploying...'"
+  }
+}
@@ -0,0 +1,4 @@
+terraform import [options] octopusdeploy_process_steps_order.<name> <project-id>
+
+# version-controlled projects also need the git reference holding the process
+terraform import [options] octopusdeploy_process_steps_order.<name> <project-id>:<git-ref>
@@ -0,0 +1,7 @@
+resource "octopusdeploy_process_steps_order" "example" {
+  project_id = "Projects-123"
+  steps = [
+    octopusdeploy_process_step.migrate_database.id,
+    octopusdeploy_process_step.deploy_web_app.id,
+  ]
+}
@@ -1 +1,2 @@
-terraform import [options] octopusdeploy_project.<name> <project-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_project.<name> <project-id>
+terraform import [options] octopusdeploy_project.<name> "<project-name-or-slug>"
\ No newline at end of file
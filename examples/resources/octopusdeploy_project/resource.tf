@@ -10,6 +10,7 @@ resource "octopusdeploy_project" "example" {
   lifecycle_id                         = "Lifecycles-123"
   name                                 = "Development Project (OK to Delete)"
   project_group_id                     = "ProjectGroups-123"
+  slug                                 = "development-project"
   tenanted_deployment_participation    = "TenantedOrUntenanted"
 
   connectivity_policy {
@@ -41,3 +42,38 @@ resource "octopusdeploy_project" "example" {
     }
   }
 }
+
+# a project stored in Git; existing projects are converted when the block is added
+resource "octopusdeploy_project" "version_controlled" {
+  lifecycle_id     = "Lifecycles-123"
+  name             = "Version Controlled Project (OK to Delete)"
+  project_group_id = "ProjectGroups-123"
+
+  git_library_persistence_settings {
+    base_path          = ".octopus/version-controlled-project"
+    default_branch     = "main"
+    git_credential_id  = "GitCredentials-123"
+    protected_branches = ["main", "release/*"]
+    url                = "https://github.com/acme/deployments.git"
+  }
+}
+
+# a project stored in GitHub, authenticating with the Octopus GitHub App rather than a personal access token
+resource "octopusdeploy_project" "github_app" {
+  lifecycle_id     = "Lifecycles-123"
+  name             = "GitHub App Project (OK to Delete)"
+  project_group_id = "ProjectGroups-123"
+
+  git_github_persistence_settings {
+    github_connection_id = "GitHubAppConnections-123"
+    url                  = "https://github.com/acme/deployments.git"
+  }
+}
+
+# a project for a new service, starting from the deployment process and variables of a template project
+resource "octopusdeploy_project" "payments" {
+  clone_from_project_id = "Projects-456"
+  lifecycle_id          = "Lifecycles-123"
+  name                  = "Payments Service (OK to Delete)"
+  project_group_id      = "ProjectGroups-123"
+}
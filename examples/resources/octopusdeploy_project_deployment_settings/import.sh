@@ -0,0 +1,4 @@
+terraform import [options] octopusdeploy_project_deployment_settings.<name> <project-id>
+
+# version-controlled projects also need the git reference holding the settings
+terraform import [options] octopusdeploy_project_deployment_settings.<name> <project-id>:<git-ref>
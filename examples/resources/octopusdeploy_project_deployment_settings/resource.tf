@@ -0,0 +1,35 @@
+resource "octopusdeploy_project_deployment_settings" "example" {
+  default_guided_failure_mode = "On"
+  project_id                  = "Projects-123"
+  release_notes_template      = "Release #{Octopus.Release.Number}"
+
+  connectivity_policy {
+    allow_deployments_to_no_targets = false
+    exclude_unhealthy_targets       = true
+    skip_machine_behavior           = "SkipUnavailableMachines"
+  }
+
+  versioning_strategy {
+    template = "#{Octopus.Version.LastMajor}.#{Octopus.Version.LastMinor}.#{Octopus.Version.NextPatch}"
+  }
+}
+
+# settings of a version-controlled project are read from and committed to a branch
+resource "octopusdeploy_project_deployment_settings" "cac" {
+  commit_message              = "Enable guided failure"
+  default_guided_failure_mode = "On"
+  git_ref                     = "main"
+  project_id                  = "Projects-456"
+}
+
+# release numbers taken from the version of the package deployed by a step
+resource "octopusdeploy_project_deployment_settings" "donor_package" {
+  project_id = "Projects-789"
+
+  versioning_strategy {
+    donor_package {
+      deployment_action = "Deploy Web App"
+      package_reference = ""
+    }
+  }
+}
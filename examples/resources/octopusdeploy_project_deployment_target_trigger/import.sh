@@ -1 +1 @@
-terraform import [options] octopusdeploy_project_deployment_target_trigger.<name> <project_deployment_target_trigger-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_project_deployment_target_trigger.<name> <trigger-id>
\ No newline at end of file
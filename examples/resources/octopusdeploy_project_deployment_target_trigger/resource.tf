@@ -2,4 +2,16 @@ resource "octopusdeploy_project_deployment_target_trigger" "example" {
   name             = "[deployment_target_trigger_name]"
   project_id       = "Projects-123"
   event_categories = ["MachineUnhealthy"]
-}
\ No newline at end of file
+}
+
+resource "octopusdeploy_project_deployment_target_trigger" "run_runbook" {
+  name         = "[deployment_target_trigger_name]"
+  project_id   = "Projects-123"
+  event_groups = ["MachineAvailableForDeployment"]
+  tenant_tags  = ["Hosting/Dedicated"]
+
+  run_runbook_action {
+    environment_ids = ["Environments-123"]
+    runbook_id      = "Runbooks-123"
+  }
+}
@@ -1 +1,2 @@
-terraform import [options] octopusdeploy_project_group.<name> <project_group-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_project_group.<name> <project_group-id>
+terraform import [options] octopusdeploy_project_group.<name> "<project_group-name>"
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_project_library_variable_set.<name> <project-id>:<library-variable-set-id>
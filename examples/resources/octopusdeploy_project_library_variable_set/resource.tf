@@ -0,0 +1,4 @@
+resource "octopusdeploy_project_library_variable_set" "example" {
+  library_variable_set_id = "LibraryVariableSets-123"
+  project_id              = "Projects-123"
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_project_scheduled_trigger.<name> <project-trigger-id>
@@ -0,0 +1,45 @@
+resource "octopusdeploy_project_scheduled_trigger" "nightly" {
+  name       = "Nightly deployment"
+  project_id = "Projects-123"
+  timezone   = "AUS Eastern Standard Time"
+
+  once_daily_schedule {
+    days_of_week = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+    start_time   = "2024-01-01T02:00:00"
+  }
+
+  deploy_new_release_action {
+    destination_environment_id = "Environments-123"
+  }
+}
+
+resource "octopusdeploy_project_scheduled_trigger" "promote" {
+  name       = "Promote to production"
+  project_id = "Projects-123"
+
+  cron_expression_schedule {
+    cron_expression = "0 0 9 * * Mon"
+  }
+
+  deploy_latest_release_action {
+    destination_environment_id = "Environments-456"
+    source_environment_ids     = ["Environments-123"]
+  }
+}
+
+resource "octopusdeploy_project_scheduled_trigger" "monthly" {
+  name       = "Last Friday of the month"
+  project_id = "Projects-123"
+
+  days_per_month_schedule {
+    day_number_of_month   = "L"
+    day_of_week           = "Friday"
+    monthly_schedule_type = "DayOfMonth"
+    start_time            = "2024-01-01T18:00:00"
+  }
+
+  deploy_latest_release_action {
+    destination_environment_id = "Environments-456"
+    should_redeploy            = true
+  }
+}
@@ -0,0 +1,17 @@
+resource "octopusdeploy_release" "example" {
+  project_id    = "Projects-123"
+  channel_id    = "Channels-123"
+  version       = "1.2.3"
+  release_notes = "Fixes the login page."
+
+  package {
+    action_name = "Deploy web app"
+    version     = "1.2.3"
+  }
+
+  package {
+    action_name            = "Deploy web app"
+    package_reference_name = "config"
+    version                = "0.4.0"
+  }
+}
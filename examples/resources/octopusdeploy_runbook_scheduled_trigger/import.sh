@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_runbook_scheduled_trigger.<name> <project-trigger-id>
@@ -0,0 +1,28 @@
+resource "octopusdeploy_runbook_scheduled_trigger" "nightly_maintenance" {
+  name            = "Nightly maintenance"
+  project_id      = "Projects-123"
+  runbook_id      = "Runbooks-123"
+  environment_ids = ["Environments-123", "Environments-456"]
+  timezone        = "GMT Standard Time"
+
+  once_daily_schedule {
+    days_of_week = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"]
+    start_time   = "2024-01-01T01:00:00"
+  }
+}
+
+resource "octopusdeploy_runbook_scheduled_trigger" "hourly_health_check" {
+  name            = "Hourly health check"
+  project_id      = "Projects-123"
+  runbook_id      = "Runbooks-456"
+  environment_ids = ["Environments-456"]
+  tenant_tags     = ["Region/West"]
+
+  continuous_daily_schedule {
+    days_of_week  = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+    interval      = "OnceHourly"
+    hour_interval = 1
+    run_after     = "2024-01-01T08:00:00"
+    run_until     = "2024-01-01T18:00:00"
+  }
+}
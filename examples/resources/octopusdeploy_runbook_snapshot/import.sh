@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_runbook_snapshot.<name> <runbook-snapshot-id>
@@ -0,0 +1,14 @@
+resource "octopusdeploy_runbook_snapshot" "example" {
+  runbook_id = octopusdeploy_runbook.restart.id
+  notes      = "Restarts the app pool as well."
+
+  package {
+    action_name = "Restart web server"
+    version     = "1.0.4"
+  }
+
+  # take and publish a new snapshot whenever the runbook process changes
+  triggers = {
+    process_version = octopusdeploy_runbook_process.restart.version
+  }
+}
@@ -1 +1,2 @@
-terraform import [options] octopusdeploy_script_module.<name> <script-module-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_script_module.<name> <script-module-id>
+terraform import [options] octopusdeploy_script_module.<name> "<script-module-name>"
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_smtp_configuration.<name> smtpconfiguration
@@ -0,0 +1,17 @@
+resource "octopusdeploy_smtp_configuration" "example" {
+  enable_ssl      = true
+  host            = "smtp.example.com"
+  login           = "octopus"
+  password        = "###########" # get from secure environment/store
+  port            = 587
+  send_email_from = "octopus@example.com"
+}
+
+# keep the password out of state; change the version to send a rotated password to Octopus
+resource "octopusdeploy_smtp_configuration" "write_only" {
+  host             = "smtp.example.com"
+  login            = "octopus"
+  password         = var.smtp_password
+  password_version = "1"
+  send_email_from  = "octopus@example.com"
+}
@@ -1 +1,2 @@
-terraform import [options] octopusdeploy_space.<name> <space-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_space.<name> <space-id>
+terraform import [options] octopusdeploy_space.<name> "<space-name-or-slug>"
\ No newline at end of file
@@ -1 +1,2 @@
 terraform import [options] octopusdeploy_ssh_connection_deployment_target.<name> <account-id>
+terraform import [options] octopusdeploy_ssh_connection_deployment_target.<name> "<account-name>"
@@ -0,0 +1,2 @@
+terraform import [options] octopusdeploy_static_worker_pool.<name> <worker-pool-id>
+terraform import [options] octopusdeploy_static_worker_pool.<name> "<worker-pool-name>"
\ No newline at end of file
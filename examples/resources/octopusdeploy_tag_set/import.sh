@@ -1 +1,2 @@
-terraform import [options] octopusdeploy_tag_set.<name> <tag-set-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_tag_set.<name> <tag-set-id>
+terraform import [options] octopusdeploy_tag_set.<name> "<tag-set-name>"
\ No newline at end of file
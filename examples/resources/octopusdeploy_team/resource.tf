@@ -0,0 +1,14 @@
+resource "octopusdeploy_team" "example" {
+  description = "Members of the Deployers group in Active Directory and Microsoft Entra ID."
+  name        = "Deployers"
+
+  external_security_group {
+    display_name = "EXAMPLE\\Deployers"
+    id           = "S-1-5-21-0000000000-0000000000-0000000000-1000"
+  }
+
+  external_security_group {
+    display_name = "Deployers"
+    id           = "00000000-0000-0000-0000-000000000000"
+  }
+}
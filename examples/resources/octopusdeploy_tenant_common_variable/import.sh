@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_tenant_common_variable.<name> <tenant-id>:<library-variable-set-id>:<template-id>
\ No newline at end of file
@@ -1 +1,2 @@
-terraform import [options] octopusdeploy_user.<name> <user-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_user.<name> <user-id>
+terraform import [options] octopusdeploy_user.<name> <username>
\ No newline at end of file
@@ -1 +1,2 @@
-terraform import [options] octopusdeploy_user_role.<name> <user-role-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_user_role.<name> <user-role-id>
+terraform import [options] octopusdeploy_user_role.<name> "<user-role-name>"
\ No newline at end of file
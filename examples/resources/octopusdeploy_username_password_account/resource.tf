@@ -3,3 +3,11 @@ resource "octopusdeploy_username_password_account" "example" {
   password = "###########" # get from secure environment/store
   username = "[username]"
 }
+
+# keep the password out of state; change the version to send a rotated password to Octopus
+resource "octopusdeploy_username_password_account" "versioned" {
+  name             = "Versioned Username-Password Account (OK to Delete)"
+  password         = var.account_password
+  password_version = "1"
+  username         = "[username]"
+}
@@ -1 +1 @@
-terraform import [options] octopusdeploy_variable.<name> <variable-id>
\ No newline at end of file
+terraform import [options] octopusdeploy_variable.<name> <owner-id>:<variable-id>
\ No newline at end of file
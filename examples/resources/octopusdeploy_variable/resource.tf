@@ -7,7 +7,7 @@ resource "octopusdeploy_variable" "amazon_web_services_account_variable" {
 }
 
 # create an Azure service principal account variable
-resource "octopusdeploy_account" "azure_service_principal_account_variable" {
+resource "octopusdeploy_variable" "azure_service_principal_account_variable" {
   owner_id  = "Projects-123"
   type      = "AzureAccount"
   name      = "My Azure Service Principal Account (OK to Delete)"
@@ -39,6 +39,17 @@ resource "octopusdeploy_variable" "sensitive_variable" {
   sensitive_value = "YourSecrets"
 }
 
+# create a Sensitive variable whose value is not stored in state; change the
+# version whenever the secret is rotated to send the new value to Octopus
+resource "octopusdeploy_variable" "write_only_sensitive_variable" {
+  owner_id                = "Projects-123"
+  type                    = "Sensitive"
+  name                    = "My Rotated Secret (OK to Delete)"
+  is_sensitive            = true
+  sensitive_value         = var.database_password
+  sensitive_value_version = "2"
+}
+
 # create a String variable
 resource "octopusdeploy_variable" "string_variable" {
   owner_id  = "Projects-123"
@@ -66,3 +77,56 @@ resource "octopusdeploy_variable" "prompted_variable" {
     label       = "Variable Label"
   }
 }
+
+# create a prompted variable rendered as a drop-down list
+resource "octopusdeploy_variable" "prompted_select_variable" {
+  owner_id = "Projects-123"
+  type     = "String"
+  name     = "My Prompted Select Variable (OK to Delete)"
+  value    = "Blue"
+
+  prompt {
+    description = "The slot to deploy to"
+    is_required = true
+    label       = "Slot"
+
+    display_settings {
+      control_type = "Select"
+
+      select_option {
+        display_name = "Blue"
+        value        = "Blue"
+      }
+
+      select_option {
+        display_name = "Green"
+        value        = "Green"
+      }
+    }
+  }
+}
+
+# create a variable that overrides a value for specific deployment targets
+resource "octopusdeploy_variable" "machine_scoped_variable" {
+  owner_id = "Projects-123"
+  type     = "String"
+  name     = "My Machine Scoped Variable (OK to Delete)"
+  value    = "PerTargetValue"
+
+  scope {
+    environments = ["Environments-123"]
+    machines     = ["Machines-123", "Machines-456"]
+  }
+}
+
+# create a variable that only applies to a runbook
+resource "octopusdeploy_variable" "process_scoped_variable" {
+  owner_id = "Projects-123"
+  type     = "String"
+  name     = "My Runbook Variable (OK to Delete)"
+  value    = "RunbookOnlyValue"
+
+  scope {
+    processes = ["Runbooks-123"]
+  }
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_variables.<name> <owner-id>
@@ -0,0 +1,20 @@
+resource "octopusdeploy_variables" "example" {
+  owner_id = "Projects-123"
+
+  variable {
+    name  = "Database.Name"
+    type  = "String"
+    value = "orders"
+
+    scope {
+      environments = ["Environments-123"]
+    }
+  }
+
+  variable {
+    is_sensitive    = true
+    name            = "Database.Password"
+    sensitive_value = "YourSecrets"
+    type            = "Sensitive"
+  }
+}
@@ -1132,12 +1132,12 @@ func TestLifecycleResource(t *testing.T) {
 			t.Fatal("The lifecycle must be have a tentacle retention unit set to \"Items\" (was \"" + resource.TentacleRetentionPolicy.Unit + "\")")
 		}
 
-		if resource.ReleaseRetentionPolicy.QuantityToKeep != 1 {
-			t.Fatal("The lifecycle must be have a release retention policy of \"1\" (was \"" + fmt.Sprint(resource.ReleaseRetentionPolicy.QuantityToKeep) + "\")")
+		if resource.ReleaseRetentionPolicy.QuantityToKeep != 0 {
+			t.Fatal("The lifecycle must be have a release retention policy of \"0\" (was \"" + fmt.Sprint(resource.ReleaseRetentionPolicy.QuantityToKeep) + "\")")
 		}
 
 		if !resource.ReleaseRetentionPolicy.ShouldKeepForever {
-			t.Log("BUG: The lifecycle must be have a release retention set to keep forever (known bug - the provider creates this field as false)")
+			t.Fatal("The lifecycle must be have a release retention set to keep forever")
 		}
 
 		if resource.ReleaseRetentionPolicy.Unit != "Days" {
@@ -0,0 +1,61 @@
+package configuration
+
+import "github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+
+const (
+	// ActiveDirectoryAuthenticationID is the ID of the configuration section of the Active Directory authentication
+	// provider.
+	ActiveDirectoryAuthenticationID = "authentication-directoryservices"
+
+	// EntraIDAuthenticationID is the ID of the configuration section of the Microsoft Entra ID (formerly Azure AD)
+	// authentication provider.
+	EntraIDAuthenticationID = "authentication-aad"
+
+	// GoogleAppsAuthenticationID is the ID of the configuration section of the Google Apps authentication provider.
+	GoogleAppsAuthenticationID = "authentication-googleapps"
+
+	// OktaAuthenticationID is the ID of the configuration section of the Okta authentication provider.
+	OktaAuthenticationID = "authentication-od"
+)
+
+// ActiveDirectoryAuthentication holds the settings of the Active Directory authentication provider.
+type ActiveDirectoryAuthentication struct {
+	ActiveDirectoryContainer               string `json:"ActiveDirectoryContainer,omitempty"`
+	AllowAutoUserCreation                  bool   `json:"AllowAutoUserCreation"`
+	AllowFormsAuthenticationForDomainUsers bool   `json:"AllowFormsAuthenticationForDomainUsers"`
+	AreSecurityGroupsDisabled              bool   `json:"AreSecurityGroupsDisabled"`
+	AuthenticationScheme                   string `json:"AuthenticationScheme,omitempty"`
+	IsEnabled                              bool   `json:"IsEnabled"`
+}
+
+// OpenIDConnectAuthentication holds the settings shared by the authentication providers that sign users in with
+// OpenID Connect.
+type OpenIDConnectAuthentication struct {
+	AllowAutoUserCreation bool                 `json:"AllowAutoUserCreation"`
+	ClientID              string               `json:"ClientId,omitempty"`
+	ClientSecret          *core.SensitiveValue `json:"ClientSecret,omitempty"`
+	IsEnabled             bool                 `json:"IsEnabled"`
+	Issuer                string               `json:"Issuer,omitempty"`
+}
+
+// EntraIDAuthentication holds the settings of the Microsoft Entra ID (formerly Azure AD) authentication provider.
+type EntraIDAuthentication struct {
+	RoleClaimType string `json:"RoleClaimType,omitempty"`
+
+	OpenIDConnectAuthentication
+}
+
+// GoogleAppsAuthentication holds the settings of the Google Apps authentication provider.
+type GoogleAppsAuthentication struct {
+	HostedDomain string `json:"HostedDomain,omitempty"`
+
+	OpenIDConnectAuthentication
+}
+
+// OktaAuthentication holds the settings of the Okta authentication provider.
+type OktaAuthentication struct {
+	RoleClaimType     string `json:"RoleClaimType,omitempty"`
+	UsernameClaimType string `json:"UsernameClaimType,omitempty"`
+
+	OpenIDConnectAuthentication
+}
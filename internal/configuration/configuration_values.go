@@ -0,0 +1,34 @@
+package configuration
+
+import (
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+)
+
+// GetConfigurationValues returns the values of the configuration section with the given ID. go-octopusdeploy only
+// reads the description of configuration sections, so their values are read through the configuration API directly.
+func GetConfigurationValues[T any](client *client.Client, id string) (*T, error) {
+	resp, err := api.ApiGet(client.Configuration.GetClient(), new(T), getConfigurationValuesPath(client, id))
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*T), nil
+}
+
+// UpdateConfigurationValues replaces the values of the configuration section with the given ID.
+func UpdateConfigurationValues[T any](client *client.Client, id string, values *T) (*T, error) {
+	resp, err := services.ApiUpdate(client.Configuration.GetClient(), values, new(T), getConfigurationValuesPath(client, id))
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*T), nil
+}
+
+func getConfigurationValuesPath(client *client.Client, id string) string {
+	return fmt.Sprintf("%s/%s/values", client.Configuration.GetBasePath(), id)
+}
@@ -0,0 +1,10 @@
+package configuration
+
+// EventRetentionID is the ID of the configuration section that controls how long audit events are kept.
+const EventRetentionID = "event-retention"
+
+// EventRetention holds the number of days that audit events are kept in the database. Older events are moved to the
+// event archive, where they are kept as files.
+type EventRetention struct {
+	EventRetentionDays int `json:"EventRetentionDays"`
+}
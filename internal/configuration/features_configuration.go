@@ -0,0 +1,33 @@
+package configuration
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+)
+
+// FeaturesConfiguration holds the server features of the Octopus instance, keyed by the names of their properties in
+// the API (e.g. IsHelpSidebarEnabled). Newer versions of Octopus add features over time, so the configuration is kept
+// as a whole to send back the features that are not managed by the caller unchanged. go-octopusdeploy does not model
+// the features configuration, so it is read and written through the features configuration API directly.
+type FeaturesConfiguration map[string]interface{}
+
+// GetFeaturesConfiguration returns the server features of the Octopus instance.
+func GetFeaturesConfiguration(client *client.Client) (FeaturesConfiguration, error) {
+	resp, err := api.ApiGet(client.FeaturesConfiguration.GetClient(), new(FeaturesConfiguration), client.FeaturesConfiguration.GetBasePath())
+	if err != nil {
+		return nil, err
+	}
+
+	return *resp.(*FeaturesConfiguration), nil
+}
+
+// UpdateFeaturesConfiguration replaces the server features of the Octopus instance.
+func UpdateFeaturesConfiguration(client *client.Client, featuresConfiguration FeaturesConfiguration) (FeaturesConfiguration, error) {
+	resp, err := services.ApiUpdate(client.FeaturesConfiguration.GetClient(), featuresConfiguration, new(FeaturesConfiguration), client.FeaturesConfiguration.GetBasePath())
+	if err != nil {
+		return nil, err
+	}
+
+	return *resp.(*FeaturesConfiguration), nil
+}
@@ -0,0 +1,22 @@
+package configuration
+
+import "github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+
+// JiraIntegrationID is the ID of the configuration section of the Jira issue tracker extension.
+const JiraIntegrationID = "jira-integration"
+
+// JiraReleaseNoteOptions holds the credentials used to read the Jira issues that are linked to the commits of a build,
+// and the prefix of the comments that become release notes.
+type JiraReleaseNoteOptions struct {
+	Password          *core.SensitiveValue `json:"Password,omitempty"`
+	ReleaseNotePrefix string               `json:"ReleaseNotePrefix,omitempty"`
+	Username          string               `json:"Username,omitempty"`
+}
+
+// JiraIntegration holds the settings of the Jira issue tracker extension.
+type JiraIntegration struct {
+	BaseURL            string                 `json:"BaseUrl,omitempty"`
+	IsEnabled          bool                   `json:"IsEnabled"`
+	Password           *core.SensitiveValue   `json:"Password,omitempty"`
+	ReleaseNoteOptions JiraReleaseNoteOptions `json:"ReleaseNoteOptions"`
+}
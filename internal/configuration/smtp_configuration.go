@@ -0,0 +1,44 @@
+package configuration
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+)
+
+// SmtpConfiguration is the configuration of the SMTP server that Octopus sends emails through. There is exactly one
+// SMTP configuration per Octopus instance. go-octopusdeploy does not model it, so it is read and written through the
+// SMTP configuration API directly.
+type SmtpConfiguration struct {
+	EnableSsl     bool                 `json:"EnableSsl"`
+	SendEmailFrom string               `json:"SendEmailFrom,omitempty"`
+	SmtpHost      string               `json:"SmtpHost,omitempty"`
+	SmtpLogin     string               `json:"SmtpLogin,omitempty"`
+	SmtpPassword  *core.SensitiveValue `json:"SmtpPassword,omitempty"`
+	SmtpPort      int                  `json:"SmtpPort,omitempty"`
+	Timeout       int                  `json:"Timeout,omitempty"`
+
+	resources.Resource
+}
+
+// GetSmtpConfiguration returns the SMTP configuration of the Octopus instance.
+func GetSmtpConfiguration(client *client.Client) (*SmtpConfiguration, error) {
+	resp, err := api.ApiGet(client.SmtpConfiguration.GetClient(), new(SmtpConfiguration), client.SmtpConfiguration.GetBasePath())
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*SmtpConfiguration), nil
+}
+
+// UpdateSmtpConfiguration replaces the SMTP configuration of the Octopus instance.
+func UpdateSmtpConfiguration(client *client.Client, smtpConfiguration *SmtpConfiguration) (*SmtpConfiguration, error) {
+	resp, err := services.ApiUpdate(client.SmtpConfiguration.GetClient(), smtpConfiguration, new(SmtpConfiguration), client.SmtpConfiguration.GetBasePath())
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*SmtpConfiguration), nil
+}
@@ -11,32 +11,44 @@ import (
 
 // ExpandJiraExtensionSettings deserializes the environment extension settings for Jira integration from its HCL representation.
 func ExpandJiraExtensionSettings(extensionSettings interface{}) extensions.ExtensionSettings {
-	values := extensionSettings.([]interface{})
+	values, ok := extensionSettings.([]interface{})
+	if !ok || len(values) == 0 || values[0] == nil {
+		return nil
+	}
+
 	valuesMap := values[0].(map[string]interface{})
 	return environments.NewJiraExtensionSettings(
 		valuesMap["environment_type"].(string),
 	)
 }
 
-// ExpandJiraExtensionSettings deserializes the environment extension settings for Jira Service Management (JSM) integration from its HCL representation.
+// ExpandJiraServiceManagementExtensionSettings deserializes the environment extension settings for Jira Service Management (JSM) integration from its HCL representation.
 func ExpandJiraServiceManagementExtensionSettings(extensionSettings interface{}) extensions.ExtensionSettings {
-	values := extensionSettings.([]interface{})
+	values, ok := extensionSettings.([]interface{})
+	if !ok || len(values) == 0 || values[0] == nil {
+		return nil
+	}
+
 	valuesMap := values[0].(map[string]interface{})
 	return environments.NewJiraServiceManagementExtensionSettings(
 		valuesMap["is_enabled"].(bool),
 	)
 }
 
-// ExpandJiraExtensionSettings deserializes the environment extension settings for ServiceNow integration from its HCL representation.
+// ExpandServiceNowExtensionSettings deserializes the environment extension settings for ServiceNow integration from its HCL representation.
 func ExpandServiceNowExtensionSettings(extensionSettings interface{}) extensions.ExtensionSettings {
-	values := extensionSettings.([]interface{})
+	values, ok := extensionSettings.([]interface{})
+	if !ok || len(values) == 0 || values[0] == nil {
+		return nil
+	}
+
 	valuesMap := values[0].(map[string]interface{})
 	return environments.NewServiceNowExtensionSettings(
 		valuesMap["is_enabled"].(bool),
 	)
 }
 
-// ExpandJiraExtensionSettings serializes the environment extension settings for Jira integration into its HCL representation.
+// FlattenJiraExtensionSettings serializes the environment extension settings for Jira integration into its HCL representation.
 func FlattenJiraExtensionSettings(jiraExtensionSettings *environments.JiraExtensionSettings) []interface{} {
 	if jiraExtensionSettings == nil {
 		return nil
@@ -91,7 +103,7 @@ func GetJiraExtensionSettingsSchema() map[string]*schema.Schema {
 func GetJiraServiceManagementExtensionSettingsSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"is_enabled": {
-			Description: "Specifies whether or not this extension is enabled for this project.",
+			Description: "Whether deployments to this environment are change controlled, requiring an approved change request in Jira Service Management (JSM).",
 			Required:    true,
 			Type:        schema.TypeBool,
 		},
@@ -102,7 +114,7 @@ func GetJiraServiceManagementExtensionSettingsSchema() map[string]*schema.Schema
 func GetServiceNowExtensionSettingsSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"is_enabled": {
-			Description: "Specifies whether or not this extension is enabled for this project.",
+			Description: "Whether deployments to this environment are change controlled, requiring an approved change request in ServiceNow.",
 			Required:    true,
 			Type:        schema.TypeBool,
 		},
@@ -110,23 +122,31 @@ func GetServiceNowExtensionSettingsSchema() map[string]*schema.Schema {
 }
 
 // SetExtensionSettings sets the Terraform state of environment settings collection for extensions.
+// Settings that are no longer held by Octopus are removed from state.
 func SetExtensionSettings(d *schema.ResourceData, extensionSettingsCollection []extensions.ExtensionSettings) error {
+	hasJiraExtensionSettings := false
+	hasJiraServiceManagementExtensionSettings := false
+	hasServiceNowExtensionSettings := false
+
 	for _, extensionSettings := range extensionSettingsCollection {
 		switch extensionSettings.ExtensionID() {
 		case extensions.JiraExtensionID:
 			if jiraExtensionSettings, ok := extensionSettings.(*environments.JiraExtensionSettings); ok {
+				hasJiraExtensionSettings = true
 				if err := d.Set("jira_extension_settings", FlattenJiraExtensionSettings(jiraExtensionSettings)); err != nil {
 					return fmt.Errorf("error setting extension settings for Jira: %s", err)
 				}
 			}
 		case extensions.JiraServiceManagementExtensionID:
 			if jiraServiceManagementExtensionSettings, ok := extensionSettings.(*environments.JiraServiceManagementExtensionSettings); ok {
+				hasJiraServiceManagementExtensionSettings = true
 				if err := d.Set("jira_service_management_extension_settings", FlattenJiraServiceManagementExtensionSettings(jiraServiceManagementExtensionSettings)); err != nil {
 					return fmt.Errorf("error setting extension settings for Jira Service Management (JSM): %s", err)
 				}
 			}
 		case extensions.ServiceNowExtensionID:
 			if serviceNowExtensionSettings, ok := extensionSettings.(*environments.ServiceNowExtensionSettings); ok {
+				hasServiceNowExtensionSettings = true
 				if err := d.Set("servicenow_extension_settings", FlattenServiceNowExtensionSettings(serviceNowExtensionSettings)); err != nil {
 					return fmt.Errorf("error setting extension settings for ServiceNow: %s", err)
 				}
@@ -134,5 +154,23 @@ func SetExtensionSettings(d *schema.ResourceData, extensionSettingsCollection []
 		}
 	}
 
+	if !hasJiraExtensionSettings {
+		if err := d.Set("jira_extension_settings", nil); err != nil {
+			return fmt.Errorf("error setting extension settings for Jira: %s", err)
+		}
+	}
+
+	if !hasJiraServiceManagementExtensionSettings {
+		if err := d.Set("jira_service_management_extension_settings", nil); err != nil {
+			return fmt.Errorf("error setting extension settings for Jira Service Management (JSM): %s", err)
+		}
+	}
+
+	if !hasServiceNowExtensionSettings {
+		if err := d.Set("servicenow_extension_settings", nil); err != nil {
+			return fmt.Errorf("error setting extension settings for ServiceNow: %s", err)
+		}
+	}
+
 	return nil
 }
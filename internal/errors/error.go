@@ -2,18 +2,40 @@ package errors
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// DeleteFromState removes a resource that no longer exists in Octopus Deploy from state, and warns that it was
+// removed, so that a resource deleted outside of Terraform does not prevent the workspace from being planned.
 func DeleteFromState(ctx context.Context, d *schema.ResourceData, resource string) diag.Diagnostics {
 	log.Printf("[INFO] %s (%s) not found; deleting from state", resource, d.Id())
+
+	id := d.Id()
 	d.SetId("")
-	return nil
+	return diag.Diagnostics{{
+		Detail:   fmt.Sprintf("The %s (%s) was not found in Octopus Deploy and has been removed from state. It may have been deleted outside of Terraform.", resource, id),
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("The %s (%s) no longer exists", resource, id),
+	}}
+}
+
+// IsNotFound reports whether an error returned by the Octopus REST API indicates that the requested resource does
+// not exist.
+func IsNotFound(err error) bool {
+	var apiError *core.APIError
+	if errors.As(err, &apiError) {
+		return apiError.StatusCode == http.StatusNotFound
+	}
+
+	return errors.Is(err, services.ErrItemNotFound)
 }
 
 func ProcessApiError(ctx context.Context, d *schema.ResourceData, err error, resource string) diag.Diagnostics {
@@ -21,10 +43,8 @@ func ProcessApiError(ctx context.Context, d *schema.ResourceData, err error, res
 		return nil
 	}
 
-	if apiError, ok := err.(*core.APIError); ok {
-		if apiError.StatusCode == http.StatusNotFound {
-			return DeleteFromState(ctx, d, resource)
-		}
+	if IsNotFound(err) {
+		return DeleteFromState(ctx, d, resource)
 	}
 
 	return diag.FromErr(err)
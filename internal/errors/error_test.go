@@ -0,0 +1,46 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessApiError(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Optional: true, Type: schema.TypeString},
+		},
+	}
+
+	for _, err := range []error{
+		&core.APIError{StatusCode: http.StatusNotFound},
+		fmt.Errorf("error reading lifecycle: %w", &core.APIError{StatusCode: http.StatusNotFound}),
+		services.ErrItemNotFound,
+	} {
+		d := resource.TestResourceData()
+		d.SetId("Lifecycles-1")
+
+		diags := ProcessApiError(context.Background(), d, err, "lifecycle")
+		require.Empty(t, d.Id())
+		require.Len(t, diags, 1)
+		require.Equal(t, diag.Warning, diags[0].Severity)
+		require.Contains(t, diags[0].Summary, "Lifecycles-1")
+	}
+
+	d := resource.TestResourceData()
+	d.SetId("Lifecycles-1")
+
+	diags := ProcessApiError(context.Background(), d, &core.APIError{StatusCode: http.StatusUnauthorized}, "lifecycle")
+	require.True(t, diags.HasError())
+	require.Equal(t, "Lifecycles-1", d.Id())
+
+	require.Nil(t, ProcessApiError(context.Background(), d, nil, "lifecycle"))
+}
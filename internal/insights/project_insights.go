@@ -0,0 +1,109 @@
+package insights
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+)
+
+// ProjectInsightsQuery selects the deployments of a project that insights are calculated from.
+type ProjectInsightsQuery struct {
+	EnvironmentIDs []string
+	From           time.Time
+	ProjectID      string
+	To             time.Time
+}
+
+// ProjectInsights are the DORA metrics of the deployments of a project over a window of time. go-octopusdeploy does not
+// model insights, so they are read through the insights API directly.
+type ProjectInsights struct {
+	DeploymentCount           int     `json:"DeploymentCount"`
+	DeploymentFailureRate     float64 `json:"DeploymentFailureRate"`
+	DeploymentFrequency       float64 `json:"DeploymentFrequency"`
+	FailedDeploymentCount     int     `json:"FailedDeploymentCount"`
+	From                      string  `json:"From,omitempty"`
+	LeadTime                  string  `json:"LeadTime,omitempty"`
+	MeanTimeToRecovery        string  `json:"MeanTimeToRecovery,omitempty"`
+	SuccessfulDeploymentCount int     `json:"SuccessfulDeploymentCount"`
+	To                        string  `json:"To,omitempty"`
+}
+
+// GetProjectInsights returns the insights of the deployments of a project that match the given query.
+func GetProjectInsights(client *client.Client, spaceID string, query ProjectInsightsQuery) (*ProjectInsights, error) {
+	values := url.Values{}
+	for _, environmentID := range query.EnvironmentIDs {
+		values.Add("environmentIds", environmentID)
+	}
+	if !query.From.IsZero() {
+		values.Set("from", query.From.Format(time.RFC3339))
+	}
+	if !query.To.IsZero() {
+		values.Set("to", query.To.Format(time.RFC3339))
+	}
+
+	path := fmt.Sprintf("/api/%s/projects/%s/insights/metrics", spaceID, query.ProjectID)
+	if len(values) > 0 {
+		path += "?" + values.Encode()
+	}
+
+	resp, err := api.ApiGet(client.Projects.GetClient(), new(ProjectInsights), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*ProjectInsights), nil
+}
+
+// ParseTimeSpan converts a duration in the format used by Octopus, [-][d.]hh:mm:ss[.fffffff], to a time.Duration.
+func ParseTimeSpan(timeSpan string) (time.Duration, error) {
+	if len(timeSpan) == 0 {
+		return 0, nil
+	}
+
+	negative := strings.HasPrefix(timeSpan, "-")
+	value := strings.TrimPrefix(timeSpan, "-")
+
+	var days int64
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid time span %q", timeSpan)
+	}
+
+	if dayAndHours := strings.SplitN(parts[0], ".", 2); len(dayAndHours) == 2 {
+		d, err := strconv.ParseInt(dayAndHours[0], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid time span %q", timeSpan)
+		}
+		days = d
+		parts[0] = dayAndHours[1]
+	}
+
+	hours, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time span %q", timeSpan)
+	}
+	minutes, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time span %q", timeSpan)
+	}
+	seconds, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time span %q", timeSpan)
+	}
+
+	duration := time.Duration(days)*24*time.Hour +
+		time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute +
+		time.Duration(seconds*float64(time.Second))
+
+	if negative {
+		duration = -duration
+	}
+
+	return duration, nil
+}
@@ -0,0 +1,62 @@
+package licenses
+
+import (
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+)
+
+// CurrentLicenseID is the ID of the license installed on the Octopus instance.
+const CurrentLicenseID = "licenses-current"
+
+// License is the license installed on the Octopus instance. go-octopusdeploy does not model licenses, so they are read
+// and written through the license API directly.
+type License struct {
+	LicenseText string `json:"LicenseText"`
+
+	resources.Resource
+}
+
+// LicenseStatus describes whether the usage of the Octopus instance complies with its license.
+type LicenseStatus struct {
+	ComplianceSummary   string `json:"ComplianceSummary,omitempty"`
+	EffectiveExpiryDate string `json:"EffectiveExpiryDate,omitempty"`
+	IsCompliant         bool   `json:"IsCompliant"`
+}
+
+// GetCurrentLicense returns the license installed on the Octopus instance.
+func GetCurrentLicense(client *client.Client) (*License, error) {
+	resp, err := api.ApiGet(client.Licenses.GetClient(), new(License), getLicensePath(client, CurrentLicenseID))
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*License), nil
+}
+
+// GetCurrentLicenseStatus returns the status of the license installed on the Octopus instance.
+func GetCurrentLicenseStatus(client *client.Client) (*LicenseStatus, error) {
+	resp, err := api.ApiGet(client.Licenses.GetClient(), new(LicenseStatus), getLicensePath(client, CurrentLicenseID+"-status"))
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*LicenseStatus), nil
+}
+
+// UpdateCurrentLicense installs a license on the Octopus instance, replacing the license that was installed before.
+func UpdateCurrentLicense(client *client.Client, license *License) (*License, error) {
+	resp, err := services.ApiUpdate(client.Licenses.GetClient(), license, new(License), getLicensePath(client, CurrentLicenseID))
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*License), nil
+}
+
+func getLicensePath(client *client.Client, id string) string {
+	return fmt.Sprintf("%s/%s", client.Licenses.GetBasePath(), id)
+}
@@ -9,9 +9,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-// ExpandJiraExtensionSettings deserializes the project extension settings for Jira Service Management (JSM) integration from its HCL representation.
+// ExpandJiraServiceManagementExtensionSettings deserializes the project extension settings for Jira Service Management (JSM) integration from its HCL representation.
 func ExpandJiraServiceManagementExtensionSettings(extensionSettings interface{}) extensions.ExtensionSettings {
-	values := extensionSettings.([]interface{})
+	values, ok := extensionSettings.([]interface{})
+	if !ok || len(values) == 0 || values[0] == nil {
+		return nil
+	}
+
 	valuesMap := values[0].(map[string]interface{})
 	return projects.NewJiraServiceManagementExtensionSettings(
 		valuesMap["connection_id"].(string),
@@ -20,9 +24,13 @@ func ExpandJiraServiceManagementExtensionSettings(extensionSettings interface{})
 	)
 }
 
-// ExpandJiraExtensionSettings deserializes the project extension settings for ServiceNow integration from its HCL representation.
+// ExpandServiceNowExtensionSettings deserializes the project extension settings for ServiceNow integration from its HCL representation.
 func ExpandServiceNowExtensionSettings(extensionSettings interface{}) extensions.ExtensionSettings {
-	values := extensionSettings.([]interface{})
+	values, ok := extensionSettings.([]interface{})
+	if !ok || len(values) == 0 || values[0] == nil {
+		return nil
+	}
+
 	valuesMap := values[0].(map[string]interface{})
 	return projects.NewServiceNowExtensionSettings(
 		valuesMap["connection_id"].(string),
@@ -74,7 +82,7 @@ func GetJiraServiceManagementExtensionSettingsSchema() map[string]*schema.Schema
 			Type:        schema.TypeBool,
 		},
 		"service_desk_project_name": {
-			Description:      "The project name associated with this extension.",
+			Description:      "The name of the Jira Service Management service desk project in which change requests are raised.",
 			Required:         true,
 			Type:             schema.TypeString,
 			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
@@ -111,17 +119,23 @@ func GetServiceNowExtensionSettingsSchema() map[string]*schema.Schema {
 }
 
 // SetExtensionSettings sets the Terraform state of project settings collection for extensions.
+// Settings that are no longer held by Octopus are removed from state.
 func SetExtensionSettings(d *schema.ResourceData, extensionSettingsCollection []extensions.ExtensionSettings) error {
+	hasJiraServiceManagementExtensionSettings := false
+	hasServiceNowExtensionSettings := false
+
 	for _, extensionSettings := range extensionSettingsCollection {
 		switch extensionSettings.ExtensionID() {
 		case extensions.JiraServiceManagementExtensionID:
 			if jiraServiceManagementExtensionSettings, ok := extensionSettings.(*projects.JiraServiceManagementExtensionSettings); ok {
+				hasJiraServiceManagementExtensionSettings = true
 				if err := d.Set("jira_service_management_extension_settings", FlattenJiraServiceManagementExtensionSettings(jiraServiceManagementExtensionSettings)); err != nil {
 					return fmt.Errorf("error setting extension settings for Jira Service Management (JSM): %s", err)
 				}
 			}
 		case extensions.ServiceNowExtensionID:
 			if serviceNowExtensionSettings, ok := extensionSettings.(*projects.ServiceNowExtensionSettings); ok {
+				hasServiceNowExtensionSettings = true
 				if err := d.Set("servicenow_extension_settings", FlattenServiceNowExtensionSettings(serviceNowExtensionSettings)); err != nil {
 					return fmt.Errorf("error setting extension settings for ServiceNow: %s", err)
 				}
@@ -129,5 +143,17 @@ func SetExtensionSettings(d *schema.ResourceData, extensionSettingsCollection []
 		}
 	}
 
+	if !hasJiraServiceManagementExtensionSettings {
+		if err := d.Set("jira_service_management_extension_settings", nil); err != nil {
+			return fmt.Errorf("error setting extension settings for Jira Service Management (JSM): %s", err)
+		}
+	}
+
+	if !hasServiceNowExtensionSettings {
+		if err := d.Set("servicenow_extension_settings", nil); err != nil {
+			return fmt.Errorf("error setting extension settings for ServiceNow: %s", err)
+		}
+	}
+
 	return nil
 }
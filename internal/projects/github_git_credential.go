@@ -0,0 +1,69 @@
+package projects
+
+import (
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/credentials"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+)
+
+// GitCredentialTypeGitHub is the type of the Git credential that authenticates with a GitHub App connection.
+const GitCredentialTypeGitHub = credentials.Type("GitHub")
+
+// GitHubGitCredential is a Git credential that authenticates with the installation of the Octopus GitHub App referenced
+// by a GitHub App connection. go-octopusdeploy does not model this credential, so it is added to the persistence
+// settings of projects here.
+type GitHubGitCredential struct {
+	CredentialType credentials.Type `json:"Type"`
+	ID             string           `json:"Id"`
+}
+
+// NewGitHubGitCredential creates a Git credential for the GitHub App connection with the given ID.
+func NewGitHubGitCredential(id string) *GitHubGitCredential {
+	return &GitHubGitCredential{
+		CredentialType: GitCredentialTypeGitHub,
+		ID:             id,
+	}
+}
+
+// Type returns the type for this Git credential.
+func (c *GitHubGitCredential) Type() credentials.Type {
+	return c.CredentialType
+}
+
+var _ credentials.GitCredential = &GitHubGitCredential{}
+
+type gitHubPersistenceSettings struct {
+	PersistenceSettings struct {
+		Credentials *GitHubGitCredential `json:"Credentials,omitempty"`
+	} `json:"PersistenceSettings"`
+}
+
+// LoadGitHubGitCredential restores the GitHub App connection used by a version-controlled project. go-octopusdeploy
+// drops the Git credentials it does not model when it reads a project, so they are read through the project API
+// directly. Projects that use any other kind of Git credential are left untouched.
+func LoadGitHubGitCredential(client *client.Client, project *projects.Project) error {
+	if project == nil || project.PersistenceSettings == nil || project.PersistenceSettings.Type() != projects.PersistenceSettingsTypeVersionControlled {
+		return nil
+	}
+
+	gitPersistenceSettings := project.PersistenceSettings.(projects.GitPersistenceSettings)
+	if gitPersistenceSettings.Credential() != nil {
+		return nil
+	}
+
+	path := fmt.Sprintf("/api/%s/projects/%s", project.SpaceID, project.GetID())
+	resp, err := api.ApiGet(client.Projects.GetClient(), new(gitHubPersistenceSettings), path)
+	if err != nil {
+		return err
+	}
+
+	credential := resp.(*gitHubPersistenceSettings).PersistenceSettings.Credentials
+	if credential != nil && credential.Type() == GitCredentialTypeGitHub {
+		gitPersistenceSettings.SetCredential(credential)
+	}
+
+	return nil
+}
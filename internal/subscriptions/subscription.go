@@ -0,0 +1,64 @@
+package subscriptions
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/subscriptions"
+)
+
+// EventNotificationSubscriptionFilter describes the events that a subscription notifies about. An empty list matches
+// events of any value.
+type EventNotificationSubscriptionFilter struct {
+	DocumentTypes   []string `json:"DocumentTypes"`
+	Environments    []string `json:"Environments"`
+	EventAgents     []string `json:"EventAgents"`
+	EventCategories []string `json:"EventCategories"`
+	EventGroups     []string `json:"EventGroups"`
+	ProjectGroups   []string `json:"ProjectGroups"`
+	Projects        []string `json:"Projects"`
+	Tags            []string `json:"Tags"`
+	Tenants         []string `json:"Tenants"`
+	Users           []string `json:"Users"`
+}
+
+// EventNotificationSubscription describes how a subscription notifies about the events that match its filter, by email
+// digest and by webhook.
+type EventNotificationSubscription struct {
+	EmailFrequencyPeriod       string                              `json:"EmailFrequencyPeriod,omitempty"`
+	EmailPriority              string                              `json:"EmailPriority,omitempty"`
+	EmailShowDatesInTimeZoneID string                              `json:"EmailShowDatesInTimeZoneId,omitempty"`
+	EmailTeams                 []string                            `json:"EmailTeams"`
+	Filter                     EventNotificationSubscriptionFilter `json:"Filter"`
+	WebhookHeaderKey           string                              `json:"WebhookHeaderKey,omitempty"`
+	WebhookTeams               []string                            `json:"WebhookTeams"`
+	WebhookTimeout             string                              `json:"WebhookTimeout,omitempty"`
+	WebhookURI                 string                              `json:"WebhookURI,omitempty"`
+}
+
+// Subscription notifies teams of events in Octopus. go-octopusdeploy does not model subscriptions, so they are read
+// through the subscription API directly.
+type Subscription struct {
+	EventNotificationSubscription EventNotificationSubscription `json:"EventNotificationSubscription"`
+	IsDisabled                    bool                          `json:"IsDisabled"`
+	Name                          string                        `json:"Name"`
+	SpaceID                       string                        `json:"SpaceId,omitempty"`
+	Type                          string                        `json:"Type,omitempty"`
+
+	resources.Resource
+}
+
+// GetSubscriptions returns the subscriptions that match the given query.
+func GetSubscriptions(client *client.Client, query subscriptions.SubscriptionsQuery) (*resources.Resources[*Subscription], error) {
+	path, err := client.Subscriptions.GetURITemplate().Expand(query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.ApiGet(client.Subscriptions.GetClient(), new(resources.Resources[*Subscription]), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*resources.Resources[*Subscription]), nil
+}
@@ -0,0 +1,84 @@
+package triggers
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+)
+
+const (
+	// AutoDeployActionType is the type of the action of a trigger that deploys the current release to deployment
+	// targets.
+	AutoDeployActionType = "AutoDeploy"
+
+	// MachineFilterType is the type of the filter of a trigger that fires on deployment target events.
+	MachineFilterType = "MachineFilter"
+
+	// RunRunbookActionType is the type of the action of a trigger that runs a runbook.
+	RunRunbookActionType = "RunRunbook"
+)
+
+// DeploymentTargetTriggerAction is the action of a deployment target trigger: either to deploy the current release to
+// the deployment target, or to run a runbook.
+type DeploymentTargetTriggerAction struct {
+	ActionType     string   `json:"ActionType"`
+	EnvironmentIDs []string `json:"EnvironmentIds,omitempty"`
+	RunbookID      string   `json:"RunbookId,omitempty"`
+	ShouldRedeploy bool     `json:"ShouldRedeployWhenMachineHasBeenDeployedTo"`
+	TenantIDs      []string `json:"TenantIds,omitempty"`
+	TenantTags     []string `json:"TenantTags,omitempty"`
+}
+
+// DeploymentTargetTriggerFilter describes the deployment target events that cause a deployment target trigger to
+// fire.
+type DeploymentTargetTriggerFilter struct {
+	EnvironmentIDs  []string `json:"EnvironmentIds,omitempty"`
+	EventCategories []string `json:"EventCategories,omitempty"`
+	EventGroups     []string `json:"EventGroups,omitempty"`
+	FilterType      string   `json:"FilterType"`
+	Roles           []string `json:"Roles,omitempty"`
+	TenantTags      []string `json:"TenantTags,omitempty"`
+}
+
+// DeploymentTargetTrigger is a project trigger that fires on deployment target events. go-octopusdeploy models these
+// triggers without tenant tags or runbook actions, so they are read and written through the project trigger API
+// directly.
+type DeploymentTargetTrigger struct {
+	Action      DeploymentTargetTriggerAction `json:"Action"`
+	Description string                        `json:"Description,omitempty"`
+	Filter      DeploymentTargetTriggerFilter `json:"Filter"`
+	IsDisabled  bool                          `json:"IsDisabled"`
+	Name        string                        `json:"Name"`
+	ProjectID   string                        `json:"ProjectId"`
+	SpaceID     string                        `json:"SpaceId"`
+
+	resources.Resource
+}
+
+// NewDeploymentTargetTrigger creates a deployment target trigger with the given action and filter.
+func NewDeploymentTargetTrigger(name string, projectID string, spaceID string, action DeploymentTargetTriggerAction, filter DeploymentTargetTriggerFilter) *DeploymentTargetTrigger {
+	filter.FilterType = MachineFilterType
+
+	return &DeploymentTargetTrigger{
+		Action:    action,
+		Filter:    filter,
+		Name:      name,
+		ProjectID: projectID,
+		SpaceID:   spaceID,
+		Resource:  *resources.NewResource(),
+	}
+}
+
+// AddDeploymentTargetTrigger creates a deployment target trigger.
+func AddDeploymentTargetTrigger(client *client.Client, trigger *DeploymentTargetTrigger) (*DeploymentTargetTrigger, error) {
+	return addTrigger(client, trigger.SpaceID, trigger)
+}
+
+// GetDeploymentTargetTrigger returns the deployment target trigger with the given ID.
+func GetDeploymentTargetTrigger(client *client.Client, id string) (*DeploymentTargetTrigger, error) {
+	return getTrigger[DeploymentTargetTrigger](client, id)
+}
+
+// UpdateDeploymentTargetTrigger updates a deployment target trigger.
+func UpdateDeploymentTargetTrigger(client *client.Client, trigger *DeploymentTargetTrigger) (*DeploymentTargetTrigger, error) {
+	return updateTrigger(client, trigger.SpaceID, trigger.ProjectID, trigger.GetID(), trigger)
+}
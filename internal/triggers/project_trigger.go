@@ -0,0 +1,42 @@
+package triggers
+
+import (
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+)
+
+// addTrigger, getTrigger and updateTrigger read and write project triggers through the same endpoints as the project
+// trigger service of go-octopusdeploy, but with the representation given by the caller.
+
+func addTrigger[T any](client *client.Client, spaceID string, trigger *T) (*T, error) {
+	path := fmt.Sprintf("/api/%s/projecttriggers", spaceID)
+	resp, err := services.ApiAdd(client.ProjectTriggers.GetClient(), trigger, new(T), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*T), nil
+}
+
+func getTrigger[T any](client *client.Client, id string) (*T, error) {
+	path := fmt.Sprintf("/api/projecttriggers/%s", id)
+	resp, err := api.ApiGet(client.ProjectTriggers.GetClient(), new(T), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*T), nil
+}
+
+func updateTrigger[T any](client *client.Client, spaceID string, projectID string, id string, trigger *T) (*T, error) {
+	path := fmt.Sprintf("/api/%s/projects/%s/triggers/%s", spaceID, projectID, id)
+	resp, err := services.ApiUpdate(client.ProjectTriggers.GetClient(), trigger, new(T), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*T), nil
+}
@@ -0,0 +1,87 @@
+package triggers
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+)
+
+// CreateReleaseActionType is the type of the action of a trigger that creates a release.
+const CreateReleaseActionType = "CreateRelease"
+
+// CreateReleaseAction creates a release in a channel of the project of a trigger.
+type CreateReleaseAction struct {
+	ActionType string `json:"ActionType"`
+	ChannelID  string `json:"ChannelId,omitempty"`
+}
+
+// DeploymentActionSlugPackage identifies a package of a deployment action by the slug of the action. An empty package
+// reference identifies the primary package of the action.
+type DeploymentActionSlugPackage struct {
+	DeploymentActionSlug string `json:"DeploymentActionSlug,omitempty"`
+	PackageReference     string `json:"PackageReference,omitempty"`
+}
+
+// GitTriggerSource identifies a Git repository referenced by a deployment action, and the paths within it that are
+// monitored for commits.
+type GitTriggerSource struct {
+	DeploymentActionSlug string   `json:"DeploymentActionSlug"`
+	ExcludeFilePaths     []string `json:"ExcludeFilePaths"`
+	GitDependencyName    string   `json:"GitDependencyName"`
+	IncludeFilePaths     []string `json:"IncludeFilePaths"`
+}
+
+// ReleaseCreationTriggerFilter describes the changes that cause a release creation trigger to fire: new versions of
+// packages for feed triggers, and commits to Git repositories for Git triggers.
+type ReleaseCreationTriggerFilter struct {
+	FilterType string                        `json:"FilterType"`
+	Packages   []DeploymentActionSlugPackage `json:"Packages,omitempty"`
+	Sources    []GitTriggerSource            `json:"Sources,omitempty"`
+}
+
+// ReleaseCreationTrigger is a project trigger that creates a release when a change is detected outside of Octopus.
+// go-octopusdeploy does not model these triggers and fails to read them, so they are read and written through the
+// project trigger API directly.
+type ReleaseCreationTrigger struct {
+	Action      CreateReleaseAction          `json:"Action"`
+	Description string                       `json:"Description,omitempty"`
+	Filter      ReleaseCreationTriggerFilter `json:"Filter"`
+	IsDisabled  bool                         `json:"IsDisabled"`
+	Name        string                       `json:"Name"`
+	ProjectID   string                       `json:"ProjectId"`
+	SpaceID     string                       `json:"SpaceId"`
+
+	resources.Resource
+}
+
+// NewReleaseCreationTrigger creates a release creation trigger with the given filter type, which creates releases in
+// the given channel.
+func NewReleaseCreationTrigger(name string, projectID string, spaceID string, channelID string, filterType string) *ReleaseCreationTrigger {
+	return &ReleaseCreationTrigger{
+		Action: CreateReleaseAction{
+			ActionType: CreateReleaseActionType,
+			ChannelID:  channelID,
+		},
+		Filter: ReleaseCreationTriggerFilter{
+			FilterType: filterType,
+		},
+		Name:      name,
+		ProjectID: projectID,
+		SpaceID:   spaceID,
+		Resource:  *resources.NewResource(),
+	}
+}
+
+// AddReleaseCreationTrigger creates a release creation trigger.
+func AddReleaseCreationTrigger(client *client.Client, trigger *ReleaseCreationTrigger) (*ReleaseCreationTrigger, error) {
+	return addTrigger(client, trigger.SpaceID, trigger)
+}
+
+// GetReleaseCreationTrigger returns the release creation trigger with the given ID.
+func GetReleaseCreationTrigger(client *client.Client, id string) (*ReleaseCreationTrigger, error) {
+	return getTrigger[ReleaseCreationTrigger](client, id)
+}
+
+// UpdateReleaseCreationTrigger updates a release creation trigger.
+func UpdateReleaseCreationTrigger(client *client.Client, trigger *ReleaseCreationTrigger) (*ReleaseCreationTrigger, error) {
+	return updateTrigger(client, trigger.SpaceID, trigger.ProjectID, trigger.GetID(), trigger)
+}
@@ -3,14 +3,13 @@ package octopusdeploy
 import (
 	"fmt"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func testAccountExists(prefix string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		accountID := s.RootModule().Resources[prefix].Primary.ID
 		if _, err := client.Accounts.GetByID(accountID); err != nil {
 			return err
@@ -21,7 +20,7 @@ func testAccountExists(prefix string) resource.TestCheckFunc {
 }
 
 func testAccountCheckDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*client.Client)
+	client := getClient(testAccProvider.Meta())
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "octopusdeploy_account" {
 			continue
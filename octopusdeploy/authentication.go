@@ -0,0 +1,217 @@
+package octopusdeploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+)
+
+// accessTokenAPIKey is given to the Octopus client in place of an API key when the provider authenticates with an
+// access token, since the client only accepts API keys. It is never sent to Octopus.
+const accessTokenAPIKey = "API-ACCESSTOKEN"
+
+// accessTokenRefreshMargin is how long before it expires that an access token is exchanged for a new one, so that a
+// request is not sent with a token that expires on its way to the Octopus Server.
+const accessTokenRefreshMargin = time.Minute
+
+// accessTokenExchange exchanges a credential for an access token, returning the token and when it expires. The
+// expiry is zero when the token does not expire.
+type accessTokenExchange func() (string, time.Time, error)
+
+type accessTokenTransport struct {
+	accessToken string
+	exchange    accessTokenExchange
+	expiry      time.Time
+	mutex       sync.Mutex
+	transport   http.RoundTripper
+}
+
+// RoundTrip replaces the API key header of the request with the access token. When the access token was exchanged for
+// another credential, it is exchanged again shortly before it expires, and once when the Octopus Server rejects it.
+func (t *accessTokenTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	accessToken, err := t.getAccessToken()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := t.transport.RoundTrip(t.authenticate(request, accessToken))
+	if err != nil || response.StatusCode != http.StatusUnauthorized || t.exchange == nil {
+		return response, err
+	}
+
+	// a request with a body can only be sent again when the body can be read again
+	if request.Body != nil && request.Body != http.NoBody && request.GetBody == nil {
+		return response, nil
+	}
+
+	response.Body.Close()
+
+	accessToken, err = t.refreshAccessToken(accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	retry := t.authenticate(request, accessToken)
+	if request.GetBody != nil {
+		if retry.Body, err = request.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+
+	return t.transport.RoundTrip(retry)
+}
+
+// authenticate returns a copy of the request with the access token in place of the API key.
+func (t *accessTokenTransport) authenticate(request *http.Request, accessToken string) *http.Request {
+	request = request.Clone(request.Context())
+	request.Header.Del(constants.ClientAPIKeyHTTPHeader)
+	request.Header.Set("Authorization", "Bearer "+accessToken)
+
+	return request
+}
+
+// getAccessToken returns the access token, exchanging it for a new one when it is about to expire.
+func (t *accessTokenTransport) getAccessToken() (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.exchange != nil && !t.expiry.IsZero() && time.Now().Add(accessTokenRefreshMargin).After(t.expiry) {
+		if err := t.exchangeAccessToken(); err != nil {
+			return "", err
+		}
+	}
+
+	return t.accessToken, nil
+}
+
+// refreshAccessToken exchanges the rejected access token for a new one, unless another request has done so already.
+func (t *accessTokenTransport) refreshAccessToken(rejectedAccessToken string) (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.accessToken == rejectedAccessToken {
+		if err := t.exchangeAccessToken(); err != nil {
+			return "", err
+		}
+	}
+
+	return t.accessToken, nil
+}
+
+func (t *accessTokenTransport) exchangeAccessToken() error {
+	accessToken, expiry, err := t.exchange()
+	if err != nil {
+		return err
+	}
+
+	t.accessToken = accessToken
+	t.expiry = expiry
+	return nil
+}
+
+// newAccessTokenHTTPClient returns a copy of the HTTP client that authenticates with the access token. When the access
+// token was exchanged for another credential, exchange is given to exchange it again before it expires at expiry;
+// otherwise it is nil.
+func newAccessTokenHTTPClient(httpClient *http.Client, accessToken string, expiry time.Time, exchange accessTokenExchange) *http.Client {
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	accessTokenHTTPClient := *httpClient
+	accessTokenHTTPClient.Transport = &accessTokenTransport{
+		accessToken: accessToken,
+		exchange:    exchange,
+		expiry:      expiry,
+		transport:   transport,
+	}
+
+	return &accessTokenHTTPClient
+}
+
+type openIDConfiguration struct {
+	TokenEndpoint string `json:"token_endpoint"`
+}
+
+type tokenExchangeRequest struct {
+	Audience         string `json:"audience"`
+	GrantType        string `json:"grant_type"`
+	SubjectToken     string `json:"subject_token"`
+	SubjectTokenType string `json:"subject_token_type"`
+}
+
+type tokenExchangeResponse struct {
+	AccessToken      string `json:"access_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	ExpiresIn        int64  `json:"expires_in"`
+}
+
+// exchangeIDToken exchanges an OIDC ID token for an access token of the service account, using the token endpoint
+// published by the Octopus Server. It returns the access token and when it expires, which is zero when the Octopus Server
+// does not say.
+func exchangeIDToken(httpClient *http.Client, apiURL *url.URL, serviceAccountID string, idToken string) (string, time.Time, error) {
+	configurationURL := strings.TrimRight(apiURL.String(), "/") + "/.well-known/openid-configuration"
+	configurationResponse, err := httpClient.Get(configurationURL)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("error reading the OpenID configuration of the Octopus Server: %s", err)
+	}
+	defer configurationResponse.Body.Close()
+
+	if configurationResponse.StatusCode != http.StatusOK {
+		return "", time.Time{}, fmt.Errorf("error reading the OpenID configuration of the Octopus Server: %s", configurationResponse.Status)
+	}
+
+	var configuration openIDConfiguration
+	if err := json.NewDecoder(configurationResponse.Body).Decode(&configuration); err != nil {
+		return "", time.Time{}, fmt.Errorf("error reading the OpenID configuration of the Octopus Server: %s", err)
+	}
+
+	if len(configuration.TokenEndpoint) == 0 {
+		return "", time.Time{}, fmt.Errorf("the OpenID configuration of the Octopus Server does not have a token endpoint")
+	}
+
+	body, err := json.Marshal(tokenExchangeRequest{
+		Audience:         serviceAccountID,
+		GrantType:        "urn:ietf:params:oauth:grant-type:token-exchange",
+		SubjectToken:     idToken,
+		SubjectTokenType: "urn:ietf:params:oauth:token-type:jwt",
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	issuedAt := time.Now()
+	exchangeResponse, err := httpClient.Post(configuration.TokenEndpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("error exchanging the ID token for an access token: %s", err)
+	}
+	defer exchangeResponse.Body.Close()
+
+	var exchange tokenExchangeResponse
+	if err := json.NewDecoder(exchangeResponse.Body).Decode(&exchange); err != nil {
+		return "", time.Time{}, fmt.Errorf("error exchanging the ID token for an access token: %s", exchangeResponse.Status)
+	}
+
+	if exchangeResponse.StatusCode != http.StatusOK {
+		return "", time.Time{}, fmt.Errorf("error exchanging the ID token for an access token: %s %s", exchange.Error, exchange.ErrorDescription)
+	}
+
+	if len(exchange.AccessToken) == 0 {
+		return "", time.Time{}, fmt.Errorf("error exchanging the ID token for an access token: no access token was returned")
+	}
+
+	var expiry time.Time
+	if exchange.ExpiresIn > 0 {
+		expiry = issuedAt.Add(time.Duration(exchange.ExpiresIn) * time.Second)
+	}
+
+	return exchange.AccessToken, expiry, nil
+}
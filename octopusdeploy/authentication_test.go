@@ -0,0 +1,133 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessTokenAPIKey(t *testing.T) {
+	require.True(t, client.IsAPIKey(accessTokenAPIKey))
+}
+
+func TestAccessTokenHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Empty(t, r.Header.Get(constants.ClientAPIKeyHTTPHeader))
+		require.Equal(t, "Bearer access-token", r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+
+	request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	request.Header.Set(constants.ClientAPIKeyHTTPHeader, accessTokenAPIKey)
+
+	response, err := newAccessTokenHTTPClient(&http.Client{}, "access-token", time.Time{}, nil).Do(request)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	// the request of the caller is not modified
+	require.Equal(t, accessTokenAPIKey, request.Header.Get(constants.ClientAPIKeyHTTPHeader))
+}
+
+func TestExchangeIDToken(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			json.NewEncoder(w).Encode(openIDConfiguration{TokenEndpoint: server.URL + "/token/v1"})
+		case "/token/v1":
+			var exchange tokenExchangeRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&exchange))
+			require.Equal(t, "urn:ietf:params:oauth:grant-type:token-exchange", exchange.GrantType)
+			require.Equal(t, "urn:ietf:params:oauth:token-type:jwt", exchange.SubjectTokenType)
+
+			if exchange.Audience != "service-account-id" || exchange.SubjectToken != "id-token" {
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(tokenExchangeResponse{Error: "invalid_grant", ErrorDescription: "The token is not trusted."})
+				return
+			}
+
+			json.NewEncoder(w).Encode(tokenExchangeResponse{AccessToken: "access-token", ExpiresIn: 3600})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	apiURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	accessToken, expiry, err := exchangeIDToken(server.Client(), apiURL, "service-account-id", "id-token")
+	require.NoError(t, err)
+	require.Equal(t, "access-token", accessToken)
+	require.WithinDuration(t, time.Now().Add(time.Hour), expiry, time.Minute)
+
+	_, _, err = exchangeIDToken(server.Client(), apiURL, "service-account-id", "untrusted-id-token")
+	require.ErrorContains(t, err, "invalid_grant The token is not trusted.")
+}
+
+func TestAccessTokenHTTPClientRefresh(t *testing.T) {
+	var rejectedAccessToken string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer "+rejectedAccessToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	exchanges := 0
+	expiresIn := 30 * time.Second
+	exchange := func() (string, time.Time, error) {
+		exchanges++
+		return fmt.Sprintf("access-token-%d", exchanges), time.Now().Add(expiresIn), nil
+	}
+
+	// an access token that expires within the refresh margin is exchanged before it is sent
+	httpClient := newAccessTokenHTTPClient(&http.Client{}, "access-token-0", time.Now().Add(expiresIn), exchange)
+	response, err := httpClient.Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, 1, exchanges)
+
+	// an access token that is not about to expire is kept
+	expiresIn = time.Hour
+	response, err = httpClient.Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, 2, exchanges)
+
+	response, err = httpClient.Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, 2, exchanges)
+
+	// a rejected access token is exchanged once, and the request is sent again with its body
+	rejectedAccessToken = "access-token-2"
+	response, err = httpClient.Post(server.URL, "text/plain", strings.NewReader("body"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, 3, exchanges)
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	require.Equal(t, "body", string(body))
+
+	// an access token that was not exchanged is never exchanged
+	rejectedAccessToken = "access-token"
+	response, err = newAccessTokenHTTPClient(&http.Client{}, "access-token", time.Time{}, nil).Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+	require.Equal(t, 3, exchanges)
+}
@@ -0,0 +1,158 @@
+package octopusdeploy
+
+import (
+	"bytes"
+	"container/list"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// maxCachedResponses is the number of responses that are held for revalidation. Once it is reached, the response that
+// was least recently used is discarded, so that the memory held by a long plan or apply is bounded.
+const maxCachedResponses = 256
+
+// cachedResponse is a response to a GET request that carried an ETag, and so can be revalidated with the server.
+type cachedResponse struct {
+	body       []byte
+	etag       string
+	header     http.Header
+	key        string
+	status     string
+	statusCode int
+}
+
+type conditionalRequestTransport struct {
+	maxResponses int
+	mutex        sync.Mutex
+	recentlyUsed *list.List
+	responses    map[string]*list.Element
+	transport    http.RoundTripper
+}
+
+// RoundTrip sends a GET request for a URL that has been read before with the ETag of the earlier response in
+// If-None-Match. When the server replies that the resource has not been modified, the earlier response is returned
+// without the server sending it again. Any other request for the URL discards the earlier response.
+func (t *conditionalRequestTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	key := request.URL.String()
+	if request.Method != http.MethodGet || len(request.Header.Get("If-None-Match")) > 0 {
+		t.forget(key)
+		return t.transport.RoundTrip(request)
+	}
+
+	cached := t.get(key)
+
+	if cached != nil {
+		request = request.Clone(request.Context())
+		request.Header.Set("If-None-Match", cached.etag)
+	}
+
+	response, err := t.transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if cached != nil && response.StatusCode == http.StatusNotModified {
+		io.Copy(io.Discard, response.Body)
+		response.Body.Close()
+
+		return &http.Response{
+			Body:          io.NopCloser(bytes.NewReader(cached.body)),
+			ContentLength: int64(len(cached.body)),
+			Header:        cached.header.Clone(),
+			Proto:         response.Proto,
+			ProtoMajor:    response.ProtoMajor,
+			ProtoMinor:    response.ProtoMinor,
+			Request:       request,
+			Status:        cached.status,
+			StatusCode:    cached.statusCode,
+		}, nil
+	}
+
+	etag := response.Header.Get("ETag")
+	if response.StatusCode != http.StatusOK || len(etag) == 0 {
+		t.forget(key)
+		return response, nil
+	}
+
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	t.store(&cachedResponse{
+		body:       body,
+		etag:       etag,
+		header:     response.Header.Clone(),
+		key:        key,
+		status:     response.Status,
+		statusCode: response.StatusCode,
+	})
+
+	return response, nil
+}
+
+// get returns the response held for the key, if any, and marks it as the most recently used.
+func (t *conditionalRequestTransport) get(key string) *cachedResponse {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	element, ok := t.responses[key]
+	if !ok {
+		return nil
+	}
+
+	t.recentlyUsed.MoveToFront(element)
+	return element.Value.(*cachedResponse)
+}
+
+// store holds the response, discarding the least recently used response when too many are held.
+func (t *conditionalRequestTransport) store(cached *cachedResponse) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if element, ok := t.responses[cached.key]; ok {
+		element.Value = cached
+		t.recentlyUsed.MoveToFront(element)
+		return
+	}
+
+	t.responses[cached.key] = t.recentlyUsed.PushFront(cached)
+	if t.recentlyUsed.Len() > t.maxResponses {
+		oldest := t.recentlyUsed.Back()
+		t.recentlyUsed.Remove(oldest)
+		delete(t.responses, oldest.Value.(*cachedResponse).key)
+	}
+}
+
+func (t *conditionalRequestTransport) forget(key string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if element, ok := t.responses[key]; ok {
+		t.recentlyUsed.Remove(element)
+		delete(t.responses, key)
+	}
+}
+
+// newConditionalRequestHTTPClient returns a copy of the HTTP client that revalidates the responses to GET requests
+// that carried an ETag rather than downloading them again. The most recently used responses are held for the lifetime
+// of the provider.
+func newConditionalRequestHTTPClient(httpClient *http.Client) *http.Client {
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	conditionalRequestHTTPClient := *httpClient
+	conditionalRequestHTTPClient.Transport = &conditionalRequestTransport{
+		maxResponses: maxCachedResponses,
+		recentlyUsed: list.New(),
+		responses:    map[string]*list.Element{},
+		transport:    transport,
+	}
+
+	return &conditionalRequestHTTPClient
+}
@@ -0,0 +1,109 @@
+package octopusdeploy
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionalRequestHTTPClient(t *testing.T) {
+	etag := `"1"`
+	body := `{"Id":"TagSets-1","Name":"Regions"}`
+	notModified := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			etag = `"2"`
+			body = `{"Id":"TagSets-1","Name":"Zones"}`
+			return
+		}
+
+		if r.URL.Path == "/api/Spaces-1/tagsets/TagSets-1" {
+			if r.Header.Get("If-None-Match") == etag {
+				notModified++
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", etag)
+		}
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	httpClient := newConditionalRequestHTTPClient(&http.Client{})
+	get := func(path string) string {
+		response, err := httpClient.Get(server.URL + path)
+		require.NoError(t, err)
+		defer response.Body.Close()
+		require.Equal(t, http.StatusOK, response.StatusCode)
+		content, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		return string(content)
+	}
+
+	require.Equal(t, `{"Id":"TagSets-1","Name":"Regions"}`, get("/api/Spaces-1/tagsets/TagSets-1"))
+	require.Equal(t, `{"Id":"TagSets-1","Name":"Regions"}`, get("/api/Spaces-1/tagsets/TagSets-1"))
+	require.Equal(t, 1, notModified)
+
+	// a resource that has changed is sent again
+	request, err := http.NewRequest(http.MethodPut, server.URL+"/api/Spaces-1/tagsets/TagSets-1", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	response, err := httpClient.Do(request)
+	require.NoError(t, err)
+	response.Body.Close()
+
+	require.Equal(t, `{"Id":"TagSets-1","Name":"Zones"}`, get("/api/Spaces-1/tagsets/TagSets-1"))
+	require.Equal(t, 1, notModified)
+
+	// responses without an ETag are not held on to
+	require.Equal(t, `{"Id":"TagSets-1","Name":"Zones"}`, get("/api/Spaces-1/tagsets"))
+	require.Len(t, httpClient.Transport.(*conditionalRequestTransport).responses, 1)
+}
+
+func TestConditionalRequestHTTPClientLimit(t *testing.T) {
+	revalidated := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"1"` {
+			revalidated[r.URL.Path]++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"1"`)
+		w.Write([]byte(r.URL.Path))
+	}))
+	defer server.Close()
+
+	httpClient := newConditionalRequestHTTPClient(&http.Client{})
+	transport := httpClient.Transport.(*conditionalRequestTransport)
+	transport.maxResponses = 2
+
+	get := func(path string) {
+		response, err := httpClient.Get(server.URL + path)
+		require.NoError(t, err)
+		defer response.Body.Close()
+		content, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		require.Equal(t, path, string(content))
+	}
+
+	get("/api/Spaces-1/environments/Environments-1")
+	get("/api/Spaces-1/environments/Environments-2")
+	get("/api/Spaces-1/environments/Environments-1")
+	require.Equal(t, 1, revalidated["/api/Spaces-1/environments/Environments-1"])
+
+	// the least recently used response is discarded once the limit is reached
+	get("/api/Spaces-1/environments/Environments-3")
+	require.Len(t, transport.responses, 2)
+	require.Equal(t, 2, transport.recentlyUsed.Len())
+	require.NotContains(t, transport.responses, server.URL+"/api/Spaces-1/environments/Environments-2")
+
+	get("/api/Spaces-1/environments/Environments-2")
+	require.Zero(t, revalidated["/api/Spaces-1/environments/Environments-2"])
+
+	get("/api/Spaces-1/environments/Environments-3")
+	require.Equal(t, 1, revalidated["/api/Spaces-1/environments/Environments-3"])
+}
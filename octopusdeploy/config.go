@@ -1,42 +1,285 @@
 package octopusdeploy
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"net/url"
+	"os"
+	"time"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/spaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
-// Config holds Address and the APIKey of the Octopus Deploy server
+// Config holds Address, the credentials and the SpaceID of the Octopus Deploy server
 type Config struct {
-	Address string
-	APIKey  string
-	SpaceID string
+	AccessToken              string
+	Address                  string
+	APIKey                   string
+	CACertFile               string
+	CACertPEM                string
+	CredentialsFile          string
+	IDToken                  string
+	MaxConcurrentRequests    int
+	MaxProcessUpdateAttempts int
+	MaxRequestsPerSecond     int
+	ProxyPassword            string
+	ProxyURL                 string
+	ProxyUsername            string
+	ServiceAccountID         string
+	SkipTLSVerification      bool
+	SpaceID                  string
+	SpaceName                string
+	ValidateReferences       bool
 }
 
-// Client returns a new Octopus Deploy client
-func (c *Config) Client() (*client.Client, diag.Diagnostics) {
+// loadEnvironment fills the credentials and space from environment variables when none of the mutually exclusive
+// settings of each is configured in the provider block, so that the provider block always takes precedence.
+func (c *Config) loadEnvironment() {
+	if len(c.AccessToken) == 0 && len(c.APIKey) == 0 && len(c.IDToken) == 0 {
+		c.AccessToken = getEnv("OCTOPUS_ACCESS_TOKEN")
+		c.APIKey = getEnv("OCTOPUS_API_KEY", "OCTOPUS_APIKEY")
+		c.IDToken = getEnv("OCTOPUS_ID_TOKEN")
+	}
+
+	if len(c.SpaceID) == 0 && len(c.SpaceName) == 0 {
+		c.SpaceID = getEnv("OCTOPUS_SPACE_ID", "OCTOPUS_SPACE")
+		c.SpaceName = getEnv("OCTOPUS_SPACE_NAME")
+	}
+}
+
+// getEnv returns the value of the first of the given environment variables that is set.
+func getEnv(keys ...string) string {
+	for _, key := range keys {
+		if value := os.Getenv(key); len(value) > 0 {
+			return value
+		}
+	}
+	return ""
+}
+
+type credentialsFile struct {
+	AccessToken string `json:"access_token"`
+	Address     string `json:"address"`
+	APIKey      string `json:"api_key"`
+	SpaceID     string `json:"space_id"`
+	SpaceName   string `json:"space_name"`
+}
+
+// loadCredentialsFile fills the settings that are not already configured from a credentials file. Its credentials
+// are only used if no credentials are configured.
+func (c *Config) loadCredentialsFile(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading the credentials file: %s", err)
+	}
+
+	var credentials credentialsFile
+	if err := json.Unmarshal(content, &credentials); err != nil {
+		return fmt.Errorf("error reading the credentials file %s: %s", path, err)
+	}
+
+	if len(c.Address) == 0 {
+		c.Address = credentials.Address
+	}
+
+	if len(c.AccessToken) == 0 && len(c.APIKey) == 0 && len(c.IDToken) == 0 {
+		c.AccessToken = credentials.AccessToken
+		c.APIKey = credentials.APIKey
+	}
+
+	if len(c.SpaceID) == 0 && len(c.SpaceName) == 0 {
+		c.SpaceID = credentials.SpaceID
+		c.SpaceName = credentials.SpaceName
+	}
+
+	return nil
+}
+
+// httpClient returns the HTTP client used to call the Octopus REST API, trusting the configured CA certificates in
+// addition to those of the system, or skipping verification of the certificate of the server altogether. Requests
+// go through the configured proxy, or otherwise the proxy given by the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func (c *Config) httpClient() (*http.Client, error) {
+	if len(c.CACertFile) == 0 && len(c.CACertPEM) == 0 && len(c.ProxyURL) == 0 && !c.SkipTLSVerification {
+		return &http.Client{}, nil
+	}
+
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+
+	if len(c.CACertFile) > 0 {
+		caCert, err := os.ReadFile(c.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the CA certificate file: %s", err)
+		}
+
+		if !rootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("the CA certificate file %s does not contain a PEM-encoded certificate", c.CACertFile)
+		}
+	}
+
+	if len(c.CACertPEM) > 0 && !rootCAs.AppendCertsFromPEM([]byte(c.CACertPEM)) {
+		return nil, fmt.Errorf("ca_cert_pem does not contain a PEM-encoded certificate")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: c.SkipTLSVerification,
+		RootCAs:            rootCAs,
+	}
+
+	if len(c.ProxyURL) > 0 {
+		proxyURL, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing proxy_url: %s", err)
+		}
+
+		if len(c.ProxyUsername) > 0 {
+			proxyURL.User = url.UserPassword(c.ProxyUsername, c.ProxyPassword)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return &http.Client{Transport: transport}, nil
+}
+
+// Client returns a new Octopus Deploy client. Credentials and the space are taken from the provider block first, then
+// from environment variables, and then from the credentials file.
+func (c *Config) Client(ctx context.Context) (*client.Client, diag.Diagnostics) {
+	c.loadEnvironment()
+
+	if len(c.CredentialsFile) > 0 {
+		if err := c.loadCredentialsFile(c.CredentialsFile); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	if len(c.Address) == 0 {
+		return nil, diag.Errorf("address must be configured")
+	}
+
 	apiURL, err := url.Parse(c.Address)
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
 
-	octopus, err := client.NewClient(nil, apiURL, c.APIKey, "")
+	credentials := 0
+	for _, credential := range []string{c.AccessToken, c.APIKey, c.IDToken} {
+		if len(credential) > 0 {
+			credentials++
+		}
+	}
+
+	switch {
+	case credentials == 0:
+		return nil, diag.Errorf("one of access_token, api_key or id_token must be configured")
+	case credentials > 1:
+		return nil, diag.Errorf("only one of access_token, api_key or id_token can be configured")
+	}
+
+	if len(c.SpaceID) > 0 && len(c.SpaceName) > 0 {
+		return nil, diag.Errorf("only one of space_id or space_name can be configured")
+	}
+
+	httpClient, err := c.httpClient()
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
+	httpClient = newRateLimitedHTTPClient(httpClient, c.MaxConcurrentRequests, c.MaxRequestsPerSecond)
+	httpClient = newConditionalRequestHTTPClient(httpClient)
+
+	var diags diag.Diagnostics
+	if c.SkipTLSVerification {
+		diags = append(diags, diag.Diagnostic{
+			Detail:   fmt.Sprintf("The certificate of the Octopus Server at %s is not verified, so the connection to it, including its credentials, can be intercepted. Only use skip_tls_verification with servers in lab environments.", c.Address),
+			Severity: diag.Warning,
+			Summary:  "TLS verification is disabled",
+		})
+	}
 
-	if len(c.SpaceID) > 0 {
-		space, err := octopus.Spaces.GetByID(c.SpaceID)
+	apiKey := c.APIKey
+	accessToken := c.AccessToken
+	credential := "api_key"
+
+	var exchange accessTokenExchange
+	var expiry time.Time
+
+	if len(apiKey) > 0 && !client.IsAPIKey(apiKey) {
+		return nil, append(diags, diag.Errorf("api_key is not a valid API key; API keys begin with API- (e.g. API-XXXXXXXXXXXXX)")...)
+	}
+
+	if len(accessToken) > 0 {
+		credential = "access_token"
+	}
+
+	if len(c.IDToken) > 0 {
+		credential = "id_token"
+
+		if len(c.ServiceAccountID) == 0 {
+			return nil, append(diags, diag.Errorf("service_account_id must be configured to authenticate with an ID token")...)
+		}
+
+		// the access token is exchanged again with the same ID token when it expires, using the HTTP client that
+		// does not send the access token
+		idTokenHTTPClient := httpClient
+		exchange = func() (string, time.Time, error) {
+			return exchangeIDToken(idTokenHTTPClient, apiURL, c.ServiceAccountID, c.IDToken)
+		}
+
+		accessToken, expiry, err = exchange()
 		if err != nil {
-			return nil, diag.FromErr(err)
+			return nil, append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if len(accessToken) > 0 {
+		httpClient = newAccessTokenHTTPClient(httpClient, accessToken, expiry, exchange)
+		apiKey = accessTokenAPIKey
+	}
+
+	diags = append(diags, checkServer(ctx, httpClient, apiURL, apiKey, credential)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	octopus, err := client.NewClient(httpClient, apiURL, apiKey, "")
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+
+	var space *spaces.Space
+	switch {
+	case len(c.SpaceID) > 0:
+		space, err = octopus.Spaces.GetByID(c.SpaceID)
+		if err != nil {
+			if apiError, ok := err.(*core.APIError); ok && apiError.StatusCode == http.StatusNotFound {
+				return nil, append(diags, diag.Errorf("the space %s could not be found; check that it exists and that the %s of the provider has access to it", c.SpaceID, credential)...)
+			}
+			return nil, append(diags, diag.FromErr(err)...)
+		}
+	case len(c.SpaceName) > 0:
+		space, err = octopus.Spaces.GetByName(c.SpaceName)
+		if err != nil {
+			return nil, append(diags, diag.Errorf("error finding the space named %s: %s", c.SpaceName, err)...)
 		}
+	}
 
-		octopus, err = client.NewClient(nil, apiURL, c.APIKey, space.GetID())
+	if space != nil {
+		octopus, err = client.NewClient(httpClient, apiURL, apiKey, space.GetID())
 		if err != nil {
-			return nil, diag.FromErr(err)
+			return nil, append(diags, diag.FromErr(err)...)
 		}
 	}
 
-	return octopus, nil
+	return octopus, diags
 }
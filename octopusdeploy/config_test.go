@@ -0,0 +1,207 @@
+package octopusdeploy
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/require"
+)
+
+// clearConfigEnvironment unsets the environment variables of the credentials and space for the duration of a test.
+func clearConfigEnvironment(t *testing.T) {
+	for _, key := range []string{"OCTOPUS_ACCESS_TOKEN", "OCTOPUS_API_KEY", "OCTOPUS_APIKEY", "OCTOPUS_ID_TOKEN", "OCTOPUS_SPACE", "OCTOPUS_SPACE_ID", "OCTOPUS_SPACE_NAME"} {
+		t.Setenv(key, "")
+	}
+}
+
+func TestConfigClientCredentials(t *testing.T) {
+	clearConfigEnvironment(t)
+
+	config := Config{Address: "https://octopus.example.com"}
+	_, diags := config.Client(context.Background())
+	require.True(t, diags.HasError())
+	require.Equal(t, "one of access_token, api_key or id_token must be configured", diags[0].Summary)
+
+	config = Config{AccessToken: "access-token", Address: "https://octopus.example.com", APIKey: "API-XXXXXXXXXXXXX"}
+	_, diags = config.Client(context.Background())
+	require.True(t, diags.HasError())
+	require.Equal(t, "only one of access_token, api_key or id_token can be configured", diags[0].Summary)
+
+	config = Config{Address: "https://octopus.example.com", APIKey: "API-XXXXXXXXXXXXX", SpaceID: "Spaces-1", SpaceName: "Default"}
+	_, diags = config.Client(context.Background())
+	require.True(t, diags.HasError())
+	require.Equal(t, "only one of space_id or space_name can be configured", diags[0].Summary)
+
+	config = Config{Address: "https://octopus.example.com", APIKey: "not-an-api-key"}
+	_, diags = config.Client(context.Background())
+	require.True(t, diags.HasError())
+	require.Equal(t, "api_key is not a valid API key; API keys begin with API- (e.g. API-XXXXXXXXXXXXX)", diags[0].Summary)
+
+	config = Config{Address: "https://octopus.example.com", IDToken: "id-token"}
+	_, diags = config.Client(context.Background())
+	require.True(t, diags.HasError())
+	require.Equal(t, "service_account_id must be configured to authenticate with an ID token", diags[0].Summary)
+
+	// skipping TLS verification is always warned about
+	config = Config{Address: "https://octopus.example.com", IDToken: "id-token", SkipTLSVerification: true}
+	_, diags = config.Client(context.Background())
+	require.Len(t, diags, 2)
+	require.Equal(t, diag.Warning, diags[0].Severity)
+	require.Equal(t, "TLS verification is disabled", diags[0].Summary)
+	require.True(t, diags.HasError())
+}
+
+func TestConfigLoadEnvironment(t *testing.T) {
+	clearConfigEnvironment(t)
+	t.Setenv("OCTOPUS_API_KEY", "API-ENVIRONMENT")
+	t.Setenv("OCTOPUS_SPACE_ID", "Spaces-2")
+
+	config := Config{}
+	config.loadEnvironment()
+	require.Equal(t, "API-ENVIRONMENT", config.APIKey)
+	require.Equal(t, "Spaces-2", config.SpaceID)
+
+	// a credential or space in the provider block takes precedence over all of the environment variables
+	config = Config{AccessToken: "access-token", SpaceName: "Default"}
+	config.loadEnvironment()
+	require.Equal(t, "access-token", config.AccessToken)
+	require.Empty(t, config.APIKey)
+	require.Empty(t, config.SpaceID)
+	require.Equal(t, "Default", config.SpaceName)
+
+	config = Config{APIKey: "API-BLOCK", SpaceID: "Spaces-1"}
+	config.loadEnvironment()
+	require.Equal(t, "API-BLOCK", config.APIKey)
+	require.Equal(t, "Spaces-1", config.SpaceID)
+
+	// the older environment variables are used when the newer ones are not set
+	t.Setenv("OCTOPUS_API_KEY", "")
+	t.Setenv("OCTOPUS_APIKEY", "API-LEGACY")
+	t.Setenv("OCTOPUS_SPACE_ID", "")
+	t.Setenv("OCTOPUS_SPACE", "Spaces-3")
+
+	config = Config{}
+	config.loadEnvironment()
+	require.Equal(t, "API-LEGACY", config.APIKey)
+	require.Equal(t, "Spaces-3", config.SpaceID)
+}
+
+func TestConfigClientEnvironment(t *testing.T) {
+	clearConfigEnvironment(t)
+	t.Setenv("OCTOPUS_API_KEY", "API-XXXXXXXXXXXXX")
+	t.Setenv("OCTOPUS_SPACE_ID", "Spaces-1")
+
+	// an ID token in the provider block is used in place of the API key from the environment
+	config := Config{Address: "https://octopus.example.com", IDToken: "id-token", SpaceName: "Default"}
+	_, diags := config.Client(context.Background())
+	require.True(t, diags.HasError())
+	require.Equal(t, "service_account_id must be configured to authenticate with an ID token", diags[0].Summary)
+	require.Empty(t, config.APIKey)
+	require.Empty(t, config.SpaceID)
+
+	// environment variables take precedence over the credentials file
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"access_token": "access-token", "space_name": "Default"}`), 0600))
+
+	config = Config{CredentialsFile: path}
+	_, diags = config.Client(context.Background())
+	require.True(t, diags.HasError())
+	require.Equal(t, "address must be configured", diags[0].Summary)
+	require.Equal(t, "API-XXXXXXXXXXXXX", config.APIKey)
+	require.Empty(t, config.AccessToken)
+	require.Equal(t, "Spaces-1", config.SpaceID)
+	require.Empty(t, config.SpaceName)
+
+	// credentials that conflict within the environment are still reported
+	t.Setenv("OCTOPUS_ACCESS_TOKEN", "access-token")
+	config = Config{Address: "https://octopus.example.com"}
+	_, diags = config.Client(context.Background())
+	require.True(t, diags.HasError())
+	require.Equal(t, "only one of access_token, api_key or id_token can be configured", diags[0].Summary)
+}
+
+func TestConfigLoadCredentialsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"address": "https://octopus.example.com",
+		"api_key": "API-XXXXXXXXXXXXX",
+		"space_id": "Spaces-1"
+	}`), 0600))
+
+	config := Config{}
+	require.NoError(t, config.loadCredentialsFile(path))
+	require.Equal(t, "https://octopus.example.com", config.Address)
+	require.Equal(t, "API-XXXXXXXXXXXXX", config.APIKey)
+	require.Equal(t, "Spaces-1", config.SpaceID)
+
+	// settings from the provider block or environment variables take precedence over the file
+	config = Config{AccessToken: "access-token", SpaceID: "Spaces-2"}
+	require.NoError(t, config.loadCredentialsFile(path))
+	require.Equal(t, "https://octopus.example.com", config.Address)
+	require.Equal(t, "access-token", config.AccessToken)
+	require.Empty(t, config.APIKey)
+	require.Equal(t, "Spaces-2", config.SpaceID)
+
+	config = Config{SpaceName: "Default"}
+	require.NoError(t, config.loadCredentialsFile(path))
+	require.Empty(t, config.SpaceID)
+	require.Equal(t, "Default", config.SpaceName)
+
+	require.Error(t, config.loadCredentialsFile(filepath.Join(t.TempDir(), "missing.json")))
+}
+
+func TestConfigHTTPClientCACertificates(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caCertFile, caCert, 0600))
+
+	// the certificate of the server is not trusted by default
+	httpClient, err := (&Config{}).httpClient()
+	require.NoError(t, err)
+	_, err = httpClient.Get(server.URL)
+	require.Error(t, err)
+
+	for _, config := range []Config{{CACertFile: caCertFile}, {CACertPEM: string(caCert)}} {
+		httpClient, err := config.httpClient()
+		require.NoError(t, err)
+
+		response, err := httpClient.Get(server.URL)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, response.StatusCode)
+	}
+
+	httpClient, err = (&Config{SkipTLSVerification: true}).httpClient()
+	require.NoError(t, err)
+	response, err := httpClient.Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	_, err = (&Config{CACertPEM: "not a certificate"}).httpClient()
+	require.Error(t, err)
+}
+
+func TestConfigHTTPClientProxy(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "octopus.example.com", r.Host)
+		require.Equal(t, "Basic "+base64.StdEncoding.EncodeToString([]byte("proxy-user:proxy-password")), r.Header.Get("Proxy-Authorization"))
+	}))
+	defer proxy.Close()
+
+	config := Config{ProxyPassword: "proxy-password", ProxyURL: proxy.URL, ProxyUsername: "proxy-user"}
+	httpClient, err := config.httpClient()
+	require.NoError(t, err)
+
+	response, err := httpClient.Get("http://octopus.example.com/api")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+}
@@ -0,0 +1,96 @@
+package octopusdeploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// minimumServerMajorVersion and minimumServerMinorVersion are the oldest version of Octopus Server that the provider
+// supports.
+const (
+	minimumServerMajorVersion = 2020
+	minimumServerMinorVersion = 1
+)
+
+// checkServer reads the root document of the Octopus Server and verifies that the server accepts the credentials of
+// the provider, so that an unreachable server or rejected credentials are reported when the provider is configured
+// rather than by the first resource that calls the Octopus REST API.
+func checkServer(ctx context.Context, httpClient *http.Client, apiURL *url.URL, apiKey string, credential string) diag.Diagnostics {
+	baseURL := strings.TrimRight(apiURL.String(), "/") + "/api"
+
+	rootResponse, err := httpClient.Get(baseURL)
+	if err != nil {
+		return diag.Diagnostics{{
+			Detail:   fmt.Sprintf("The Octopus Server at %s could not be reached: %s. Check the address of the provider and, if they are configured, its proxy and CA certificates.", apiURL, err),
+			Severity: diag.Error,
+			Summary:  "Unable to connect to the Octopus Server",
+		}}
+	}
+	defer rootResponse.Body.Close()
+
+	var root client.RootResource
+	if rootResponse.StatusCode != http.StatusOK || json.NewDecoder(rootResponse.Body).Decode(&root) != nil || len(root.Version) == 0 {
+		return diag.Diagnostics{{
+			Detail:   fmt.Sprintf("%s did not return the root document of the Octopus REST API (%s). Check that the address of the provider is the URL of the Octopus Server, without the /api path.", baseURL, rootResponse.Status),
+			Severity: diag.Error,
+			Summary:  "Unable to connect to the Octopus Server",
+		}}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("connected to Octopus Server %s at %s", root.Version, apiURL))
+
+	var diags diag.Diagnostics
+	if !isSupportedServerVersion(root.Version) {
+		diags = append(diags, diag.Diagnostic{
+			Detail:   fmt.Sprintf("The Octopus Server at %s is version %s. The provider supports Octopus Server %d.%d and later; resources may fail with errors from the Octopus REST API.", apiURL, root.Version, minimumServerMajorVersion, minimumServerMinorVersion),
+			Severity: diag.Warning,
+			Summary:  "Unsupported version of Octopus Server",
+		})
+	}
+
+	request, err := http.NewRequest(http.MethodGet, baseURL+"/users/me", nil)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	request.Header.Set(constants.ClientAPIKeyHTTPHeader, apiKey)
+
+	userResponse, err := httpClient.Do(request)
+	if err != nil {
+		return append(diags, diag.Errorf("error verifying the %s of the provider with the Octopus Server at %s: %s", credential, apiURL, err)...)
+	}
+	defer userResponse.Body.Close()
+
+	switch userResponse.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized, http.StatusForbidden:
+		diags = append(diags, diag.Diagnostic{
+			Detail:   fmt.Sprintf("The Octopus Server at %s rejected the %s of the provider (%s). Check that it is correct and has not expired or been revoked.", apiURL, credential, userResponse.Status),
+			Severity: diag.Error,
+			Summary:  "Invalid Octopus Deploy credentials",
+		})
+	default:
+		diags = append(diags, diag.Errorf("error verifying the %s of the provider with the Octopus Server at %s: %s", credential, apiURL, userResponse.Status)...)
+	}
+
+	return diags
+}
+
+// isSupportedServerVersion reports whether a version of Octopus Server is supported by the provider. Versions that
+// cannot be parsed, and local builds of Octopus Server (version 0.0), are assumed to be supported.
+func isSupportedServerVersion(version string) bool {
+	major, minor, err := parseServerVersion(version)
+	if err != nil || major == 0 {
+		return true
+	}
+
+	return major > minimumServerMajorVersion || (major == minimumServerMajorVersion && minor >= minimumServerMinorVersion)
+}
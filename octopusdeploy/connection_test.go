@@ -0,0 +1,78 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOctopusServer(t *testing.T, version string) *url.URL {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api":
+			fmt.Fprintf(w, `{"Application": "Octopus Deploy", "Version": "%s", "ApiVersion": "3.0.0", "InstallationId": "b1d2e3f4-0000-4000-8000-000000000000"}`, version)
+		case "/api/users/me":
+			if r.Header.Get(constants.ClientAPIKeyHTTPHeader) != "API-VALID" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, `{"Id": "Users-1"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return serverURL
+}
+
+func TestCheckServer(t *testing.T) {
+	serverURL := newTestOctopusServer(t, "2023.2.12345")
+
+	diags := checkServer(context.Background(), http.DefaultClient, serverURL, "API-VALID", "api_key")
+	require.Empty(t, diags)
+
+	diags = checkServer(context.Background(), http.DefaultClient, serverURL, "API-INVALID", "api_key")
+	require.True(t, diags.HasError())
+	require.Equal(t, "Invalid Octopus Deploy credentials", diags[0].Summary)
+	require.Contains(t, diags[0].Detail, "api_key")
+
+	// the address of the provider must not include the /api path
+	apiURL, err := url.Parse(serverURL.String() + "/api")
+	require.NoError(t, err)
+	diags = checkServer(context.Background(), http.DefaultClient, apiURL, "API-VALID", "api_key")
+	require.True(t, diags.HasError())
+	require.Equal(t, "Unable to connect to the Octopus Server", diags[0].Summary)
+
+	unreachableURL, err := url.Parse("http://127.0.0.1:1")
+	require.NoError(t, err)
+	diags = checkServer(context.Background(), http.DefaultClient, unreachableURL, "API-VALID", "api_key")
+	require.True(t, diags.HasError())
+	require.Equal(t, "Unable to connect to the Octopus Server", diags[0].Summary)
+}
+
+func TestCheckServerUnsupportedVersion(t *testing.T) {
+	serverURL := newTestOctopusServer(t, "2019.13.7")
+
+	diags := checkServer(context.Background(), http.DefaultClient, serverURL, "API-VALID", "api_key")
+	require.Len(t, diags, 1)
+	require.Equal(t, diag.Warning, diags[0].Severity)
+	require.Equal(t, "Unsupported version of Octopus Server", diags[0].Summary)
+}
+
+func TestIsSupportedServerVersion(t *testing.T) {
+	require.True(t, isSupportedServerVersion("2023.2.12345"))
+	require.True(t, isSupportedServerVersion("2020.1.0"))
+	require.False(t, isSupportedServerVersion("2019.13.7"))
+	require.True(t, isSupportedServerVersion("0.0.0-local"))
+	require.True(t, isSupportedServerVersion("latest"))
+}
@@ -5,7 +5,7 @@ import (
 	"time"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/accounts"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -28,14 +28,28 @@ func dataSourceAccountsRead(ctx context.Context, d *schema.ResourceData, m inter
 		Take:        d.Get("take").(int),
 	}
 
-	client := m.(*client.Client)
-	existingAccounts, err := client.Accounts.Get(query)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firstPage, err := client.Accounts.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingAccounts, err := getAllPages((*resources.Resources[accounts.IAccount])(firstPage), query.Skip, query.Take, func(skip int) (*resources.Resources[accounts.IAccount], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		page, err := client.Accounts.Get(pageQuery)
+		return (*resources.Resources[accounts.IAccount])(page), err
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedAccounts := []interface{}{}
-	for _, account := range existingAccounts.Items {
+	for _, account := range existingAccounts {
 		accountResource, err := accounts.ToAccountResource(account)
 		if err != nil {
 			return diag.FromErr(err)
@@ -4,7 +4,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,14 +35,18 @@ func dataSourceAzureCloudServiceDeploymentTargetsRead(ctx context.Context, d *sc
 		Thumbprint:          d.Get("thumbprint").(string),
 	}
 
-	client := m.(*client.Client)
-	existingDeploymentTargets, err := client.Machines.Get(query)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingDeploymentTargets, err := getDeploymentTargets(client, query)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedAzureCloudServiceDeploymentTargets := []interface{}{}
-	for _, deploymentTarget := range existingDeploymentTargets.Items {
+	for _, deploymentTarget := range existingDeploymentTargets {
 		flattenedAzureCloudServiceDeploymentTargets = append(flattenedAzureCloudServiceDeploymentTargets, flattenAzureCloudServiceDeploymentTarget(deploymentTarget))
 	}
 
@@ -4,7 +4,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -37,14 +36,18 @@ func dataSourceAzureWebAppDeploymentTargetsRead(ctx context.Context, d *schema.R
 		Thumbprint:          d.Get("thumbprint").(string),
 	}
 
-	client := m.(*client.Client)
-	existingDeploymentTargets, err := client.Machines.Get(query)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingDeploymentTargets, err := getDeploymentTargets(client, query)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedAzureWebAppDeploymentTargets := []interface{}{}
-	for _, deploymentTarget := range existingDeploymentTargets.Items {
+	for _, deploymentTarget := range existingDeploymentTargets {
 		flattenedAzureWebAppDeploymentTargets = append(flattenedAzureWebAppDeploymentTargets, flattenAzureWebAppDeploymentTarget(deploymentTarget))
 	}
 
@@ -0,0 +1,79 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/buildinformation"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceBuildInformation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about the build information of a version of a package, such as its commits and work items.",
+		ReadContext: dataSourceBuildInformationRead,
+		Schema:      getBuildInformationDataSchema(),
+	}
+}
+
+func dataSourceBuildInformationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	packageID := d.Get("package_id").(string)
+	version := d.Get("version").(string)
+
+	query := buildinformation.BuildInformationQuery{
+		PackageID: packageID,
+	}
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the build information service cannot query build information, so it is read from its path directly
+	path, err := client.BuildInformation.GetURITemplate().Expand(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := api.ApiGet(client.BuildInformation.GetClient(), new(resources.Resources[*buildinformation.BuildInformation]), path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingBuildInformation, err := getAllPages(response.(*resources.Resources[*buildinformation.BuildInformation]), query.Skip, query.Take, func(skip int) (*resources.Resources[*buildinformation.BuildInformation], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+
+		path, err := client.BuildInformation.GetURITemplate().Expand(pageQuery)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := api.ApiGet(client.BuildInformation.GetClient(), new(resources.Resources[*buildinformation.BuildInformation]), path)
+		if err != nil {
+			return nil, err
+		}
+		return response.(*resources.Resources[*buildinformation.BuildInformation]), nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, buildInformation := range existingBuildInformation {
+		if buildInformation.Version != version {
+			continue
+		}
+
+		if err := setBuildInformation(d, buildInformation); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(buildInformation.GetID())
+
+		return nil
+	}
+
+	return diag.Errorf("no build information found for package %s with version %s", packageID, version)
+}
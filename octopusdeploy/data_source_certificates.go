@@ -5,7 +5,7 @@ import (
 	"time"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/certificates"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -31,14 +31,27 @@ func dataSourceCertificatesRead(ctx context.Context, d *schema.ResourceData, m i
 		Tenant:      d.Get("tenant").(string),
 	}
 
-	client := m.(*client.Client)
-	existingCertificates, err := client.Certificates.Get(query)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firstPage, err := client.Certificates.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingCertificates, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*certificates.CertificateResource], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return client.Certificates.Get(pageQuery)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedCertificates := []interface{}{}
-	for _, certificate := range existingCertificates.Items {
+	for _, certificate := range existingCertificates {
 		flattenedCertificates = append(flattenedCertificates, flattenCertificate(certificate))
 	}
 
@@ -2,12 +2,14 @@ package octopusdeploy
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/channels"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
 )
 
 func dataSourceChannels() *schema.Resource {
@@ -26,14 +28,43 @@ func dataSourceChannelsRead(ctx context.Context, d *schema.ResourceData, m inter
 		Take:        d.Get("take").(int),
 	}
 
-	client := m.(*client.Client)
-	existingChannels, err := client.Channels.Get(query)
+	client, err := getSpaceClient(m, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	var existingChannels []*channels.Channel
+	if projectID, ok := d.GetOk("project_id"); ok {
+		project, err := client.Projects.GetByID(projectID.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// the channels of a project cannot be queried, so the other filters are applied to all of its channels
+		projectChannels, err := client.Projects.GetChannels(project)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		existingChannels = filterChannels(projectChannels, query)
+	} else {
+		firstPage, err := client.Channels.Get(query)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		existingChannels, err = getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*channels.Channel], error) {
+			pageQuery := query
+			pageQuery.Skip = skip
+			return client.Channels.Get(pageQuery)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	flattenedChannels := []interface{}{}
-	for _, channel := range existingChannels.Items {
+	for _, channel := range existingChannels {
 		flattenedChannels = append(flattenedChannels, flattenChannel(channel))
 	}
 
@@ -42,3 +73,29 @@ func dataSourceChannelsRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	return nil
 }
+
+func filterChannels(projectChannels []*channels.Channel, query channels.Query) []*channels.Channel {
+	filteredChannels := []*channels.Channel{}
+	for _, channel := range projectChannels {
+		if len(query.IDs) > 0 && !slices.Contains(query.IDs, channel.GetID()) {
+			continue
+		}
+
+		if !strings.Contains(strings.ToLower(channel.Name), strings.ToLower(query.PartialName)) {
+			continue
+		}
+
+		filteredChannels = append(filteredChannels, channel)
+	}
+
+	if query.Skip >= len(filteredChannels) {
+		return []*channels.Channel{}
+	}
+	filteredChannels = filteredChannels[query.Skip:]
+
+	if query.Take > 0 && query.Take < len(filteredChannels) {
+		filteredChannels = filteredChannels[:query.Take]
+	}
+
+	return filteredChannels
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/channels"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccDataSourceChannels(t *testing.T) {
@@ -47,3 +49,24 @@ func testAccDataSourceChannelsConfig(localName string, take int) string {
 		take = %v
 	}`, localName, take)
 }
+
+func TestFilterChannels(t *testing.T) {
+	projectChannels := []*channels.Channel{}
+	for i, name := range []string{"Default", "Hotfix", "Hotfix (Legacy)", "Preview"} {
+		channel := channels.NewChannel(name, "Projects-1")
+		channel.ID = fmt.Sprintf("Channels-%d", i+1)
+		projectChannels = append(projectChannels, channel)
+	}
+
+	filteredChannels := filterChannels(projectChannels, channels.Query{PartialName: "hotfix"})
+	require.Len(t, filteredChannels, 2)
+	require.Equal(t, "Channels-2", filteredChannels[0].GetID())
+	require.Equal(t, "Channels-3", filteredChannels[1].GetID())
+
+	filteredChannels = filterChannels(projectChannels, channels.Query{IDs: []string{"Channels-1", "Channels-4"}, Skip: 1})
+	require.Len(t, filteredChannels, 1)
+	require.Equal(t, "Preview", filteredChannels[0].Name)
+
+	require.Len(t, filterChannels(projectChannels, channels.Query{Take: 3}), 3)
+	require.Empty(t, filterChannels(projectChannels, channels.Query{Skip: 4}))
+}
@@ -0,0 +1,42 @@
+package octopusdeploy
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDeploymentProcess() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about the deployment process of an existing project, such as the slugs of its actions and the packages they reference.",
+		ReadContext: dataSourceDeploymentProcessRead,
+		Schema:      getDeploymentProcessDataSchema(),
+	}
+}
+
+func dataSourceDeploymentProcessRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := client.Projects.GetByID(d.Get("project_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentProcess, err := client.DeploymentProcesses.Get(project, d.Get("branch").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setDeploymentProcessData(d, deploymentProcess); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("DeploymentProcess " + time.Now().UTC().String())
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -37,8 +38,12 @@ func dataSourceDeploymentTargetsRead(ctx context.Context, d *schema.ResourceData
 		Thumbprint:          d.Get("thumbprint").(string),
 	}
 
-	client := m.(*client.Client)
-	existingDeploymentTargets, err := client.Machines.Get(query)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingDeploymentTargets, err := getDeploymentTargets(client, query)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -48,7 +53,7 @@ func dataSourceDeploymentTargetsRead(ctx context.Context, d *schema.ResourceData
 	// flattenedOfflinePackageDropDeploymentTargets := []interface{}{}
 	// flattenedPollingTentacleDeploymentTargets := []interface{}{}
 
-	for _, deploymentTarget := range existingDeploymentTargets.Items {
+	for _, deploymentTarget := range existingDeploymentTargets {
 		// 	switch deploymentTarget.Endpoint.GetCommunicationStyle() {
 		// 	case "OfflineDrop":
 		// 		flattenedOfflinePackageDropDeploymentTargets = append(flattenedOfflinePackageDropDeploymentTargets, flattenOfflinePackageDropDeploymentTarget(deploymentTarget))
@@ -70,3 +75,18 @@ func dataSourceDeploymentTargetsRead(ctx context.Context, d *schema.ResourceData
 
 	return nil
 }
+
+// getDeploymentTargets returns the deployment targets that match a query. All of the pages of deployment targets are
+// requested unless the query asks for a specific page with take.
+func getDeploymentTargets(octopus *client.Client, query machines.MachinesQuery) ([]*machines.DeploymentTarget, error) {
+	firstPage, err := octopus.Machines.Get(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*machines.DeploymentTarget], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return octopus.Machines.Get(pageQuery)
+	})
+}
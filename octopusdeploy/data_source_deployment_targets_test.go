@@ -0,0 +1,54 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceDeploymentTargets(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := fmt.Sprintf("data.octopusdeploy_deployment_targets.%s", localName)
+	role := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentTargetsDataSourceID(name),
+					resource.TestCheckResourceAttr(name, "deployment_targets.#", "0"),
+					resource.TestCheckResourceAttr(name, "roles.0", role),
+				),
+				Config: testAccDataSourceDeploymentTargetsConfig(localName, role),
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentTargetsDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		all := s.RootModule().Resources
+		rs, ok := all[n]
+		if !ok {
+			return fmt.Errorf("cannot find deployment targets data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("snapshot deployment targets source ID not set")
+		}
+		return nil
+	}
+}
+
+func testAccDataSourceDeploymentTargetsConfig(localName string, role string) string {
+	return fmt.Sprintf(`data "octopusdeploy_deployment_targets" "%s" {
+	  communication_styles = ["TentaclePassive"]
+	  health_statuses      = ["Healthy"]
+	  roles                = ["%s"]
+	}`, localName, role)
+}
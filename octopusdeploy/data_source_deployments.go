@@ -0,0 +1,91 @@
+package octopusdeploy
+
+import (
+	"context"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/releases"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDeployments() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about existing deployments, such as the version of their release and the state of their task.",
+		ReadContext: dataSourceDeploymentsRead,
+		Schema:      getDeploymentDataSchema(),
+	}
+}
+
+func dataSourceDeploymentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	query := deployments.DeploymentsQuery{
+		Environments: expandArray(d.Get("environments").([]interface{})),
+		IDs:          expandArray(d.Get("ids").([]interface{})),
+		Projects:     expandArray(d.Get("projects").([]interface{})),
+		Skip:         d.Get("skip").(int),
+		Take:         d.Get("take").(int),
+		TaskState:    d.Get("task_state").(string),
+		Tenants:      expandArray(d.Get("tenants").([]interface{})),
+	}
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the deployment service can only list the deployments of a release, so they are queried directly
+	path, err := client.Deployments.GetURITemplate().Expand(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := api.ApiGet(client.Deployments.GetClient(), new(resources.Resources[*deployments.Deployment]), path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingDeployments := response.(*resources.Resources[*deployments.Deployment]).Items
+
+	releaseIDs := []string{}
+	taskIDs := []string{}
+	for _, deployment := range existingDeployments {
+		releaseIDs = append(releaseIDs, deployment.ReleaseID)
+		taskIDs = append(taskIDs, deployment.TaskID)
+	}
+
+	releasesByID := map[string]*releases.Release{}
+	tasksByID := map[string]*tasks.Task{}
+	if len(existingDeployments) > 0 {
+		existingReleases, err := client.Releases.Get(releases.ReleasesQuery{IDs: releaseIDs, Take: len(releaseIDs)})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, release := range existingReleases.Items {
+			releasesByID[release.GetID()] = release
+		}
+
+		existingTasks, err := client.Tasks.Get(tasks.TasksQuery{IDs: taskIDs, Take: len(taskIDs)})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, task := range existingTasks.Items {
+			tasksByID[task.GetID()] = task
+		}
+	}
+
+	flattenedDeployments := []interface{}{}
+	for _, deployment := range existingDeployments {
+		flattenedDeployments = append(flattenedDeployments, flattenDeploymentData(deployment, releasesByID[deployment.ReleaseID], tasksByID[deployment.TaskID]))
+	}
+
+	d.Set("deployments", flattenedDeployments)
+	d.SetId("Deployments " + time.Now().UTC().String())
+
+	return nil
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/environments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -27,14 +27,27 @@ func dataSourceEnvironmentsRead(ctx context.Context, d *schema.ResourceData, m i
 		Take:        d.Get("take").(int),
 	}
 
-	client := m.(*client.Client)
-	existingEnvironments, err := client.Environments.Get(query)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firstPage, err := client.Environments.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingEnvironments, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*environments.Environment], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return client.Environments.Get(pageQuery)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedEnvironments := []interface{}{}
-	for _, environment := range existingEnvironments.Items {
+	for _, environment := range existingEnvironments {
 		flattenedEnvironments = append(flattenedEnvironments, flattenEnvironment(environment))
 	}
 
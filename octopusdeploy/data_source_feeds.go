@@ -4,8 +4,8 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -27,14 +27,31 @@ func dataSourceFeedsRead(ctx context.Context, d *schema.ResourceData, m interfac
 		Take:        d.Get("take").(int),
 	}
 
-	client := m.(*client.Client)
-	existingFeeds, err := client.Feeds.Get(query)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firstPage, err := client.Feeds.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingFeeds, err := getAllPages(&resources.Resources[feeds.IFeed]{Items: firstPage.Items, PagedResults: firstPage.PagedResults}, query.Skip, query.Take, func(skip int) (*resources.Resources[feeds.IFeed], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		page, err := client.Feeds.Get(pageQuery)
+		if err != nil {
+			return nil, err
+		}
+		return &resources.Resources[feeds.IFeed]{Items: page.Items, PagedResults: page.PagedResults}, nil
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedFeeds := []interface{}{}
-	for _, feed := range existingFeeds.Items {
+	for _, feed := range existingFeeds {
 		feedResource, err := feeds.ToFeedResource(feed)
 		if err != nil {
 			return diag.FromErr(err)
@@ -2,10 +2,10 @@ package octopusdeploy
 
 import (
 	"context"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"time"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/credentials"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -25,14 +25,27 @@ func dataSourceGitCredentialsRead(ctx context.Context, d *schema.ResourceData, m
 		Take: d.Get("take").(int),
 	}
 
-	client := m.(*client.Client)
-	existingGitCredentials, err := client.GitCredentials.Get(query)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firstPage, err := client.GitCredentials.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingGitCredentials, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*credentials.Resource], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return client.GitCredentials.Get(pageQuery)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedGitCredentials := []interface{}{}
-	for _, gitCredential := range existingGitCredentials.Items {
+	for _, gitCredential := range existingGitCredentials {
 		flattenedGitCredentials = append(flattenedGitCredentials, flattenGitCredential(gitCredential))
 	}
 
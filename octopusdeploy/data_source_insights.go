@@ -0,0 +1,44 @@
+package octopusdeploy
+
+import (
+	"context"
+	"time"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/insights"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceInsights() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides the DORA metrics of the deployments of a project over a window of time, such as the deployment frequency, lead time and failure rate that Octopus reports as insights.",
+		ReadContext: dataSourceInsightsRead,
+		Schema:      getInsightsDataSchema(),
+	}
+}
+
+func dataSourceInsightsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	query := expandProjectInsightsQuery(d)
+	project, err := client.Projects.GetByID(query.ProjectID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectInsights, err := insights.GetProjectInsights(client, project.SpaceID, query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setProjectInsights(d, projectInsights); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("Insights " + time.Now().UTC().String())
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package octopusdeploy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/insights"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsightsRead(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api":
+			w.Write([]byte(`{"Links":{}}`))
+		case "/api/Spaces-1":
+			w.Write([]byte(`{"Id":"Spaces-1","Links":{"Projects":"/api/Spaces-1/projects{/id}{?name,skip,ids,clone,take,partialName,clonedFromProjectId}"}}`))
+		case "/api/Spaces-1/projects/Projects-1":
+			w.Write([]byte(`{"Id":"Projects-1","SpaceId":"Spaces-1","Name":"Web","LifecycleId":"Lifecycles-1","ProjectGroupId":"ProjectGroups-1","Links":{}}`))
+		case "/api/Spaces-1/projects/Projects-1/insights/metrics":
+			query = r.URL.Query()
+			w.Write([]byte(`{"From":"2024-01-01T00:00:00+00:00","To":"2024-01-31T00:00:00+00:00","DeploymentCount":20,"SuccessfulDeploymentCount":18,"FailedDeploymentCount":2,"DeploymentFrequency":0.66,"DeploymentFailureRate":0.1,"LeadTime":"1.12:00:00","MeanTimeToRecovery":"00:30:00"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"ErrorMessage":"Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+	meta := newProviderMeta(octopus)
+
+	d := dataSourceInsights().TestResourceData()
+	d.Set("project_id", "Projects-1")
+	d.Set("environment_ids", []interface{}{"Environments-1", "Environments-2"})
+	d.Set("from", "2024-01-01T00:00:00Z")
+
+	require.False(t, dataSourceInsightsRead(context.Background(), d, meta).HasError())
+	require.Equal(t, []string{"Environments-1", "Environments-2"}, query["environmentIds"])
+	require.Equal(t, "2024-01-01T00:00:00Z", query.Get("from"))
+	require.False(t, query.Has("to"))
+
+	require.Equal(t, 20, d.Get("deployment_count"))
+	require.Equal(t, 2, d.Get("failed_deployment_count"))
+	require.Equal(t, 0.1, d.Get("deployment_failure_rate"))
+	require.Equal(t, 36.0, d.Get("lead_time_hours"))
+	require.Equal(t, 0.5, d.Get("mean_time_to_recovery_hours"))
+	require.Equal(t, "2024-01-31T00:00:00+00:00", d.Get("to"))
+}
+
+func TestParseTimeSpan(t *testing.T) {
+	for timeSpan, expected := range map[string]time.Duration{
+		"":                 0,
+		"00:00:05.5000000": 5500 * time.Millisecond,
+		"02:03:04":         2*time.Hour + 3*time.Minute + 4*time.Second,
+		"3.00:00:00":       72 * time.Hour,
+		"-1.01:00:00":      -25 * time.Hour,
+	} {
+		duration, err := insights.ParseTimeSpan(timeSpan)
+		require.NoError(t, err)
+		require.Equal(t, expected, duration, timeSpan)
+	}
+
+	_, err := insights.ParseTimeSpan("1 day")
+	require.Error(t, err)
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,14 +27,27 @@ func dataSourceLibraryVariableSetReadByName(ctx context.Context, d *schema.Resou
 		Take:        d.Get("take").(int),
 	}
 
-	client := m.(*client.Client)
-	existingLibraryVariableSets, err := client.LibraryVariableSets.Get(query)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firstPage, err := client.LibraryVariableSets.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingLibraryVariableSets, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*variables.LibraryVariableSet], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return client.LibraryVariableSets.Get(pageQuery)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedLibraryVariableSets := []interface{}{}
-	for _, libraryVariableSet := range existingLibraryVariableSets.Items {
+	for _, libraryVariableSet := range existingLibraryVariableSets {
 		flattenedLibraryVariableSets = append(flattenedLibraryVariableSets, flattenLibraryVariableSet(libraryVariableSet))
 	}
 
@@ -4,8 +4,8 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/lifecycles"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -26,14 +26,27 @@ func dataSourceLifecyclesRead(ctx context.Context, d *schema.ResourceData, m int
 		Take:        d.Get("take").(int),
 	}
 
-	client := m.(*client.Client)
-	existingLifecycles, err := client.Lifecycles.Get(query)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firstPage, err := client.Lifecycles.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingLifecycles, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*lifecycles.Lifecycle], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return client.Lifecycles.Get(pageQuery)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedLifecycles := []interface{}{}
-	for _, lifecycle := range existingLifecycles.Items {
+	for _, lifecycle := range existingLifecycles {
 		flattenedLifecycles = append(flattenedLifecycles, flattenLifecycle(lifecycle))
 	}
 
@@ -3,7 +3,6 @@ package octopusdeploy
 import (
 	"fmt"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,6 +17,7 @@ func dataSourceMachine() *schema.Resource {
 				Required: true,
 				Type:     schema.TypeString,
 			},
+			"space_id": getQuerySpaceID(),
 			"endpoint_communicationstyle": {
 				Computed: true,
 				Type:     schema.TypeString,
@@ -107,7 +107,10 @@ func dataSourceMachine() *schema.Resource {
 }
 
 func dataMachineReadByName(d *schema.ResourceData, m interface{}) error {
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return err
+	}
 
 	machineName := d.Get("name").(string)
 	existingMachines, err := client.Machines.GetByName(machineName)
@@ -4,8 +4,8 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -26,14 +26,27 @@ func dataSourceMachinePoliciesRead(ctx context.Context, d *schema.ResourceData,
 		Take:        d.Get("take").(int),
 	}
 
-	client := m.(*client.Client)
-	existingMachinePolicies, err := client.MachinePolicies.Get(query)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firstPage, err := client.MachinePolicies.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingMachinePolicies, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*machines.MachinePolicy], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return client.MachinePolicies.Get(pageQuery)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedMachinePolicies := []interface{}{}
-	for _, machinePolicy := range existingMachinePolicies.Items {
+	for _, machinePolicy := range existingMachinePolicies {
 		flattenedMachinePolicies = append(flattenedMachinePolicies, flattenMachinePolicy(machinePolicy))
 	}
 
@@ -0,0 +1,34 @@
+package octopusdeploy
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMachineRoles() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides the roles that are assigned to deployment targets in the space.",
+		ReadContext: dataSourceMachineRolesRead,
+		Schema:      getMachineRoleDataSchema(),
+	}
+}
+
+func dataSourceMachineRolesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	machineRoles, err := client.MachineRoles.GetAll()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("roles", filterMachineRoles(machineRoles, d.Get("partial_name").(string)))
+	d.SetId("MachineRoles " + time.Now().UTC().String())
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceMachineRoles(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := fmt.Sprintf("data.octopusdeploy_machine_roles.%s", localName)
+	partialName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMachineRolesDataSourceID(name),
+					resource.TestCheckResourceAttr(name, "partial_name", partialName),
+					resource.TestCheckResourceAttr(name, "roles.#", "0"),
+				),
+				Config: testAccDataSourceMachineRolesConfig(localName, partialName),
+			},
+		},
+	})
+}
+
+func testAccCheckMachineRolesDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		all := s.RootModule().Resources
+		rs, ok := all[n]
+		if !ok {
+			return fmt.Errorf("cannot find machine roles data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("snapshot machine roles source ID not set")
+		}
+		return nil
+	}
+}
+
+func testAccDataSourceMachineRolesConfig(localName string, partialName string) string {
+	return fmt.Sprintf(`data "octopusdeploy_machine_roles" "%s" {
+	  partial_name = "%s"
+	}`, localName, partialName)
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projectgroups"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -26,14 +26,27 @@ func dataSourceProjectGroupsRead(ctx context.Context, d *schema.ResourceData, m
 		Take:        d.Get("take").(int),
 	}
 
-	client := m.(*client.Client)
-	existingProjectGroups, err := client.ProjectGroups.Get(query)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firstPage, err := client.ProjectGroups.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingProjectGroups, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*projectgroups.ProjectGroup], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return client.ProjectGroups.Get(pageQuery)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedProjectGroups := []interface{}{}
-	for _, projectGroup := range existingProjectGroups.Items {
+	for _, projectGroup := range existingProjectGroups {
 		flattenedProjectGroups = append(flattenedProjectGroups, flattenProjectGroup(projectGroup))
 	}
 
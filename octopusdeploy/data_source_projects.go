@@ -4,8 +4,9 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	prj "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/projects"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -32,14 +33,30 @@ func dataSourceProjectsRead(ctx context.Context, d *schema.ResourceData, m inter
 		Take:                d.Get("take").(int),
 	}
 
-	client := m.(*client.Client)
-	existingProjects, err := client.Projects.Get(query)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firstPage, err := client.Projects.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingProjects, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*projects.Project], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return client.Projects.Get(pageQuery)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedProjects := []interface{}{}
-	for _, project := range existingProjects.Items {
+	for _, project := range existingProjects {
+		if err := prj.LoadGitHubGitCredential(client, project); err != nil {
+			return diag.FromErr(err)
+		}
 		flattenedProjects = append(flattenedProjects, flattenProject(ctx, d, project))
 	}
 
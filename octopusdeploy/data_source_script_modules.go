@@ -4,7 +4,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,14 +27,27 @@ func dataSourceScriptModulesRead(ctx context.Context, d *schema.ResourceData, m
 		Take:        d.Get("take").(int),
 	}
 
-	client := m.(*client.Client)
-	existingScriptModules, err := client.ScriptModules.Get(query)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firstPage, err := client.ScriptModules.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingScriptModules, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*variables.ScriptModule], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return client.ScriptModules.Get(pageQuery)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedScriptModules := []interface{}{}
-	for _, scriptModule := range existingScriptModules.Items {
+	for _, scriptModule := range existingScriptModules {
 		flattenedScriptModules = append(flattenedScriptModules, flattenScriptModule(scriptModule))
 	}
 
@@ -0,0 +1,69 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/octopusservernodes"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceServer() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about the Octopus Server, such as its version and nodes.",
+		ReadContext: dataSourceServerRead,
+		Schema:      getServerDataSchema(),
+	}
+}
+
+func dataSourceServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getClient(m)
+	root, err := client.Root.Get()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setServer(d, root); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+
+	// the node service cannot list nodes, so they are read from its path directly
+	path, err := services.GetPath(client.OctopusServerNodes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serverNodes := []*octopusservernodes.OctopusServerNodeResource{}
+	response, err := api.ApiGet(client.OctopusServerNodes.GetClient(), new(resources.Resources[*octopusservernodes.OctopusServerNodeResource]), path)
+	if err != nil {
+		apiError, ok := err.(*core.APIError)
+		if !ok || (apiError.StatusCode != http.StatusForbidden && apiError.StatusCode != http.StatusUnauthorized) {
+			return diag.FromErr(err)
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Detail:   fmt.Sprintf("The nodes of the Octopus Server could not be read: %s", err),
+			Severity: diag.Warning,
+			Summary:  "Octopus Server nodes are not available",
+		})
+	} else {
+		serverNodes = response.(*resources.Resources[*octopusservernodes.OctopusServerNodeResource]).Items
+	}
+
+	if err := d.Set("node", flattenServerNodes(serverNodes)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting node: %s", err))
+	}
+
+	d.SetId("Server " + time.Now().UTC().String())
+
+	return diags
+}
@@ -0,0 +1,24 @@
+package octopusdeploy
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSlug() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides the slug that Octopus derives from a name, such as the slug of a project or environment in URLs and the paths of version-controlled projects. The slug is computed by the provider without calling Octopus.",
+		ReadContext: dataSourceSlugRead,
+		Schema:      getSlugDataSchema(),
+	}
+}
+
+func dataSourceSlugRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.Set("slug", slugify(d.Get("name").(string)))
+	d.SetId("Slug " + time.Now().UTC().String())
+
+	return nil
+}
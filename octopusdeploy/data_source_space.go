@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -19,7 +18,7 @@ func dataSourceSpace() *schema.Resource {
 
 func dataSourceSpaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
-	client := m.(*client.Client)
+	client := getClient(m)
 
 	spaceName := d.Get("name").(string)
 	existingSpace, err := client.Spaces.GetByName(spaceName)
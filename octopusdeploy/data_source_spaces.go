@@ -4,7 +4,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/spaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,7 +20,7 @@ func dataSourceSpaces() *schema.Resource {
 
 func dataSourceSpacesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
-	client := m.(*client.Client)
+	client := getClient(m)
 
 	flattenedSpaces := []interface{}{}
 
@@ -31,12 +31,21 @@ func dataSourceSpacesRead(ctx context.Context, d *schema.ResourceData, m interfa
 		Take:        d.Get("take").(int),
 	}
 
-	existingSpaces, err := client.Spaces.Get(query)
+	firstPage, err := client.Spaces.Get(query)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	for _, space := range existingSpaces.Items {
+	existingSpaces, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*spaces.Space], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return client.Spaces.Get(pageQuery)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, space := range existingSpaces {
 		flattenedSpaces = append(flattenedSpaces, flattenSpace(space))
 	}
 
@@ -0,0 +1,87 @@
+package octopusdeploy
+
+import (
+	"context"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actions"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actiontemplates"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceStepTemplates() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about existing step templates, including community step templates that have been installed. The `name` and `website` filters are applied to the step templates returned for the other filters.",
+		ReadContext: dataSourceStepTemplatesRead,
+		Schema:      getStepTemplateDataSchema(),
+	}
+}
+
+func dataSourceStepTemplatesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	query := actiontemplates.Query{
+		IDs:         expandArray(d.Get("ids").([]interface{})),
+		PartialName: d.Get("partial_name").(string),
+		Skip:        d.Get("skip").(int),
+		Take:        d.Get("take").(int),
+	}
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firstPage, err := client.ActionTemplates.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingStepTemplates, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*actiontemplates.ActionTemplate], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return client.ActionTemplates.Get(pageQuery)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	communityActionTemplateIDs := []string{}
+	for _, stepTemplate := range existingStepTemplates {
+		if len(stepTemplate.CommunityActionTemplateID) > 0 {
+			communityActionTemplateIDs = append(communityActionTemplateIDs, stepTemplate.CommunityActionTemplateID)
+		}
+	}
+
+	communityActionTemplates, err := client.CommunityActionTemplates.GetByIDs(communityActionTemplateIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	communityActionTemplatesByID := map[string]*actions.CommunityActionTemplate{}
+	for _, communityActionTemplate := range communityActionTemplates {
+		communityActionTemplatesByID[communityActionTemplate.GetID()] = communityActionTemplate
+	}
+
+	name := d.Get("name").(string)
+	website := d.Get("website").(string)
+
+	flattenedStepTemplates := []interface{}{}
+	for _, stepTemplate := range existingStepTemplates {
+		if len(name) > 0 && stepTemplate.Name != name {
+			continue
+		}
+
+		communityActionTemplate := communityActionTemplatesByID[stepTemplate.CommunityActionTemplateID]
+		if len(website) > 0 && !isCommunityStepTemplateWebsite(communityActionTemplate, website) {
+			continue
+		}
+
+		flattenedStepTemplates = append(flattenedStepTemplates, flattenStepTemplate(stepTemplate, communityActionTemplate))
+	}
+
+	d.Set("step_templates", flattenedStepTemplates)
+	d.SetId("StepTemplates " + time.Now().UTC().String())
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package octopusdeploy
+
+import (
+	"context"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/subscriptions"
+	sub "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/subscriptions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSubscriptions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about existing subscriptions, which notify teams of events by email and webhook.",
+		ReadContext: dataSourceSubscriptionsRead,
+		Schema:      getSubscriptionDataSchema(),
+	}
+}
+
+func dataSourceSubscriptionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	query := subscriptions.SubscriptionsQuery{
+		IDs:         expandArray(d.Get("ids").([]interface{})),
+		PartialName: d.Get("partial_name").(string),
+		Skip:        d.Get("skip").(int),
+		Take:        d.Get("take").(int),
+	}
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firstPage, err := sub.GetSubscriptions(client, query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingSubscriptions, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*sub.Subscription], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return sub.GetSubscriptions(client, pageQuery)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattenedSubscriptions := []interface{}{}
+	for _, subscription := range existingSubscriptions {
+		flattenedSubscriptions = append(flattenedSubscriptions, flattenSubscription(subscription))
+	}
+
+	d.Set("subscriptions", flattenedSubscriptions)
+	d.SetId("Subscriptions " + time.Now().UTC().String())
+
+	return nil
+}
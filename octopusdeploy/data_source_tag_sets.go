@@ -4,7 +4,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tagsets"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,14 +26,27 @@ func dataSourceTagSetsRead(ctx context.Context, d *schema.ResourceData, m interf
 		Take:        d.Get("take").(int),
 	}
 
-	octopus := m.(*client.Client)
-	existingTagSets, err := octopus.TagSets.Get(query)
+	octopus, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firstPage, err := octopus.TagSets.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingTagSets, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*tagsets.TagSet], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return octopus.TagSets.Get(pageQuery)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedTagSets := []interface{}{}
-	for _, tagSet := range existingTagSets.Items {
+	for _, tagSet := range existingTagSets {
 		flattenedTagSets = append(flattenedTagSets, flattenTagSet(tagSet))
 	}
 
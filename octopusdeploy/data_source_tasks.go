@@ -0,0 +1,53 @@
+package octopusdeploy
+
+import (
+	"context"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTasks() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about existing server tasks, such as deployments that are queued or executing.",
+		ReadContext: dataSourceTasksRead,
+		Schema:      getTaskDataSchema(),
+	}
+}
+
+func dataSourceTasksRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	query := tasks.TasksQuery{
+		Environment: d.Get("environment_id").(string),
+		IDs:         expandArray(d.Get("ids").([]interface{})),
+		IsActive:    d.Get("is_active").(bool),
+		IsRunning:   d.Get("is_running").(bool),
+		Name:        d.Get("name").(string),
+		Project:     d.Get("project_id").(string),
+		Skip:        d.Get("skip").(int),
+		States:      expandArray(d.Get("states").([]interface{})),
+		Take:        d.Get("take").(int),
+		Tenant:      d.Get("tenant_id").(string),
+	}
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingTasks, err := client.Tasks.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattenedTasks := []interface{}{}
+	for _, task := range existingTasks.Items {
+		flattenedTasks = append(flattenedTasks, flattenTask(task))
+	}
+
+	d.Set("tasks", flattenedTasks)
+	d.SetId("Tasks " + time.Now().UTC().String())
+
+	return nil
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/teams"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,14 +28,23 @@ func dataSourceTeamsRead(ctx context.Context, d *schema.ResourceData, meta inter
 		Take:          d.Get("take").(int),
 	}
 
-	client := meta.(*client.Client)
-	existingTeams, err := client.Teams.Get(query)
+	client := getClient(meta)
+	firstPage, err := client.Teams.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingTeams, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*teams.Team], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return client.Teams.Get(pageQuery)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedTeams := []interface{}{}
-	for _, team := range existingTeams.Items {
+	for _, team := range existingTeams {
 		flattenedTeams = append(flattenedTeams, flattenTeam(team))
 	}
 
@@ -4,7 +4,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -31,14 +31,27 @@ func dataSourceTenantsRead(ctx context.Context, d *schema.ResourceData, meta int
 		Take:               d.Get("take").(int),
 	}
 
-	client := meta.(*client.Client)
-	existingTenants, err := client.Tenants.Get(query)
+	client, err := getSpaceClient(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firstPage, err := client.Tenants.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingTenants, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*tenants.Tenant], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return client.Tenants.Get(pageQuery)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedTenants := []interface{}{}
-	for _, tenant := range existingTenants.Items {
+	for _, tenant := range existingTenants {
 		flattenedTenants = append(flattenedTenants, flattenTenant(tenant))
 	}
 
@@ -4,7 +4,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/userroles"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,14 +26,23 @@ func dataSourceUserRolesRead(ctx context.Context, d *schema.ResourceData, meta i
 		Take:        d.Get("take").(int),
 	}
 
-	client := meta.(*client.Client)
-	existingUserRoles, err := client.UserRoles.Get(query)
+	client := getClient(meta)
+	firstPage, err := client.UserRoles.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingUserRoles, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*userroles.UserRole], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return client.UserRoles.Get(pageQuery)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedUserRoles := []interface{}{}
-	for _, userRole := range existingUserRoles.Items {
+	for _, userRole := range existingUserRoles {
 		flattenedUserRoles = append(flattenedUserRoles, flattenUserRole(userRole))
 	}
 
@@ -4,7 +4,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/users"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,14 +26,23 @@ func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta inter
 		Take:   d.Get("take").(int),
 	}
 
-	client := meta.(*client.Client)
-	existingUsers, err := client.Users.Get(query)
+	client := getClient(meta)
+	firstPage, err := client.Users.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingUsers, err := getAllPages(firstPage, query.Skip, query.Take, func(skip int) (*resources.Resources[*users.User], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		return client.Users.Get(pageQuery)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedUsers := []interface{}{}
-	for _, user := range existingUsers.Items {
+	for _, user := range existingUsers {
 		flattenedUsers = append(flattenedUsers, flattenUser(user))
 	}
 
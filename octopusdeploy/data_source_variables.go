@@ -3,7 +3,6 @@ package octopusdeploy
 import (
 	"context"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -11,7 +10,7 @@ import (
 
 func dataSourceVariable() *schema.Resource {
 	return &schema.Resource{
-		Description: "Provides information about existing variables.",
+		Description: "Provides information about an existing variable, found by its owner, name and optionally its scope.",
 		ReadContext: dataSourceVariableReadByName,
 		Schema:      getVariableDataSchema(),
 	}
@@ -26,13 +25,17 @@ func dataSourceVariableReadByName(ctx context.Context, d *schema.ResourceData, m
 		scope = expandVariableScope(v)
 	}
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	variables, err := client.Variables.GetByName(ownerID.(string), name.(string), &scope)
 	if err != nil {
 		return diag.Errorf("error reading variable with owner ID %s with name %s: %s", ownerID, name, err.Error())
 	}
-	if variables == nil {
-		return nil
+	if len(variables) == 0 {
+		return diag.Errorf("no variable found with owner ID %s with name %s", ownerID, name)
 	}
 	if len(variables) > 1 {
 		return diag.Errorf("found %v variables with owner ID %s with name %s, should match exactly 1", len(variables), ownerID, name)
@@ -43,6 +46,7 @@ func dataSourceVariableReadByName(ctx context.Context, d *schema.ResourceData, m
 	d.Set("type", variables[0].Type)
 	d.Set("value", variables[0].Value)
 	d.Set("description", variables[0].Description)
+	d.Set("is_sensitive", variables[0].IsSensitive)
 
 	return nil
 }
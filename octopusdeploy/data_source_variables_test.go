@@ -0,0 +1,52 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceVariables(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	libraryVariableSetName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := fmt.Sprintf("data.octopusdeploy_variables.%s", localName)
+	value := "https://" + acctest.RandStringFromCharSet(20, acctest.CharSetAlpha) + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testLibraryVariableSetDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(prefix, "id", "octopusdeploy_variable."+localName, "id"),
+					resource.TestCheckResourceAttr(prefix, "is_sensitive", "false"),
+					resource.TestCheckResourceAttr(prefix, "type", "String"),
+					resource.TestCheckResourceAttr(prefix, "value", value),
+				),
+				Config: testAccDataSourceVariablesConfig(localName, libraryVariableSetName, name, value),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVariablesConfig(localName string, libraryVariableSetName string, name string, value string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_library_variable_set" "%[1]s" {
+		name = "%[2]s"
+	}
+
+	resource "octopusdeploy_variable" "%[1]s" {
+		name     = "%[3]s"
+		owner_id = octopusdeploy_library_variable_set.%[1]s.id
+		type     = "String"
+		value    = "%[4]s"
+	}
+
+	data "octopusdeploy_variables" "%[1]s" {
+		name     = octopusdeploy_variable.%[1]s.name
+		owner_id = octopusdeploy_library_variable_set.%[1]s.id
+	}`, localName, libraryVariableSetName, name, value)
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/workerpools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,14 +26,31 @@ func dataSourceWorkerPoolsRead(ctx context.Context, d *schema.ResourceData, m in
 		Take:        d.Get("take").(int),
 	}
 
-	client := m.(*client.Client)
-	workerPools, err := client.WorkerPools.Get(query)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	firstPage, err := client.WorkerPools.Get(query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	workerPools, err := getAllPages(&resources.Resources[workerpools.IWorkerPool]{Items: firstPage.Items, PagedResults: firstPage.PagedResults}, query.Skip, query.Take, func(skip int) (*resources.Resources[workerpools.IWorkerPool], error) {
+		pageQuery := query
+		pageQuery.Skip = skip
+		page, err := client.WorkerPools.Get(pageQuery)
+		if err != nil {
+			return nil, err
+		}
+		return &resources.Resources[workerpools.IWorkerPool]{Items: page.Items, PagedResults: page.PagedResults}, nil
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	flattenedWorkerPools := []interface{}{}
-	for _, workerPool := range workerPools.Items {
+	for _, workerPool := range workerPools {
 		workerPoolResource, err := workerpools.ToWorkerPoolResource(workerPool)
 		if err != nil {
 			return diag.FromErr(err)
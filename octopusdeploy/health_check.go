@@ -0,0 +1,110 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// healthCheckMachineTimeout is how long a health check waits for each machine to respond.
+const healthCheckMachineTimeout = "00:05:00"
+
+// newHealthCheckTask creates a task that checks the health of the given machines, or of every machine in the given
+// environment when no machines are given.
+func newHealthCheckTask(description string, environmentID string, machineIDs []string) *tasks.Task {
+	task := tasks.NewTask()
+	task.Name = "Health"
+	task.Description = description
+	task.Arguments["MachineTimeout"] = healthCheckMachineTimeout
+	task.Arguments["Timeout"] = healthCheckMachineTimeout
+
+	if len(environmentID) > 0 {
+		task.Arguments["EnvironmentId"] = environmentID
+	}
+
+	if len(machineIDs) > 0 {
+		task.Arguments["MachineIds"] = machineIDs
+	}
+
+	return task
+}
+
+func isHealthy(healthStatus string) bool {
+	return healthStatus == "Healthy" || healthStatus == "HasWarnings"
+}
+
+// waitForHealthyDeploymentTarget checks the health of a deployment target until Octopus reports it as healthy or the
+// timeout has elapsed. Health checks are repeated because a target may not be reachable straight after it has been
+// registered, e.g. while a polling tentacle is still connecting.
+func waitForHealthyDeploymentTarget(ctx context.Context, client *client.Client, id string, timeout time.Duration) (*machines.DeploymentTarget, error) {
+	tflog.Info(ctx, fmt.Sprintf("waiting for deployment target to become healthy (%s)", id))
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var deploymentTarget *machines.DeploymentTarget
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		task, err := client.Tasks.Add(newHealthCheckTask(fmt.Sprintf("Check the health of deployment target %s", id), "", []string{id}))
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		// a failed health check is reflected in the health status of the target, which is checked below
+		if _, err := waitForTask(ctx, client, task.GetID(), timeout); err != nil {
+			tflog.Info(ctx, fmt.Sprintf("health check did not succeed (%s): %s", id, err))
+		}
+
+		deploymentTarget, err = client.Machines.GetByID(id)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if !isHealthy(deploymentTarget.HealthStatus) {
+			return resource.RetryableError(fmt.Errorf("deployment target (%s) is not healthy: %s", id, deploymentTarget.HealthStatus))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return deploymentTarget, err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("deployment target is healthy (%s)", id))
+	return deploymentTarget, nil
+}
+
+// waitForCreatedDeploymentTarget waits for a newly created deployment target to become healthy when wait_for_healthy is
+// set. The state is refreshed with the health of the target either way, and a target that never becomes healthy is
+// kept in state so that it is replaced on the next apply.
+func waitForCreatedDeploymentTarget(ctx context.Context, d *schema.ResourceData, client *client.Client, set func(context.Context, *schema.ResourceData, *machines.DeploymentTarget) error) diag.Diagnostics {
+	if !d.Get("wait_for_healthy").(bool) {
+		return nil
+	}
+
+	deploymentTarget, waitErr := waitForHealthyDeploymentTarget(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if deploymentTarget != nil {
+		if err := set(ctx, d, deploymentTarget); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diag.FromErr(waitErr)
+}
+
+func getWaitForHealthySchema() *schema.Schema {
+	return &schema.Schema{
+		Default:     false,
+		Description: "Whether to wait after the deployment target has been created until Octopus reports it as healthy, checking its health until it is. The apply fails if the deployment target is not healthy within the create timeout, so that deployments are not made to unreachable targets.",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+}
@@ -0,0 +1,146 @@
+package octopusdeploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/stretchr/testify/require"
+)
+
+func newHealthCheckTestServer(t *testing.T, healthStatuses ...string) (*httptest.Server, *[]map[string]interface{}) {
+	healthChecks := []map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api":
+			w.Write([]byte(`{"Links":{}}`))
+		case r.URL.Path == "/api/Spaces-1":
+			w.Write([]byte(`{"Id":"Spaces-1","Links":{"Machines":"/api/Spaces-1/machines{/id}{?skip,take,name,ids,partialName,roles,isDisabled,healthStatuses,commStyles,tenantIds,tenantTags,environmentIds,thumbprint,deploymentId,shellNames}","Tasks":"/api/Spaces-1/tasks{/id}{?skip,take,ids,name,node,running,states,hasPendingInterruptions,hasWarningsOrErrors,partialName,spaces,includeSystem}"}}`))
+		case r.URL.Path == "/api/Spaces-1/machines" && r.Method == http.MethodPost:
+			w.Write([]byte(machineJSON("Unknown")))
+		case r.URL.Path == "/api/Spaces-1/machines/all":
+			w.Write([]byte(`[{"Id":"Machines-1","Name":"Web","MachinePolicyId":"MachinePolicies-1","Endpoint":{"CommunicationStyle":"None","Links":{}},"Links":{}},{"Id":"Machines-2","Name":"Database","MachinePolicyId":"MachinePolicies-2","Endpoint":{"CommunicationStyle":"None","Links":{}},"Links":{}}]`))
+		case r.URL.Path == "/api/Spaces-1/machines/Machines-1":
+			// the health status changes with each health check that has been run
+			i := len(healthChecks) - 1
+			if i >= len(healthStatuses) {
+				i = len(healthStatuses) - 1
+			}
+			w.Write([]byte(machineJSON(healthStatuses[i])))
+		case r.URL.Path == "/api/Spaces-1/tasks" && r.Method == http.MethodPost:
+			var task map[string]interface{}
+			body, _ := io.ReadAll(r.Body)
+			require.NoError(t, json.Unmarshal(body, &task))
+			healthChecks = append(healthChecks, task)
+
+			w.Write([]byte(fmt.Sprintf(`{"Id":"ServerTasks-%d","Name":"Health","State":"Queued","Links":{}}`, len(healthChecks))))
+		case r.URL.Path == "/api/Spaces-1/tasks":
+			w.Write([]byte(fmt.Sprintf(`{"Items":[{"Id":"%s","Name":"Health","State":"Success","Links":{}}],"Links":{}}`, r.URL.Query().Get("ids"))))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"ErrorMessage":"Not found"}`))
+		}
+	}))
+
+	return server, &healthChecks
+}
+
+func machineJSON(healthStatus string) string {
+	return fmt.Sprintf(`{"Id":"Machines-1","Name":"Cloud","SpaceId":"Spaces-1","EnvironmentIds":["Environments-1"],"Roles":["web"],"Endpoint":{"CommunicationStyle":"None","Links":{}},"HealthStatus":"%s","Links":{}}`, healthStatus)
+}
+
+func TestDeploymentTargetWaitForHealthy(t *testing.T) {
+	server, healthChecks := newHealthCheckTestServer(t, "Healthy")
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+	meta := newProviderMeta(octopus)
+
+	d := resourceCloudRegionDeploymentTarget().TestResourceData()
+	d.Set("environments", []interface{}{"Environments-1"})
+	d.Set("name", "Cloud")
+	d.Set("roles", []interface{}{"web"})
+	d.Set("wait_for_healthy", true)
+
+	require.False(t, resourceCloudRegionDeploymentTargetCreate(context.Background(), d, meta).HasError())
+	require.Equal(t, "Machines-1", d.Id())
+	require.Equal(t, "Healthy", d.Get("health_status"))
+
+	require.Len(t, *healthChecks, 1)
+	require.Equal(t, "Health", (*healthChecks)[0]["Name"])
+	require.Equal(t, []interface{}{"Machines-1"}, (*healthChecks)[0]["Arguments"].(map[string]interface{})["MachineIds"])
+}
+
+func TestWaitForHealthyDeploymentTarget(t *testing.T) {
+	// a target that is unreachable at first is checked again
+	server, healthChecks := newHealthCheckTestServer(t, "Unavailable", "Healthy")
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+
+	deploymentTarget, err := waitForHealthyDeploymentTarget(context.Background(), octopus, "Machines-1", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "Healthy", deploymentTarget.HealthStatus)
+	require.Len(t, *healthChecks, 2)
+
+	// a target that never becomes healthy fails once the timeout has elapsed
+	server, _ = newHealthCheckTestServer(t, "Unhealthy")
+	defer server.Close()
+
+	apiURL, _ = url.Parse(server.URL)
+	octopus, err = client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+
+	deploymentTarget, err = waitForHealthyDeploymentTarget(context.Background(), octopus, "Machines-1", 2*time.Second)
+	require.ErrorContains(t, err, "is not healthy: Unhealthy")
+	require.Equal(t, "Unhealthy", deploymentTarget.HealthStatus)
+}
+
+func TestHealthCheckCreate(t *testing.T) {
+	server, healthChecks := newHealthCheckTestServer(t, "Healthy")
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+	meta := newProviderMeta(octopus)
+
+	d := resourceHealthCheck().TestResourceData()
+	d.Set("environment_id", "Environments-1")
+
+	require.False(t, resourceHealthCheckCreate(context.Background(), d, meta).HasError())
+	require.Equal(t, "ServerTasks-1", d.Id())
+	require.Equal(t, "ServerTasks-1", d.Get("task_id"))
+	require.Equal(t, "Success", d.Get("task_state"))
+	require.Equal(t, "Environments-1", (*healthChecks)[0]["Arguments"].(map[string]interface{})["EnvironmentId"])
+	require.NotContains(t, (*healthChecks)[0]["Arguments"], "MachineIds")
+
+	// health checks cannot be scoped to a machine policy, so the targets that use it are checked
+	d = resourceHealthCheck().TestResourceData()
+	d.Set("machine_policy_id", "MachinePolicies-2")
+
+	require.False(t, resourceHealthCheckCreate(context.Background(), d, meta).HasError())
+	require.Equal(t, "ServerTasks-2", d.Id())
+	require.Equal(t, []interface{}{"Machines-2"}, (*healthChecks)[1]["Arguments"].(map[string]interface{})["MachineIds"])
+
+	d = resourceHealthCheck().TestResourceData()
+	d.Set("machine_policy_id", "MachinePolicies-3")
+
+	diags := resourceHealthCheckCreate(context.Background(), d, meta)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "no deployment targets use machine policy (MachinePolicies-3)")
+	require.Empty(t, d.Id())
+	require.Len(t, *healthChecks, 2)
+}
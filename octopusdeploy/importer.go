@@ -0,0 +1,358 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/accounts"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/certificates"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/credentials"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/environments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/lifecycles"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projectgroups"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/spaces"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tagsets"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/teams"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/userroles"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/users"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/workerpools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importCandidate is a resource that may be the one named by the ID given to terraform import.
+type importCandidate struct {
+	id   string
+	name string
+	slug string
+}
+
+// importCandidateFinder finds the resources whose name contains the given search term.
+type importCandidateFinder func(octopus *client.Client, partialName string) ([]importCandidate, error)
+
+// importSearchTake is the number of resources requested when searching for a resource to import, so that the
+// resource is found even when many others have similar names.
+const importSearchTake = math.MaxInt32
+
+var (
+	octopusIDPattern = regexp.MustCompile(`^[A-Z][A-Za-z]*-\d+$`)
+	slugPattern      = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+)
+
+// getImporterByName returns an importer that accepts the name or, for resources that have one, the slug of a
+// resource in place of its ID, e.g. terraform import octopusdeploy_project.example "My Project". Names and slugs are
+// resolved to an ID within the space of the provider; anything that is not the name or slug of a resource is
+// imported as an ID.
+func getImporterByName(kind string, find importCandidateFinder) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			id, err := resolveImportID(getClient(m), kind, d.Id(), find)
+			if err != nil {
+				return nil, err
+			}
+
+			d.SetId(id)
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+func resolveImportID(octopus *client.Client, kind string, id string, find importCandidateFinder) (string, error) {
+	if octopusIDPattern.MatchString(id) {
+		return id, nil
+	}
+
+	candidates, err := find(octopus, getImportSearchTerm(id))
+	if err != nil {
+		return "", fmt.Errorf("error finding the %s %q to import: %s", kind, id, err)
+	}
+
+	var matches []string
+	for _, candidate := range candidates {
+		if strings.EqualFold(candidate.name, id) || (len(candidate.slug) > 0 && candidate.slug == id) {
+			matches = append(matches, candidate.id)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		// built-in resources, such as feeds-builtin and teams-everyone, have IDs that look like names
+		return id, nil
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("%q is the name of %d resources of type %s (%s); import one of them by its ID", id, len(matches), kind, strings.Join(matches, ", "))
+	}
+}
+
+// getImportSearchTerm returns the term to search for by partial name. The search does not match slugs, so the
+// longest word of a slug is searched for instead, as the name of the resource will contain it.
+func getImportSearchTerm(id string) string {
+	if !slugPattern.MatchString(id) {
+		return id
+	}
+
+	term := ""
+	for _, word := range strings.Split(id, "-") {
+		if len(word) > len(term) {
+			term = word
+		}
+	}
+	return term
+}
+
+func findAccounts(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.Accounts.Get(accounts.AccountsQuery{PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.GetName(), slug: resource.GetSlug()})
+	}
+	return candidates, nil
+}
+
+func findCertificates(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.Certificates.Get(certificates.CertificatesQuery{PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.Name})
+	}
+	return candidates, nil
+}
+
+func findDeploymentTargets(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.Machines.Get(machines.MachinesQuery{PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.Name})
+	}
+	return candidates, nil
+}
+
+func findEnvironments(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.Environments.Get(environments.EnvironmentsQuery{PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.Name, slug: resource.Slug})
+	}
+	return candidates, nil
+}
+
+func findFeeds(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.Feeds.Get(feeds.FeedsQuery{PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.GetName()})
+	}
+	return candidates, nil
+}
+
+func findGitCredentials(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.GitCredentials.Get(credentials.Query{Name: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.Name})
+	}
+	return candidates, nil
+}
+
+func findLibraryVariableSets(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.LibraryVariableSets.Get(variables.LibraryVariablesQuery{ContentType: "Variables", PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.Name})
+	}
+	return candidates, nil
+}
+
+func findLifecycles(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.Lifecycles.Get(lifecycles.Query{PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.Name})
+	}
+	return candidates, nil
+}
+
+func findMachinePolicies(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.MachinePolicies.Get(machines.MachinePoliciesQuery{PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.Name})
+	}
+	return candidates, nil
+}
+
+func findProjectGroups(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.ProjectGroups.Get(projectgroups.ProjectGroupsQuery{PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.Name})
+	}
+	return candidates, nil
+}
+
+func findProjects(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.Projects.Get(projects.ProjectsQuery{PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.Name, slug: resource.Slug})
+	}
+	return candidates, nil
+}
+
+func findScriptModules(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.ScriptModules.Get(variables.LibraryVariablesQuery{ContentType: "ScriptModule", PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.Name})
+	}
+	return candidates, nil
+}
+
+func findSpaces(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.Spaces.Get(spaces.SpacesQuery{PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.Name, slug: resource.Slug})
+	}
+	return candidates, nil
+}
+
+func findTagSets(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.TagSets.Get(tagsets.TagSetsQuery{PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.Name})
+	}
+	return candidates, nil
+}
+
+func findTeams(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.Teams.Get(teams.TeamsQuery{PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.Name})
+	}
+	return candidates, nil
+}
+
+func findTenants(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.Tenants.Get(tenants.TenantsQuery{PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.Name})
+	}
+	return candidates, nil
+}
+
+func findUserRoles(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.UserRoles.Get(userroles.UserRolesQuery{PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.Name})
+	}
+	return candidates, nil
+}
+
+// findUsers finds users by their username, which is the name by which they are imported.
+func findUsers(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.Users.Get(users.UsersQuery{Filter: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.Username})
+	}
+	return candidates, nil
+}
+
+func findWorkerPools(octopus *client.Client, partialName string) ([]importCandidate, error) {
+	resources, err := octopus.WorkerPools.Get(workerpools.WorkerPoolsQuery{PartialName: partialName, Take: importSearchTake})
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []importCandidate
+	for _, resource := range resources.Items {
+		candidates = append(candidates, importCandidate{id: resource.GetID(), name: resource.GetName()})
+	}
+	return candidates, nil
+}
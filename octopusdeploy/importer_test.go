@@ -0,0 +1,55 @@
+package octopusdeploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetImportSearchTerm(t *testing.T) {
+	require.Equal(t, "Web Application", getImportSearchTerm("Web Application"))
+	require.Equal(t, "application", getImportSearchTerm("web-application"))
+	require.Equal(t, "production", getImportSearchTerm("production"))
+}
+
+func TestResolveImportID(t *testing.T) {
+	var searchTerms []string
+	find := func(octopus *client.Client, partialName string) ([]importCandidate, error) {
+		searchTerms = append(searchTerms, partialName)
+		return []importCandidate{
+			{id: "Projects-1", name: "Web Application", slug: "web-application"},
+			{id: "Projects-2", name: "Web Application API", slug: "web-application-api"},
+			{id: "Projects-3", name: "Worker", slug: "worker"},
+			{id: "Projects-4", name: "worker", slug: "worker-2"},
+		}, nil
+	}
+
+	id, err := resolveImportID(nil, "project", "Projects-123", find)
+	require.NoError(t, err)
+	require.Equal(t, "Projects-123", id)
+	require.Empty(t, searchTerms)
+
+	id, err = resolveImportID(nil, "project", "Web Application", find)
+	require.NoError(t, err)
+	require.Equal(t, "Projects-1", id)
+
+	id, err = resolveImportID(nil, "project", "web-application-api", find)
+	require.NoError(t, err)
+	require.Equal(t, "Projects-2", id)
+	require.Equal(t, []string{"Web Application", "application"}, searchTerms)
+
+	// names that match no resource are imported as IDs
+	id, err = resolveImportID(nil, "project", "feeds-builtin", find)
+	require.NoError(t, err)
+	require.Equal(t, "feeds-builtin", id)
+
+	_, err = resolveImportID(nil, "project", "Worker", find)
+	require.ErrorContains(t, err, "Projects-3, Projects-4")
+
+	_, err = resolveImportID(nil, "project", "Web", func(octopus *client.Client, partialName string) ([]importCandidate, error) {
+		return nil, errors.New("unauthorized")
+	})
+	require.ErrorContains(t, err, "unauthorized")
+}
@@ -0,0 +1,56 @@
+package octopusdeploy
+
+import (
+	"sync"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+)
+
+// maxConcurrentPageRequests is the number of pages of a collection that getAllPages requests at the same time.
+const maxConcurrentPageRequests = 4
+
+// getAllPages returns the items of the first page of a collection along with those of all of its later pages. The
+// later pages are requested concurrently through getPage, which is given the number of items to skip. Only the first
+// page is returned when take is set, as a specific page of the collection was asked for.
+func getAllPages[T any](firstPage *resources.Resources[T], skip int, take int, getPage func(skip int) (*resources.Resources[T], error)) ([]T, error) {
+	if take > 0 || firstPage.ItemsPerPage <= 0 {
+		return firstPage.Items, nil
+	}
+
+	skips := []int{}
+	for pageSkip := skip + firstPage.ItemsPerPage; pageSkip < firstPage.TotalResults; pageSkip += firstPage.ItemsPerPage {
+		skips = append(skips, pageSkip)
+	}
+
+	pages := make([][]T, len(skips))
+	errs := make([]error, len(skips))
+	requests := make(chan struct{}, maxConcurrentPageRequests)
+
+	var wg sync.WaitGroup
+	for i, pageSkip := range skips {
+		wg.Add(1)
+		go func(i int, pageSkip int) {
+			defer wg.Done()
+
+			requests <- struct{}{}
+			defer func() { <-requests }()
+
+			page, err := getPage(pageSkip)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			pages[i] = page.Items
+		}(i, pageSkip)
+	}
+	wg.Wait()
+
+	items := append([]T{}, firstPage.Items...)
+	for i := range pages {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		items = append(items, pages[i]...)
+	}
+	return items, nil
+}
@@ -0,0 +1,107 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func getTestPage(skip int, take int, total int) *resources.Resources[int] {
+	page := &resources.Resources[int]{Items: []int{}}
+	for i := skip; i < skip+take && i < total; i++ {
+		page.Items = append(page.Items, i)
+	}
+	page.ItemsPerPage = take
+	page.TotalResults = total
+	return page
+}
+
+func TestGetAllPages(t *testing.T) {
+	var mutex sync.Mutex
+	requestedSkips := []int{}
+	getPage := func(skip int) (*resources.Resources[int], error) {
+		mutex.Lock()
+		requestedSkips = append(requestedSkips, skip)
+		mutex.Unlock()
+		return getTestPage(skip, 30, 100), nil
+	}
+
+	items, err := getAllPages(getTestPage(0, 30, 100), 0, 0, getPage)
+	require.NoError(t, err)
+	require.Len(t, items, 100)
+	for i, item := range items {
+		require.Equal(t, i, item)
+	}
+	require.ElementsMatch(t, []int{30, 60, 90}, requestedSkips)
+
+	// the pages after the items that were skipped
+	requestedSkips = []int{}
+	items, err = getAllPages(getTestPage(50, 30, 100), 50, 0, getPage)
+	require.NoError(t, err)
+	require.Len(t, items, 50)
+	require.Equal(t, 50, items[0])
+	require.ElementsMatch(t, []int{80}, requestedSkips)
+
+	// a specific page
+	requestedSkips = []int{}
+	items, err = getAllPages(getTestPage(0, 10, 100), 0, 10, getPage)
+	require.NoError(t, err)
+	require.Len(t, items, 10)
+	require.Empty(t, requestedSkips)
+}
+
+func TestGetAllPagesWithError(t *testing.T) {
+	_, err := getAllPages(getTestPage(0, 30, 100), 0, 0, func(skip int) (*resources.Resources[int], error) {
+		if skip == 60 {
+			return nil, fmt.Errorf("error getting page")
+		}
+		return getTestPage(skip, 30, 100), nil
+	})
+	require.EqualError(t, err, "error getting page")
+}
+
+func TestDataSourceFeedsReadAllPages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api":
+			w.Write([]byte(`{"Links":{}}`))
+		case "/api/Spaces-1":
+			w.Write([]byte(`{"Id":"Spaces-1","Links":{"Feeds":"/api/Spaces-1/feeds{/id}{?skip,take,ids,partialName,feedType,name}"}}`))
+		case "/api/Spaces-1/feeds":
+			skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+			fmt.Fprintf(w, `{"Items":[{"Id":"Feeds-%d","Name":"Feed %d","FeedType":"BuiltIn"}],"ItemsPerPage":1,"TotalResults":3}`, skip+1, skip+1)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+	meta := newProviderMeta(octopus)
+
+	// every page is read when take is not set
+	d := dataSourceFeeds().TestResourceData()
+	require.False(t, dataSourceFeedsRead(context.Background(), d, meta).HasError())
+	require.Len(t, d.Get("feeds"), 3)
+	require.Equal(t, "Feeds-3", d.Get("feeds.2.id"))
+
+	// only the given page is read when take is set
+	d = dataSourceFeeds().TestResourceData()
+	d.Set("skip", 1)
+	d.Set("take", 1)
+	require.False(t, dataSourceFeedsRead(context.Background(), d, meta).HasError())
+	require.Len(t, d.Get("feeds"), 1)
+	require.Equal(t, "Feeds-2", d.Get("feeds.0.id"))
+}
@@ -0,0 +1,35 @@
+package octopusdeploy
+
+import (
+	"sync"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+)
+
+// processLocks holds a lock for each deployment process that is changed a step at a time.
+// Resources that share a deployment process must not overwrite each other's changes, but changes to the processes of
+// different projects can be made at the same time.
+type processLocks struct {
+	locks map[string]*sync.Mutex
+	mutex sync.Mutex
+}
+
+// lockDeploymentProcess locks the deployment process of the project with the given ID in the space of the given
+// client, and returns a function that unlocks it.
+func lockDeploymentProcess(m interface{}, octopus *client.Client, projectID string) func() {
+	_, spaceID := splitClientBaseURL(octopus.HttpSession().BaseURL)
+	key := spaceID + ":" + projectID
+
+	locks := m.(*providerMeta).processLocks
+
+	locks.mutex.Lock()
+	lock, ok := locks.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		locks.locks[key] = lock
+	}
+	locks.mutex.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
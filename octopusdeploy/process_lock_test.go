@@ -0,0 +1,53 @@
+package octopusdeploy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockDeploymentProcess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"Links":{}}`))
+	}))
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+	otherSpace, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-2")
+	require.NoError(t, err)
+	meta := newProviderMeta(octopus)
+
+	unlock := lockDeploymentProcess(meta, octopus, "Projects-1")
+
+	// the processes of other projects, and of projects in other spaces, are not locked
+	lockDeploymentProcess(meta, octopus, "Projects-2")()
+	lockDeploymentProcess(meta, otherSpace, "Projects-1")()
+
+	// the process of the same project is locked until it is unlocked
+	locked := make(chan struct{})
+	go func() {
+		lockDeploymentProcess(meta, octopus, "Projects-1")()
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("the deployment process was locked twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unlock()
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("the deployment process was not unlocked")
+	}
+}
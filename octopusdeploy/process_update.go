@@ -0,0 +1,72 @@
+package octopusdeploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbooks"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultProcessUpdateAttempts is the number of attempts made to update a process when the provider is not
+// configured with max_process_update_attempts.
+const defaultProcessUpdateAttempts = 3
+
+func getProcessUpdateAttempts(m interface{}) int {
+	return m.(*providerMeta).processUpdateAttempts
+}
+
+// updateProcess calls update, which reads the current version of a deployment or runbook process and writes changes
+// to it. Octopus bumps the version of a process whenever anything touches its project, so a write that is rejected
+// because the process was changed after it was read is attempted again, up to the given number of attempts.
+func updateProcess(ctx context.Context, attempts int, update func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := update()
+		if err == nil || attempt >= attempts || !isConflict(err) {
+			return err
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("the process was changed after it was read; retrying the update (attempt %d of %d)", attempt+1, attempts))
+	}
+}
+
+// errProcessChanged marks the error of an update of a process that was changed after it was read.
+var errProcessChanged = errors.New("the process was changed after it was read")
+
+// getProcessUpdateError returns the error of an update of the process that was read with the given self link and
+// version. The client only reports the status of a response that is not found, so the process is read again to find
+// whether the update was rejected because the process was changed in the meantime, in which case it is a conflict.
+func getProcessUpdateError(octopus *client.Client, self string, version int32, err error) error {
+	if err == nil || isConflict(err) {
+		return err
+	}
+
+	current := &struct {
+		Version int32 `json:"Version"`
+	}{}
+	if _, getErr := api.ApiGet(octopus.Sling(), current, self); getErr == nil && current.Version != version {
+		return fmt.Errorf("%w: %w", errProcessChanged, err)
+	}
+	return err
+}
+
+func getRunbookProcessVersion(runbookProcess *runbooks.RunbookProcess) int32 {
+	if runbookProcess.Version == nil {
+		return 0
+	}
+	return *runbookProcess.Version
+}
+
+func isConflict(err error) bool {
+	if errors.Is(err, errProcessChanged) {
+		return true
+	}
+
+	apiError, ok := err.(*core.APIError)
+	return ok && apiError.StatusCode == http.StatusConflict
+}
@@ -0,0 +1,91 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateProcess(t *testing.T) {
+	conflict := &core.APIError{StatusCode: http.StatusConflict}
+
+	calls := 0
+	err := updateProcess(context.Background(), 3, func() error {
+		calls++
+		if calls < 3 {
+			return conflict
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	calls = 0
+	err = updateProcess(context.Background(), 2, func() error {
+		calls++
+		return conflict
+	})
+	require.Equal(t, conflict, err)
+	require.Equal(t, 2, calls)
+
+	// errors other than conflicts are not retried
+	calls = 0
+	err = updateProcess(context.Background(), 3, func() error {
+		calls++
+		return fmt.Errorf("error updating process")
+	})
+	require.EqualError(t, err, "error updating process")
+	require.Equal(t, 1, calls)
+}
+
+func TestGetProcessUpdateAttempts(t *testing.T) {
+	meta := newProviderMeta(nil)
+	require.Equal(t, defaultProcessUpdateAttempts, getProcessUpdateAttempts(meta))
+
+	meta.processUpdateAttempts = 5
+	require.Equal(t, 5, getProcessUpdateAttempts(meta))
+}
+
+func TestGetProcessUpdateError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api":
+			w.Write([]byte(`{"Links":{}}`))
+		case "/api/Spaces-1":
+			w.Write([]byte(`{"Id":"Spaces-1","Links":{}}`))
+		case "/api/Spaces-1/deploymentprocesses/deploymentprocess-Projects-1":
+			w.Write([]byte(`{"Id":"deploymentprocess-Projects-1","Version":4}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+
+	self := "/api/Spaces-1/deploymentprocesses/deploymentprocess-Projects-1"
+	updateErr := fmt.Errorf("octopus deploy api returned an error")
+
+	require.NoError(t, getProcessUpdateError(octopus, self, 3, nil))
+
+	// the process was changed after version 3 was read
+	err = getProcessUpdateError(octopus, self, 3, updateErr)
+	require.ErrorIs(t, err, errProcessChanged)
+	require.ErrorIs(t, err, updateErr)
+	require.True(t, isConflict(err))
+
+	// the update was rejected for another reason
+	err = getProcessUpdateError(octopus, self, 4, updateErr)
+	require.Equal(t, updateErr, err)
+	require.False(t, isConflict(err))
+}
@@ -0,0 +1,49 @@
+package octopusdeploy
+
+import (
+	"sync"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+)
+
+// projectLookups holds the projects that deployment processes have looked up.
+// Creating, reading and updating a deployment process each need its project, so holding on to the projects for the
+// lifetime of the provider saves a request for each deployment process in a plan or apply.
+type projectLookups struct {
+	mutex    sync.Mutex
+	projects map[string]*projects.Project
+}
+
+// getProject returns the project with the given ID, which is only requested from Octopus Deploy with the given client
+// the first time that it is needed.
+func getProject(m interface{}, octopus *client.Client, projectID string) (*projects.Project, error) {
+	lookups := m.(*providerMeta).projectLookups
+
+	lookups.mutex.Lock()
+	project, ok := lookups.projects[projectID]
+	lookups.mutex.Unlock()
+	if ok {
+		return project, nil
+	}
+
+	project, err := octopus.Projects.GetByID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	lookups.mutex.Lock()
+	lookups.projects[projectID] = project
+	lookups.mutex.Unlock()
+	return project, nil
+}
+
+// forgetProject discards the project with the given ID once it has been changed or deleted, so that it is requested
+// again the next time that it is needed.
+func forgetProject(m interface{}, projectID string) {
+	lookups := m.(*providerMeta).projectLookups
+
+	lookups.mutex.Lock()
+	delete(lookups.projects, projectID)
+	lookups.mutex.Unlock()
+}
@@ -0,0 +1,55 @@
+package octopusdeploy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProject(t *testing.T) {
+	projectRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api":
+			w.Write([]byte(`{"Links":{"Projects":"/api/projects{/id}"}}`))
+		case "/api/Spaces-1":
+			w.Write([]byte(`{"Id":"Spaces-1","Links":{"Projects":"/api/Spaces-1/projects{/id}"}}`))
+		case "/api/Spaces-1/projects/Projects-1":
+			projectRequests++
+			w.Write([]byte(`{"Id":"Projects-1","Name":"Test","LifecycleId":"Lifecycles-1","ProjectGroupId":"ProjectGroups-1"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"ErrorMessage":"Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+	meta := newProviderMeta(octopus)
+
+	for i := 0; i < 3; i++ {
+		project, err := getProject(meta, octopus, "Projects-1")
+		require.NoError(t, err)
+		require.Equal(t, "Test", project.Name)
+	}
+	require.Equal(t, 1, projectRequests)
+
+	// a project that has been changed is requested again
+	forgetProject(meta, "Projects-1")
+	_, err = getProject(meta, octopus, "Projects-1")
+	require.NoError(t, err)
+	require.Equal(t, 2, projectRequests)
+
+	// a project that could not be found is not held on to
+	_, err = getProject(meta, octopus, "Projects-2")
+	require.Error(t, err)
+	_, ok := meta.projectLookups.projects["Projects-2"]
+	require.False(t, ok)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider is the plugin entry point for the Terraform provider for Octopus Deploy.
@@ -15,28 +16,38 @@ func Provider() *schema.Provider {
 			"octopusdeploy_azure_cloud_service_deployment_targets":          dataSourceAzureCloudServiceDeploymentTargets(),
 			"octopusdeploy_azure_service_fabric_cluster_deployment_targets": dataSourceAzureServiceFabricClusterDeploymentTargets(),
 			"octopusdeploy_azure_web_app_deployment_targets":                dataSourceAzureWebAppDeploymentTargets(),
+			"octopusdeploy_build_information":                               dataSourceBuildInformation(),
 			"octopusdeploy_certificates":                                    dataSourceCertificates(),
 			"octopusdeploy_cloud_region_deployment_targets":                 dataSourceCloudRegionDeploymentTargets(),
 			"octopusdeploy_channels":                                        dataSourceChannels(),
+			"octopusdeploy_deployment_process":                              dataSourceDeploymentProcess(),
 			"octopusdeploy_deployment_targets":                              dataSourceDeploymentTargets(),
+			"octopusdeploy_deployments":                                     dataSourceDeployments(),
 			"octopusdeploy_environments":                                    dataSourceEnvironments(),
 			"octopusdeploy_feeds":                                           dataSourceFeeds(),
 			"octopusdeploy_git_credentials":                                 dataSourceGitCredentials(),
+			"octopusdeploy_insights":                                        dataSourceInsights(),
 			"octopusdeploy_kubernetes_cluster_deployment_targets":           dataSourceKubernetesClusterDeploymentTargets(),
 			"octopusdeploy_library_variable_sets":                           dataSourceLibraryVariableSet(),
 			"octopusdeploy_lifecycles":                                      dataSourceLifecycles(),
 			"octopusdeploy_listening_tentacle_deployment_targets":           dataSourceListeningTentacleDeploymentTargets(),
 			"octopusdeploy_machine":                                         dataSourceMachine(),
 			"octopusdeploy_machine_policies":                                dataSourceMachinePolicies(),
+			"octopusdeploy_machine_roles":                                   dataSourceMachineRoles(),
 			"octopusdeploy_offline_package_drop_deployment_targets":         dataSourceOfflinePackageDropDeploymentTargets(),
 			"octopusdeploy_polling_tentacle_deployment_targets":             dataSourcePollingTentacleDeploymentTargets(),
 			"octopusdeploy_project_groups":                                  dataSourceProjectGroups(),
 			"octopusdeploy_projects":                                        dataSourceProjects(),
 			"octopusdeploy_script_modules":                                  dataSourceScriptModules(),
+			"octopusdeploy_server":                                          dataSourceServer(),
+			"octopusdeploy_slug":                                            dataSourceSlug(),
 			"octopusdeploy_space":                                           dataSourceSpace(),
 			"octopusdeploy_spaces":                                          dataSourceSpaces(),
 			"octopusdeploy_ssh_connection_deployment_targets":               dataSourceSSHConnectionDeploymentTargets(),
+			"octopusdeploy_step_templates":                                  dataSourceStepTemplates(),
+			"octopusdeploy_subscriptions":                                   dataSourceSubscriptions(),
 			"octopusdeploy_tag_sets":                                        dataSourceTagSets(),
+			"octopusdeploy_tasks":                                           dataSourceTasks(),
 			"octopusdeploy_teams":                                           dataSourceTeams(),
 			"octopusdeploy_tenants":                                         dataSourceTenants(),
 			"octopusdeploy_users":                                           dataSourceUsers(),
@@ -45,6 +56,7 @@ func Provider() *schema.Provider {
 			"octopusdeploy_worker_pools":                                    dataSourceWorkerPools(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
+			"octopusdeploy_active_directory_authentication":                resourceActiveDirectoryAuthentication(),
 			"octopusdeploy_aws_account":                                    resourceAmazonWebServicesAccount(),
 			"octopusdeploy_aws_elastic_container_registry":                 resourceAwsElasticContainerRegistry(),
 			"octopusdeploy_azure_cloud_service_deployment_target":          resourceAzureCloudServiceDeploymentTarget(),
@@ -52,33 +64,54 @@ func Provider() *schema.Provider {
 			"octopusdeploy_azure_service_principal":                        resourceAzureServicePrincipalAccount(),
 			"octopusdeploy_azure_subscription_account":                     resourceAzureSubscriptionAccount(),
 			"octopusdeploy_azure_web_app_deployment_target":                resourceAzureWebAppDeploymentTarget(),
+			"octopusdeploy_built_in_trigger":                               resourceBuiltInTrigger(),
 			"octopusdeploy_certificate":                                    resourceCertificate(),
 			"octopusdeploy_channel":                                        resourceChannel(),
 			"octopusdeploy_cloud_region_deployment_target":                 resourceCloudRegionDeploymentTarget(),
+			"octopusdeploy_deployment":                                     resourceDeployment(),
 			"octopusdeploy_deployment_process":                             resourceDeploymentProcess(),
 			"octopusdeploy_docker_container_registry":                      resourceDockerContainerRegistry(),
 			"octopusdeploy_dynamic_worker_pool":                            resourceDynamicWorkerPool(),
+			"octopusdeploy_entra_id_authentication":                        resourceEntraIDAuthentication(),
 			"octopusdeploy_environment":                                    resourceEnvironment(),
+			"octopusdeploy_event_retention":                                resourceEventRetention(),
+			"octopusdeploy_external_feed_create_release_trigger":           resourceExternalFeedCreateReleaseTrigger(),
+			"octopusdeploy_features_configuration":                         resourceFeaturesConfiguration(),
 			"octopusdeploy_git_credential":                                 resourceGitCredential(),
+			"octopusdeploy_git_trigger":                                    resourceGitTrigger(),
 			"octopusdeploy_github_repository_feed":                         resourceGitHubRepositoryFeed(),
 			"octopusdeploy_gcp_account":                                    resourceGoogleCloudPlatformAccount(),
+			"octopusdeploy_google_apps_authentication":                     resourceGoogleAppsAuthentication(),
+			"octopusdeploy_health_check":                                   resourceHealthCheck(),
 			"octopusdeploy_helm_feed":                                      resourceHelmFeed(),
+			"octopusdeploy_jira_integration":                               resourceJiraIntegration(),
 			"octopusdeploy_kubernetes_cluster_deployment_target":           resourceKubernetesClusterDeploymentTarget(),
 			"octopusdeploy_library_variable_set":                           resourceLibraryVariableSet(),
+			"octopusdeploy_license":                                        resourceLicense(),
 			"octopusdeploy_lifecycle":                                      resourceLifecycle(),
 			"octopusdeploy_listening_tentacle_deployment_target":           resourceListeningTentacleDeploymentTarget(),
 			"octopusdeploy_machine_policy":                                 resourceMachinePolicy(),
 			"octopusdeploy_maven_feed":                                     resourceMavenFeed(),
 			"octopusdeploy_nuget_feed":                                     resourceNuGetFeed(),
 			"octopusdeploy_offline_package_drop_deployment_target":         resourceOfflinePackageDropDeploymentTarget(),
+			"octopusdeploy_okta_authentication":                            resourceOktaAuthentication(),
 			"octopusdeploy_polling_tentacle_deployment_target":             resourcePollingTentacleDeploymentTarget(),
+			"octopusdeploy_process_step":                                   resourceProcessStep(),
+			"octopusdeploy_process_steps_order":                            resourceProcessStepsOrder(),
 			"octopusdeploy_project":                                        resourceProject(),
+			"octopusdeploy_project_deployment_settings":                    resourceProjectDeploymentSettings(),
 			"octopusdeploy_project_deployment_target_trigger":              resourceProjectDeploymentTargetTrigger(),
 			"octopusdeploy_project_group":                                  resourceProjectGroup(),
+			"octopusdeploy_project_library_variable_set":                   resourceProjectLibraryVariableSet(),
+			"octopusdeploy_project_scheduled_trigger":                      resourceProjectScheduledTrigger(),
+			"octopusdeploy_release":                                        resourceRelease(),
 			"octopusdeploy_runbook":                                        resourceRunbook(),
 			"octopusdeploy_runbook_process":                                resourceRunbookProcess(),
+			"octopusdeploy_runbook_scheduled_trigger":                      resourceRunbookScheduledTrigger(),
+			"octopusdeploy_runbook_snapshot":                               resourceRunbookSnapshot(),
 			"octopusdeploy_scoped_user_role":                               resourceScopedUserRole(),
 			"octopusdeploy_script_module":                                  resourceScriptModule(),
+			"octopusdeploy_smtp_configuration":                             resourceSmtpConfiguration(),
 			"octopusdeploy_space":                                          resourceSpace(),
 			"octopusdeploy_ssh_connection_deployment_target":               resourceSSHConnectionDeploymentTarget(),
 			"octopusdeploy_ssh_key_account":                                resourceSSHKeyAccount(),
@@ -94,25 +127,118 @@ func Provider() *schema.Provider {
 			"octopusdeploy_user_role":                                      resourceUserRole(),
 			"octopusdeploy_username_password_account":                      resourceUsernamePasswordAccount(),
 			"octopusdeploy_variable":                                       resourceVariable(),
+			"octopusdeploy_variables":                                      resourceVariables(),
 		},
 		Schema: map[string]*schema.Schema{
+			"access_token": {
+				Description: "An access token to use with the Octopus REST API, in place of an API key.",
+				Optional:    true,
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
 			"address": {
 				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_URL", nil),
 				Description: "The endpoint of the Octopus REST API",
-				Required:    true,
+				Optional:    true,
 				Type:        schema.TypeString,
 			},
 			"api_key": {
-				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_APIKEY", nil),
-				Description: "The API key to use with the Octopus REST API",
-				Required:    true,
+				Description: "The API key to use with the Octopus REST API. One of `access_token`, `api_key` or `id_token` must be configured.",
+				Optional:    true,
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+			"ca_cert_file": {
+				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_CA_CERT_FILE", nil),
+				Description: "The path of a file with one or more PEM-encoded CA certificates to trust when connecting to the Octopus Server, in addition to those of the system.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"ca_cert_pem": {
+				Description: "One or more PEM-encoded CA certificates to trust when connecting to the Octopus Server, in addition to those of the system.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"credentials_file": {
+				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_CREDENTIALS_FILE", nil),
+				Description: "The path of a JSON file with the `address`, `access_token` or `api_key`, and `space_id` or `space_name` to use when they are not configured in the provider block or by environment variables.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"id_token": {
+				Description: "An OIDC ID token (e.g. from GitHub Actions or Azure DevOps) to exchange for an access token of the service account given by `service_account_id`, in place of an API key. The service account must have an OIDC identity that trusts the issuer and subject of the token. The access token is exchanged again before it expires, so the ID token must remain valid for as long as Terraform runs.",
+				Optional:    true,
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+			"max_concurrent_requests": {
+				DefaultFunc:      schema.EnvDefaultFunc("OCTOPUS_MAX_CONCURRENT_REQUESTS", 0),
+				Description:      "The maximum number of requests to send to the Octopus Server at once. Defaults to no limit.",
+				Optional:         true,
+				Type:             schema.TypeInt,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"max_process_update_attempts": {
+				DefaultFunc:      schema.EnvDefaultFunc("OCTOPUS_MAX_PROCESS_UPDATE_ATTEMPTS", defaultProcessUpdateAttempts),
+				Description:      "The number of attempts to make to update a deployment or runbook process when it is changed by something else between being read and written, such as a change to its project. Defaults to `3`.",
+				Optional:         true,
+				Type:             schema.TypeInt,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"max_requests_per_second": {
+				DefaultFunc:      schema.EnvDefaultFunc("OCTOPUS_MAX_REQUESTS_PER_SECOND", 0),
+				Description:      "The maximum number of requests to send to the Octopus Server each second. Defaults to no limit.",
+				Optional:         true,
+				Type:             schema.TypeInt,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"proxy_password": {
+				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_PROXY_PASSWORD", nil),
+				Description: "The password to authenticate with the proxy given by `proxy_url`.",
+				Optional:    true,
+				Sensitive:   true,
 				Type:        schema.TypeString,
 			},
+			"proxy_url": {
+				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_PROXY_URL", nil),
+				Description: "The URL of the proxy to connect to the Octopus Server through (e.g. `http://proxy.example.com:3128`). Defaults to the proxy given by the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"proxy_username": {
+				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_PROXY_USERNAME", nil),
+				Description: "The username to authenticate with the proxy given by `proxy_url`.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"service_account_id": {
+				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_SERVICE_ACCOUNT_ID", nil),
+				Description: "The ID of the service account to authenticate as with `id_token`. This is the audience of the OIDC identity of the service account.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"skip_tls_verification": {
+				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_SKIP_TLS_VERIFICATION", false),
+				Description: "Whether to skip verification of the TLS certificate of the Octopus Server. This leaves the connection open to interception, and should only be used with servers in lab environments that have self-signed certificates.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
 			"space_id": {
 				Description: "The space ID to target",
 				Optional:    true,
 				Type:        schema.TypeString,
 			},
+			"space_name": {
+				Description: "The name of the space to target, in place of `space_id`. The space is found by its name when the provider is configured.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"validate_references": {
+				DefaultFunc: schema.EnvDefaultFunc("OCTOPUS_VALIDATE_REFERENCES", false),
+				Description: "Whether to check, when planning, that the environments, feeds, lifecycles and worker pools referred to by deployment processes, runbook processes, process steps, projects, channels and lifecycles exist in their space. This makes a request to the Octopus REST API for each referenced ID.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
 		},
 
 		ConfigureContextFunc: providerConfigure,
@@ -121,13 +247,45 @@ func Provider() *schema.Provider {
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	config := Config{
-		Address: d.Get("address").(string),
-		APIKey:  d.Get("api_key").(string),
+		AccessToken:              d.Get("access_token").(string),
+		Address:                  d.Get("address").(string),
+		APIKey:                   d.Get("api_key").(string),
+		CACertFile:               d.Get("ca_cert_file").(string),
+		CACertPEM:                d.Get("ca_cert_pem").(string),
+		IDToken:                  d.Get("id_token").(string),
+		MaxConcurrentRequests:    d.Get("max_concurrent_requests").(int),
+		MaxProcessUpdateAttempts: d.Get("max_process_update_attempts").(int),
+		MaxRequestsPerSecond:     d.Get("max_requests_per_second").(int),
+		ProxyPassword:            d.Get("proxy_password").(string),
+		ProxyURL:                 d.Get("proxy_url").(string),
+		ProxyUsername:            d.Get("proxy_username").(string),
+		ServiceAccountID:         d.Get("service_account_id").(string),
+		SkipTLSVerification:      d.Get("skip_tls_verification").(bool),
+		ValidateReferences:       d.Get("validate_references").(bool),
 	}
 
 	if spaceID, ok := d.GetOk("space_id"); ok {
 		config.SpaceID = spaceID.(string)
 	}
 
-	return config.Client()
+	if spaceName, ok := d.GetOk("space_name"); ok {
+		config.SpaceName = spaceName.(string)
+	}
+
+	if credentialsFile, ok := d.GetOk("credentials_file"); ok {
+		config.CredentialsFile = credentialsFile.(string)
+	}
+
+	octopus, diags := config.Client(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	meta := newProviderMeta(octopus)
+	if config.MaxProcessUpdateAttempts > 0 {
+		meta.processUpdateAttempts = config.MaxProcessUpdateAttempts
+	}
+	meta.validateReferences = config.ValidateReferences
+
+	return meta, diags
 }
@@ -0,0 +1,35 @@
+package octopusdeploy
+
+import (
+	"sync"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+)
+
+// providerMeta is returned when the provider is configured and is given to its resources and data sources. Along with
+// the client of the provider, it holds the settings and lookups that last for as long as the provider is configured.
+type providerMeta struct {
+	client                *client.Client
+	processLocks          *processLocks
+	processUpdateAttempts int
+	projectLookups        *projectLookups
+	spaceClients          *spaceClients
+	validateReferences    bool
+}
+
+func newProviderMeta(octopus *client.Client) *providerMeta {
+	return &providerMeta{
+		client:                octopus,
+		processLocks:          &processLocks{locks: map[string]*sync.Mutex{}},
+		processUpdateAttempts: defaultProcessUpdateAttempts,
+		projectLookups:        &projectLookups{projects: map[string]*projects.Project{}},
+		spaceClients:          &spaceClients{clients: map[string]*client.Client{}},
+	}
+}
+
+// getClient returns the client of the provider, which is scoped to the space that the provider is configured with.
+// Use getSpaceClient for resources and data sources that have a space_id.
+func getClient(m interface{}) *client.Client {
+	return m.(*providerMeta).client
+}
@@ -0,0 +1,80 @@
+package octopusdeploy
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+type rateLimitedTransport struct {
+	interval  time.Duration
+	mutex     sync.Mutex
+	next      time.Time
+	requests  chan struct{}
+	transport http.RoundTripper
+}
+
+// RoundTrip waits until the request is within the limits of the number of concurrent requests and the number of
+// requests per second before sending it.
+func (t *rateLimitedTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+
+	if t.requests != nil {
+		select {
+		case t.requests <- struct{}{}:
+			defer func() { <-t.requests }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if t.interval > 0 {
+		t.mutex.Lock()
+		now := time.Now()
+		if t.next.Before(now) {
+			t.next = now
+		}
+		wait := t.next.Sub(now)
+		t.next = t.next.Add(t.interval)
+		t.mutex.Unlock()
+
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+			defer timer.Stop()
+
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	return t.transport.RoundTrip(request)
+}
+
+// newRateLimitedHTTPClient returns a copy of the HTTP client that sends at most the given number of concurrent
+// requests and requests per second. A limit of zero is no limit.
+func newRateLimitedHTTPClient(httpClient *http.Client, maxConcurrentRequests int, maxRequestsPerSecond int) *http.Client {
+	if maxConcurrentRequests <= 0 && maxRequestsPerSecond <= 0 {
+		return httpClient
+	}
+
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	rateLimitedTransport := &rateLimitedTransport{transport: transport}
+	if maxConcurrentRequests > 0 {
+		rateLimitedTransport.requests = make(chan struct{}, maxConcurrentRequests)
+	}
+	if maxRequestsPerSecond > 0 {
+		rateLimitedTransport.interval = time.Second / time.Duration(maxRequestsPerSecond)
+	}
+
+	rateLimitedHTTPClient := *httpClient
+	rateLimitedHTTPClient.Transport = rateLimitedTransport
+
+	return &rateLimitedHTTPClient
+}
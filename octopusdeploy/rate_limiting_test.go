@@ -0,0 +1,71 @@
+package octopusdeploy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitedHTTPClientConcurrentRequests(t *testing.T) {
+	var requests, maxRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&requests, 1)
+		defer atomic.AddInt32(&requests, -1)
+
+		for {
+			max := atomic.LoadInt32(&maxRequests)
+			if current <= max || atomic.CompareAndSwapInt32(&maxRequests, max, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	httpClient := newRateLimitedHTTPClient(&http.Client{}, 2, 0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			response, err := httpClient.Get(server.URL)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			response.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	require.LessOrEqual(t, atomic.LoadInt32(&maxRequests), int32(2))
+}
+
+func TestRateLimitedHTTPClientRequestsPerSecond(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	httpClient := newRateLimitedHTTPClient(&http.Client{}, 0, 20)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		response, err := httpClient.Get(server.URL)
+		require.NoError(t, err)
+		response.Body.Close()
+	}
+
+	// the first request is sent at once, and each of the others waits for 50ms
+	require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+}
+
+func TestRateLimitedHTTPClientWithoutLimits(t *testing.T) {
+	httpClient := &http.Client{}
+	require.Same(t, httpClient, newRateLimitedHTTPClient(httpClient, 0, 0))
+}
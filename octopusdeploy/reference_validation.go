@@ -0,0 +1,107 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// referenceAttributes are the attributes that refer to other resources by ID, and the kind of resource they refer to.
+var referenceAttributes = map[string]string{
+	"automatic_deployment_targets": "environment",
+	"default_worker_pool_id":       "worker pool",
+	"environments":                 "environment",
+	"excluded_environments":        "environment",
+	"feed_id":                      "feed",
+	"lifecycle_id":                 "lifecycle",
+	"optional_deployment_targets":  "environment",
+	"worker_pool_id":               "worker pool",
+}
+
+// validateReferences ensures that the environments, feeds, lifecycles and worker pools referred to by the
+// configuration of a resource exist in its space, so that a mistyped ID is reported when planning rather than part
+// way through applying changes. Lookups are only made when the provider is configured with validate_references, and
+// IDs that are not yet known, or that are variable expressions, are not checked.
+func validateReferences(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if meta, ok := m.(*providerMeta); !ok || !meta.validateReferences {
+		return nil
+	}
+
+	references := map[string]string{}
+	getReferences(d.GetRawConfig(), "", references)
+	if len(references) == 0 {
+		return nil
+	}
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(references))
+	for id := range references {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if err := getReference(client, references[id], id); err != nil {
+			if apiError, ok := err.(*core.APIError); ok && apiError.StatusCode == http.StatusNotFound {
+				_, spaceID := splitClientBaseURL(client.HttpSession().BaseURL)
+				return fmt.Errorf("the %s %s could not be found in the space %s", references[id], id, spaceID)
+			}
+			return fmt.Errorf("error finding the %s %s: %s", references[id], id, err)
+		}
+	}
+
+	return nil
+}
+
+// getReferences adds the IDs within a configuration value that are held by one of the reference attributes, along
+// with the kind of resource that they refer to.
+func getReferences(value cty.Value, attribute string, references map[string]string) {
+	if value.IsNull() || !value.IsKnown() {
+		return
+	}
+
+	valueType := value.Type()
+	switch {
+	case valueType == cty.String:
+		if kind, ok := referenceAttributes[attribute]; ok {
+			id := value.AsString()
+			if len(strings.TrimSpace(id)) > 0 && !strings.Contains(id, "#{") {
+				references[id] = kind
+			}
+		}
+	case valueType.IsObjectType():
+		for name := range valueType.AttributeTypes() {
+			getReferences(value.GetAttr(name), name, references)
+		}
+	case valueType.IsListType() || valueType.IsSetType() || valueType.IsTupleType():
+		for _, element := range value.AsValueSlice() {
+			getReferences(element, attribute, references)
+		}
+	}
+}
+
+func getReference(octopus *client.Client, kind string, id string) error {
+	var err error
+	switch kind {
+	case "environment":
+		_, err = octopus.Environments.GetByID(id)
+	case "feed":
+		_, err = octopus.Feeds.GetByID(id)
+	case "lifecycle":
+		_, err = octopus.Lifecycles.GetByID(id)
+	case "worker pool":
+		_, err = octopus.WorkerPools.GetByID(id)
+	}
+	return err
+}
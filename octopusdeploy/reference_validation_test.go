@@ -0,0 +1,46 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReferences(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"lifecycle_id": cty.StringVal("Lifecycles-1"),
+		"name":         cty.StringVal("Environments-99"),
+		"step": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"action": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"environments":          cty.SetVal([]cty.Value{cty.StringVal("Environments-1"), cty.StringVal("Environments-2")}),
+				"excluded_environments": cty.NullVal(cty.Set(cty.String)),
+				"package": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"feed_id": cty.StringVal("#{Project.Feed}"),
+				})}),
+				"primary_package": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"feed_id": cty.StringVal("Feeds-1"),
+				})}),
+				"properties": cty.MapVal(map[string]cty.Value{
+					"lifecycle_id": cty.StringVal("Lifecycles-2"),
+				}),
+				"worker_pool_id": cty.UnknownVal(cty.String),
+			})}),
+		})}),
+	})
+
+	references := map[string]string{}
+	getReferences(config, "", references)
+
+	require.Equal(t, map[string]string{
+		"Environments-1": "environment",
+		"Environments-2": "environment",
+		"Feeds-1":        "feed",
+		"Lifecycles-1":   "lifecycle",
+	}, references)
+}
+
+func TestValidateReferencesIsOptional(t *testing.T) {
+	require.NoError(t, validateReferences(context.Background(), nil, nil))
+}
@@ -0,0 +1,102 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/configuration"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceActiveDirectoryAuthentication() *schema.Resource {
+	return resourceAuthentication(
+		configuration.ActiveDirectoryAuthenticationID,
+		"Active Directory",
+		getActiveDirectoryAuthenticationSchema(),
+		expandActiveDirectoryAuthentication,
+		setActiveDirectoryAuthentication,
+	)
+}
+
+func resourceEntraIDAuthentication() *schema.Resource {
+	return resourceAuthentication(
+		configuration.EntraIDAuthenticationID,
+		"Microsoft Entra ID (formerly Azure AD)",
+		getEntraIDAuthenticationSchema(),
+		expandEntraIDAuthentication,
+		setEntraIDAuthentication,
+	)
+}
+
+func resourceGoogleAppsAuthentication() *schema.Resource {
+	return resourceAuthentication(
+		configuration.GoogleAppsAuthenticationID,
+		"Google Apps",
+		getGoogleAppsAuthenticationSchema(),
+		expandGoogleAppsAuthentication,
+		setGoogleAppsAuthentication,
+	)
+}
+
+func resourceOktaAuthentication() *schema.Resource {
+	return resourceAuthentication(
+		configuration.OktaAuthenticationID,
+		"Okta",
+		getOktaAuthenticationSchema(),
+		expandOktaAuthentication,
+		setOktaAuthentication,
+	)
+}
+
+// resourceAuthentication returns a resource that manages the settings of an authentication provider. Each
+// authentication provider has exactly one configuration section per Octopus instance, which cannot be deleted, so
+// deleting the resource only removes it from state.
+func resourceAuthentication[T any](id string, name string, authenticationSchema map[string]*schema.Schema, expand func(*schema.ResourceData) *T, set func(*schema.ResourceData, *T)) *schema.Resource {
+	update := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		tflog.Info(ctx, fmt.Sprintf("updating %s authentication (%s)", name, id))
+
+		authentication, err := configuration.UpdateConfigurationValues(getClient(m), id, expand(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		set(d, authentication)
+		d.SetId(id)
+
+		tflog.Info(ctx, fmt.Sprintf("%s authentication updated (%s)", name, d.Id()))
+		return nil
+	}
+
+	return &schema.Resource{
+		CreateContext: update,
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			tflog.Info(ctx, fmt.Sprintf("removing %s authentication from state (%s)", name, d.Id()))
+
+			d.SetId("")
+			return nil
+		},
+		Description: fmt.Sprintf("This resource manages the settings of the %s authentication provider of Octopus Deploy. There is exactly one set of these settings per Octopus instance.", name),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			tflog.Info(ctx, fmt.Sprintf("reading %s authentication (%s)", name, d.Id()))
+
+			authentication, err := configuration.GetConfigurationValues[T](getClient(m), id)
+			if err != nil {
+				return errors.ProcessApiError(ctx, d, err, name+" authentication")
+			}
+
+			set(d, authentication)
+			d.SetId(id)
+
+			tflog.Info(ctx, fmt.Sprintf("%s authentication read (%s)", name, d.Id()))
+			return nil
+		},
+		Schema:        authenticationSchema,
+		UpdateContext: update,
+	}
+}
@@ -0,0 +1,69 @@
+package octopusdeploy
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOktaAuthenticationCreate(t *testing.T) {
+	var updatedValues []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api":
+			w.Write([]byte(`{"Links":{"Configuration":"/api/configuration{/id}"}}`))
+		case r.URL.Path == "/api/Spaces-1":
+			w.Write([]byte(`{"Id":"Spaces-1","Links":{}}`))
+		case r.URL.Path == "/api/configuration/authentication-od/values" && r.Method == http.MethodPut:
+			var values map[string]interface{}
+			body, _ := io.ReadAll(r.Body)
+			require.NoError(t, json.Unmarshal(body, &values))
+			updatedValues = append(updatedValues, values)
+
+			// Octopus never returns the client secret
+			w.Write([]byte(`{"IsEnabled":true,"Issuer":"https://example.okta.com","ClientId":"0oa1","ClientSecret":{"HasValue":true},"RoleClaimType":"groups","UsernameClaimType":"preferred_username","AllowAutoUserCreation":false}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"ErrorMessage":"Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+	meta := newProviderMeta(octopus)
+
+	d := resourceOktaAuthentication().TestResourceData()
+	d.Set("allow_auto_user_creation", false)
+	d.Set("client_id", "0oa1")
+	d.Set("client_secret", "secret")
+	d.Set("is_enabled", true)
+	d.Set("issuer", "https://example.okta.com")
+	d.Set("role_claim_type", "groups")
+
+	require.False(t, resourceOktaAuthentication().CreateContext(context.Background(), d, meta).HasError())
+	require.Equal(t, "authentication-od", d.Id())
+	require.Equal(t, "preferred_username", d.Get("username_claim_type"))
+	require.Equal(t, "secret", d.Get("client_secret"))
+
+	require.Len(t, updatedValues, 1)
+	require.Equal(t, "0oa1", updatedValues[0]["ClientId"])
+	require.Equal(t, "groups", updatedValues[0]["RoleClaimType"])
+	require.Equal(t, false, updatedValues[0]["AllowAutoUserCreation"])
+	require.Equal(t, "secret", updatedValues[0]["ClientSecret"].(map[string]interface{})["NewValue"])
+
+	// the client secret held by Octopus is kept when it is not configured
+	d.Set("client_secret", "")
+	require.False(t, resourceOktaAuthentication().UpdateContext(context.Background(), d, meta).HasError())
+	require.Len(t, updatedValues, 2)
+	require.Equal(t, map[string]interface{}{"HasValue": true, "Hint": nil, "NewValue": nil}, updatedValues[1]["ClientSecret"])
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -17,7 +16,7 @@ func resourceAwsElasticContainerRegistry() *schema.Resource {
 		CreateContext: resourceAwsElasticContainerRegistryCreate,
 		DeleteContext: resourceAwsElasticContainerRegistryDelete,
 		Description:   "This resource manages an AWS Elastic Container Registry in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("feed", findFeeds),
 		ReadContext:   resourceAwsElasticContainerRegistryRead,
 		Schema:        getAwsElasticContainerRegistrySchema(),
 		UpdateContext: resourceAwsElasticContainerRegistryUpdate,
@@ -32,7 +31,11 @@ func resourceAwsElasticContainerRegistryCreate(ctx context.Context, d *schema.Re
 
 	tflog.Info(ctx, fmt.Sprintf("creating AWS Elastic Container Registry, %s", feed.GetName()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdFeed, err := client.Feeds.Add(feed)
 	if err != nil {
 		return diag.FromErr(err)
@@ -51,8 +54,12 @@ func resourceAwsElasticContainerRegistryCreate(ctx context.Context, d *schema.Re
 func resourceAwsElasticContainerRegistryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("deleting AWS Elastic Container Registry (%s)", d.Id()))
 
-	client := m.(*client.Client)
-	err := client.Feeds.DeleteByID(d.Id())
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.Feeds.DeleteByID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -66,7 +73,11 @@ func resourceAwsElasticContainerRegistryDelete(ctx context.Context, d *schema.Re
 func resourceAwsElasticContainerRegistryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("reading AWS Elastic Container Registry (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	feed, err := client.Feeds.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "AWS Elastic Container Registry")
@@ -89,7 +100,11 @@ func resourceAwsElasticContainerRegistryUpdate(ctx context.Context, d *schema.Re
 
 	tflog.Info(ctx, fmt.Sprintf("updating AWS Elastic Container Registry (%s)", awsElasticContainerRegistry.GetID()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedFeed, err := client.Feeds.Update(awsElasticContainerRegistry)
 	if err != nil {
 		return diag.FromErr(err)
@@ -3,8 +3,8 @@ package octopusdeploy
 import (
 	"context"
 	"log"
+	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,11 +13,19 @@ import (
 func resourceAzureCloudServiceDeploymentTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAzureCloudServiceDeploymentTargetCreate,
+		CustomizeDiff: validateDeploymentTargetTenants,
 		DeleteContext: resourceAzureCloudServiceDeploymentTargetDelete,
 		Description:   "This resource manages Azure cloud service deployment targets in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("deployment target", findDeploymentTargets),
 		ReadContext:   resourceAzureCloudServiceDeploymentTargetRead,
 		Schema:        getAzureCloudServiceDeploymentTargetSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getAzureCloudServiceDeploymentTargetSchema(), "environments", "roles", "tenant_tags", "tenants"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		UpdateContext: resourceAzureCloudServiceDeploymentTargetUpdate,
 	}
 }
@@ -27,7 +35,11 @@ func resourceAzureCloudServiceDeploymentTargetCreate(ctx context.Context, d *sch
 
 	log.Printf("[INFO] creating Azure cloud service deployment target: %#v", deploymentTarget)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdDeploymentTarget, err := client.Machines.Add(deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
@@ -39,6 +51,10 @@ func resourceAzureCloudServiceDeploymentTargetCreate(ctx context.Context, d *sch
 
 	d.SetId(createdDeploymentTarget.GetID())
 
+	if diags := waitForCreatedDeploymentTarget(ctx, d, client, setAzureCloudServiceDeploymentTarget); diags.HasError() {
+		return diags
+	}
+
 	log.Printf("[INFO] Azure cloud service deployment target created (%s)", d.Id())
 	return nil
 }
@@ -46,7 +62,11 @@ func resourceAzureCloudServiceDeploymentTargetCreate(ctx context.Context, d *sch
 func resourceAzureCloudServiceDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting Azure cloud service deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Machines.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +80,11 @@ func resourceAzureCloudServiceDeploymentTargetDelete(ctx context.Context, d *sch
 func resourceAzureCloudServiceDeploymentTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading Azure cloud service deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentTarget, err := client.Machines.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "Azure cloud service deployment target")
@@ -78,7 +102,11 @@ func resourceAzureCloudServiceDeploymentTargetUpdate(ctx context.Context, d *sch
 	log.Printf("[INFO] updating Azure cloud service deployment target (%s)", d.Id())
 
 	deploymentTarget := expandAzureCloudServiceDeploymentTarget(d)
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedDeploymentTarget, err := client.Machines.Update(deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
@@ -3,8 +3,8 @@ package octopusdeploy
 import (
 	"context"
 	"log"
+	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,11 +13,19 @@ import (
 func resourceAzureServiceFabricClusterDeploymentTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAzureServiceFabricClusterDeploymentTargetCreate,
+		CustomizeDiff: validateDeploymentTargetTenants,
 		DeleteContext: resourceAzureServiceFabricClusterDeploymentTargetDelete,
 		Description:   "This resource manages Azure service fabric cluster deployment targets in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("deployment target", findDeploymentTargets),
 		ReadContext:   resourceAzureServiceFabricClusterDeploymentTargetRead,
 		Schema:        getAzureServiceFabricClusterDeploymentTargetSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getAzureServiceFabricClusterDeploymentTargetSchema(), "environments", "roles", "tenant_tags", "tenants"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		UpdateContext: resourceAzureServiceFabricClusterDeploymentTargetUpdate,
 	}
 }
@@ -27,7 +35,11 @@ func resourceAzureServiceFabricClusterDeploymentTargetCreate(ctx context.Context
 
 	log.Printf("[INFO] creating Azure service fabric cluster deployment target: %#v", deploymentTarget)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdDeploymentTarget, err := client.Machines.Add(deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
@@ -39,6 +51,10 @@ func resourceAzureServiceFabricClusterDeploymentTargetCreate(ctx context.Context
 
 	d.SetId(createdDeploymentTarget.GetID())
 
+	if diags := waitForCreatedDeploymentTarget(ctx, d, client, setAzureServiceFabricClusterDeploymentTarget); diags.HasError() {
+		return diags
+	}
+
 	log.Printf("[INFO] Azure service fabric cluster deployment target created (%s)", d.Id())
 	return nil
 }
@@ -46,7 +62,11 @@ func resourceAzureServiceFabricClusterDeploymentTargetCreate(ctx context.Context
 func resourceAzureServiceFabricClusterDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting Azure service fabric cluster deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Machines.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +80,11 @@ func resourceAzureServiceFabricClusterDeploymentTargetDelete(ctx context.Context
 func resourceAzureServiceFabricClusterDeploymentTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading Azure service fabric cluster deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentTarget, err := client.Machines.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "Azure service fabric cluster deployment target")
@@ -78,7 +102,11 @@ func resourceAzureServiceFabricClusterDeploymentTargetUpdate(ctx context.Context
 	log.Printf("[INFO] updating Azure service fabric cluster deployment target (%s)", d.Id())
 
 	deploymentTarget := expandAzureServiceFabricClusterDeploymentTarget(d)
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedDeploymentTarget, err := client.Machines.Update(deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/accounts"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,9 +15,13 @@ func resourceAzureServicePrincipalAccount() *schema.Resource {
 		CreateContext: resourceAzureServicePrincipalAccountCreate,
 		DeleteContext: resourceAzureServicePrincipalAccountDelete,
 		Description:   "This resource manages Azure service principal accounts in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("account", findAccounts),
 		ReadContext:   resourceAzureServicePrincipalAccountRead,
 		Schema:        getAzureServicePrincipalAccountSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getAzureServicePrincipalAccountSchema(), "environments", "tenant_tags", "tenants"),
+		},
 		UpdateContext: resourceAzureServicePrincipalAccountUpdate,
 	}
 }
@@ -28,7 +31,11 @@ func resourceAzureServicePrincipalAccountCreate(ctx context.Context, d *schema.R
 
 	log.Printf("[INFO] creating Azure service principal account: %#v", account)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdAccount, err := client.Accounts.Add(account)
 	if err != nil {
 		return diag.FromErr(err)
@@ -47,7 +54,11 @@ func resourceAzureServicePrincipalAccountCreate(ctx context.Context, d *schema.R
 func resourceAzureServicePrincipalAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting Azure service principal account (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Accounts.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -61,7 +72,11 @@ func resourceAzureServicePrincipalAccountDelete(ctx context.Context, d *schema.R
 func resourceAzureServicePrincipalAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading Azure service principal account (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	accountResource, err := client.Accounts.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "Azure service principal account")
@@ -81,7 +96,11 @@ func resourceAzureServicePrincipalAccountUpdate(ctx context.Context, d *schema.R
 
 	log.Printf("[INFO] updating Azure service principal account %#v", account)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedAccount, err := client.Accounts.Update(account)
 	if err != nil {
 		return diag.FromErr(err)
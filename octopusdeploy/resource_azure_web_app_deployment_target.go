@@ -3,8 +3,8 @@ package octopusdeploy
 import (
 	"context"
 	"log"
+	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,11 +13,19 @@ import (
 func resourceAzureWebAppDeploymentTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAzureWebAppDeploymentTargetCreate,
+		CustomizeDiff: validateDeploymentTargetTenants,
 		DeleteContext: resourceAzureWebAppDeploymentTargetDelete,
 		Description:   "This resource manages Azure web app deployment targets in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("deployment target", findDeploymentTargets),
 		ReadContext:   resourceAzureWebAppDeploymentTargetRead,
 		Schema:        getAzureWebAppDeploymentTargetSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getAzureWebAppDeploymentTargetSchema(), "environments", "roles", "tenant_tags", "tenants"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		UpdateContext: resourceAzureWebAppDeploymentTargetUpdate,
 	}
 }
@@ -27,7 +35,11 @@ func resourceAzureWebAppDeploymentTargetCreate(ctx context.Context, d *schema.Re
 
 	log.Printf("[INFO] creating Azure web app deployment target: %#v", deploymentTarget)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdDeploymentTarget, err := client.Machines.Add(deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
@@ -39,6 +51,10 @@ func resourceAzureWebAppDeploymentTargetCreate(ctx context.Context, d *schema.Re
 
 	d.SetId(createdDeploymentTarget.GetID())
 
+	if diags := waitForCreatedDeploymentTarget(ctx, d, client, setAzureWebAppDeploymentTarget); diags.HasError() {
+		return diags
+	}
+
 	log.Printf("[INFO] Azure web app deployment target created (%s)", d.Id())
 	return nil
 }
@@ -46,7 +62,11 @@ func resourceAzureWebAppDeploymentTargetCreate(ctx context.Context, d *schema.Re
 func resourceAzureWebAppDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting Azure web app deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Machines.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +80,11 @@ func resourceAzureWebAppDeploymentTargetDelete(ctx context.Context, d *schema.Re
 func resourceAzureWebAppDeploymentTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading Azure web app deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentTarget, err := client.Machines.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "Azure web app deployment target")
@@ -78,7 +102,11 @@ func resourceAzureWebAppDeploymentTargetUpdate(ctx context.Context, d *schema.Re
 	log.Printf("[INFO] updating Azure web app deployment target (%s)", d.Id())
 
 	deploymentTarget := expandAzureWebAppDeploymentTarget(d)
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedDeploymentTarget, err := client.Machines.Update(deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
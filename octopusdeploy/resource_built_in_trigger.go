@@ -0,0 +1,139 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	prj "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/projects"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceBuiltInTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBuiltInTriggerCreate,
+		DeleteContext: resourceBuiltInTriggerDelete,
+		Description:   "This resource manages the built-in package repository trigger of a project in Octopus Deploy, which creates a release when a new version of a package is pushed to the built-in feed. A project has at most one such trigger; it is stored as the release creation strategy of the project, so `auto_create_release` and `release_creation_strategy` must not also be set on the `octopusdeploy_project`.",
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBuiltInTriggerImport,
+		},
+		ReadContext:   resourceBuiltInTriggerRead,
+		Schema:        getBuiltInTriggerSchema(),
+		UpdateContext: resourceBuiltInTriggerUpdate,
+	}
+}
+
+func resourceBuiltInTriggerImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.Set("project_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateBuiltInTrigger(ctx context.Context, d *schema.ResourceData, client *client.Client) error {
+	project, err := client.Projects.GetByID(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := prj.LoadGitHubGitCredential(client, project); err != nil {
+		return err
+	}
+
+	project.AutoCreateRelease = true
+	project.ReleaseCreationStrategy = expandBuiltInTrigger(d)
+
+	tflog.Info(ctx, fmt.Sprintf("updating built-in trigger (%s)", project.GetID()))
+
+	updatedProject, err := client.Projects.Update(project)
+	if err != nil {
+		return err
+	}
+
+	return setBuiltInTrigger(d, updatedProject)
+}
+
+func resourceBuiltInTriggerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateBuiltInTrigger(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("project_id").(string))
+
+	tflog.Info(ctx, fmt.Sprintf("built-in trigger created (%s)", d.Id()))
+	return nil
+}
+
+func resourceBuiltInTriggerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("deleting built-in trigger (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := client.Projects.GetByID(d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "built-in trigger")
+	}
+
+	if err := prj.LoadGitHubGitCredential(client, project); err != nil {
+		return diag.FromErr(err)
+	}
+
+	project.AutoCreateRelease = false
+	project.ReleaseCreationStrategy = nil
+	if _, err := client.Projects.Update(project); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	tflog.Info(ctx, "built-in trigger deleted")
+	return nil
+}
+
+func resourceBuiltInTriggerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading built-in trigger (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := client.Projects.GetByID(d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "built-in trigger")
+	}
+
+	// the trigger no longer exists once automatic release creation has been turned off
+	if !project.AutoCreateRelease || project.ReleaseCreationStrategy == nil {
+		return errors.DeleteFromState(ctx, d, "built-in trigger")
+	}
+
+	if err := setBuiltInTrigger(d, project); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("built-in trigger read (%s)", d.Id()))
+	return nil
+}
+
+func resourceBuiltInTriggerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateBuiltInTrigger(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("built-in trigger updated (%s)", d.Id()))
+	return nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,9 +14,13 @@ func resourceCertificate() *schema.Resource {
 		CreateContext: resourceCertificateCreate,
 		DeleteContext: resourceCertificateDelete,
 		Description:   "This resource manages certificates in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("certificate", findCertificates),
 		ReadContext:   resourceCertificateRead,
 		Schema:        getCertificateSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getCertificateSchema(), "environments", "tenant_tags", "tenants"),
+		},
 		UpdateContext: resourceCertificateUpdate,
 	}
 }
@@ -27,7 +30,11 @@ func resourceCertificateCreate(ctx context.Context, d *schema.ResourceData, m in
 
 	log.Printf("[INFO] creating certificate: %#v", certificate)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdCertificate, err := client.Certificates.Add(certificate)
 	if err != nil {
 		return diag.FromErr(err)
@@ -46,7 +53,11 @@ func resourceCertificateCreate(ctx context.Context, d *schema.ResourceData, m in
 func resourceCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting certificate (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Certificates.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +71,11 @@ func resourceCertificateDelete(ctx context.Context, d *schema.ResourceData, m in
 func resourceCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading certificate (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	certificate, err := client.Certificates.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "certificate")
@@ -78,7 +93,11 @@ func resourceCertificateUpdate(ctx context.Context, d *schema.ResourceData, m in
 	log.Printf("[INFO] updating certificate (%s)", d.Id())
 
 	certificate := expandCertificate(d)
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedCertificate, err := client.Certificates.Update(*certificate)
 	if err != nil {
 		return diag.FromErr(err)
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,11 +13,16 @@ import (
 func resourceChannel() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceChannelCreate,
+		CustomizeDiff: validateReferences,
 		DeleteContext: resourceChannelDelete,
 		Description:   "This resource manages channels in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceChannelRead,
 		Schema:        getChannelSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getChannelSchema(), "tenant_tags"),
+		},
 		UpdateContext: resourceChannelUpdate,
 	}
 }
@@ -31,7 +35,11 @@ func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	tflog.Info(ctx, fmt.Sprintf("creating channel: %#v", channel))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdChannel, err := client.Channels.Add(channel)
 	if err != nil {
 		return diag.FromErr(err)
@@ -53,7 +61,11 @@ func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, m interf
 
 	tflog.Info(ctx, fmt.Sprintf("deleting channel (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Channels.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -67,7 +79,11 @@ func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, m interf
 func resourceChannelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("reading channel (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	channel, err := client.Channels.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "channel")
@@ -88,7 +104,11 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	tflog.Info(ctx, fmt.Sprintf("updating channel (%s)", d.Id()))
 
 	channel := expandChannel(d)
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedChannel, err := client.Channels.Update(channel)
 	if err != nil {
 		return diag.FromErr(err)
@@ -311,7 +311,7 @@ func testAccChannelWithTwoRules(name, description, versionRange1, actionName1, v
 
 func testAccChannelExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		if err := existsHelperChannel(s, client); err != nil {
 			return err
 		}
@@ -331,7 +331,7 @@ func existsHelperChannel(s *terraform.State, client *client.Client) error {
 }
 
 func testAccChannelCheckDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*client.Client)
+	client := getClient(testAccProvider.Meta())
 
 	if err := destroyHelperChannel(s, client); err != nil {
 		return err
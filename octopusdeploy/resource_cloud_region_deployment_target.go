@@ -3,8 +3,8 @@ package octopusdeploy
 import (
 	"context"
 	"log"
+	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,11 +13,19 @@ import (
 func resourceCloudRegionDeploymentTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceCloudRegionDeploymentTargetCreate,
+		CustomizeDiff: validateDeploymentTargetTenants,
 		DeleteContext: resourceCloudRegionDeploymentTargetDelete,
 		Description:   "This resource manages cloud region deployment targets in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("deployment target", findDeploymentTargets),
 		ReadContext:   resourceCloudRegionDeploymentTargetRead,
 		Schema:        getCloudRegionDeploymentTargetSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getCloudRegionDeploymentTargetSchema(), "environments", "roles", "tenant_tags", "tenants"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		UpdateContext: resourceCloudRegionDeploymentTargetUpdate,
 	}
 }
@@ -27,7 +35,11 @@ func resourceCloudRegionDeploymentTargetCreate(ctx context.Context, d *schema.Re
 
 	log.Printf("[INFO] creating cloud region deployment target: %#v", deploymentTarget)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdDeploymentTarget, err := client.Machines.Add(deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
@@ -39,6 +51,10 @@ func resourceCloudRegionDeploymentTargetCreate(ctx context.Context, d *schema.Re
 
 	d.SetId(createdDeploymentTarget.GetID())
 
+	if diags := waitForCreatedDeploymentTarget(ctx, d, client, setCloudRegionDeploymentTarget); diags.HasError() {
+		return diags
+	}
+
 	log.Printf("[INFO] cloud region deployment target created (%s)", d.Id())
 	return nil
 }
@@ -46,7 +62,11 @@ func resourceCloudRegionDeploymentTargetCreate(ctx context.Context, d *schema.Re
 func resourceCloudRegionDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting cloud region deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Machines.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +80,11 @@ func resourceCloudRegionDeploymentTargetDelete(ctx context.Context, d *schema.Re
 func resourceCloudRegionDeploymentTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading cloud region deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentTarget, err := client.Machines.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "cloud region deployment target")
@@ -78,7 +102,11 @@ func resourceCloudRegionDeploymentTargetUpdate(ctx context.Context, d *schema.Re
 	log.Printf("[INFO] updating cloud region deployment target (%s)", d.Id())
 
 	deploymentTarget := expandCloudRegionDeploymentTarget(d)
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedDeploymentTarget, err := client.Machines.Update(deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,147 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDeploymentCreate,
+		DeleteContext: resourceDeploymentDelete,
+		Description:   "This resource deploys a release to an environment in Octopus Deploy and waits for the deployment to finish. The deployment fails if its task does not succeed within the create timeout. Deployments cannot be removed from Octopus, so destroying this resource only removes it from the Terraform state.",
+		ReadContext:   resourceDeploymentRead,
+		Schema:        getDeploymentSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	deployment := expandDeployment(d)
+
+	tflog.Info(ctx, fmt.Sprintf("creating deployment (%s)", deployment.ReleaseID))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createdDeployment, err := client.Deployments.Add(deployment)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the ID is set before waiting so that a failed deployment is kept in state and replaced on the next apply
+	d.SetId(createdDeployment.GetID())
+	if err := setDeployment(d, createdDeployment); err != nil {
+		return diag.FromErr(err)
+	}
+
+	task, err := waitForTask(ctx, client, createdDeployment.TaskID, d.Timeout(schema.TimeoutCreate))
+	if task != nil {
+		setDeploymentTask(d, task)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("deployment created (%s)", d.Id()))
+	return nil
+}
+
+func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("deleting deployment (%s)", d.Id()))
+
+	d.SetId("")
+	tflog.Info(ctx, "deployment deleted")
+	return nil
+}
+
+func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading deployment (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deployment, err := client.Deployments.GetByID(d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "deployment")
+	}
+
+	if err := setDeployment(d, deployment); err != nil {
+		return diag.FromErr(err)
+	}
+
+	task, err := getTask(client, deployment.TaskID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	setDeploymentTask(d, task)
+
+	tflog.Info(ctx, fmt.Sprintf("deployment read (%s)", d.Id()))
+	return nil
+}
+
+func getTask(client *client.Client, id string) (*tasks.Task, error) {
+	// the task service cannot get a task by its ID, so it is queried by ID instead
+	query := tasks.TasksQuery{IDs: []string{id}, Take: 1}
+	taskResources, err := client.Tasks.Get(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(taskResources.Items) == 0 {
+		return nil, fmt.Errorf("task (%s) not found", id)
+	}
+
+	return taskResources.Items[0], nil
+}
+
+// waitForTask polls a task until it has completed or the timeout has elapsed. An error is returned if the task did not
+// succeed.
+func waitForTask(ctx context.Context, client *client.Client, id string, timeout time.Duration) (*tasks.Task, error) {
+	tflog.Info(ctx, fmt.Sprintf("waiting for task (%s)", id))
+
+	stateChangeConf := &resource.StateChangeConf{
+		Pending: []string{"Cancelling", "Executing", "Queued"},
+		Refresh: func() (interface{}, string, error) {
+			task, err := getTask(client, id)
+			if err != nil {
+				return nil, "", err
+			}
+			return task, task.State, nil
+		},
+		Target:     []string{"Success"},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	result, err := stateChangeConf.WaitForStateContext(ctx)
+	if result == nil {
+		return nil, err
+	}
+
+	task := result.(*tasks.Task)
+	if err != nil {
+		if task.ErrorMessage != "" {
+			return task, fmt.Errorf("task (%s) finished in state %s: %s", id, task.State, task.ErrorMessage)
+		}
+		return task, err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("task completed (%s)", id))
+	return task, nil
+}
@@ -2,45 +2,83 @@ package octopusdeploy
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"regexp"
 	"strings"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceDeploymentProcess() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDeploymentProcessCreate,
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("branch", isChangeOfExistingValue),
+			customdiff.ForceNewIfChange("project_id", isChangeOfExistingValue),
+			validateActionProperties,
+			validateReferences,
+		),
 		DeleteContext: resourceDeploymentProcessDelete,
 		Description:   "This resource manages deployment processes in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDeploymentProcessImport,
+		},
 		ReadContext:   resourceDeploymentProcessRead,
 		Schema:        getDeploymentProcessSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getDeploymentProcessSchema(), "step.*.channels", "step.*.environments", "step.*.excluded_environments", "step.*.tenant_tags"),
+		},
 		UpdateContext: resourceDeploymentProcessUpdate,
 	}
 }
 
+// The values of delete_behavior, which determines what destroying a deployment process does to its steps.
+const (
+	deploymentProcessDeleteBehaviorClearSteps     = "clear_steps"
+	deploymentProcessDeleteBehaviorFail           = "fail"
+	deploymentProcessDeleteBehaviorLeaveUnmanaged = "leave_unmanaged"
+)
+
 func getDeploymentProcessSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"id": getIDSchema(),
 		"branch": {
 			Computed:    true,
-			Description: "The branch name associated with this deployment process (i.e. `main`). This value is optional and only applies to associated projects that are stored in version control.",
+			Description: "The branch name associated with this deployment process (i.e. `main`). This value is optional and only applies to associated projects that are stored in version control. Changing the branch once it is set forces a new deployment process to be created.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"delete_behavior": {
+			Default:     deploymentProcessDeleteBehaviorClearSteps,
+			Description: "What happens to the deployment process in Octopus Deploy when this resource is destroyed. `clear_steps` removes all of its steps, `leave_unmanaged` leaves its steps unchanged and removes the resource from state, and `fail` prevents the resource from being destroyed. A change to this value must be applied before it affects a destroy. Defaults to `clear_steps`.",
 			Optional:    true,
 			Type:        schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				deploymentProcessDeleteBehaviorClearSteps,
+				deploymentProcessDeleteBehaviorFail,
+				deploymentProcessDeleteBehaviorLeaveUnmanaged,
+			}, false)),
+		},
+		"ignore_remote_changes": {
+			Default:     false,
+			Description: "Whether changes made to the steps of this deployment process outside Terraform, such as in the Octopus Deploy portal, are ignored when refreshing. When `true`, the steps are not read back from Octopus Deploy, so they do not produce a diff; a change to the steps in the configuration still replaces all of the steps in Octopus Deploy, including those changed outside Terraform. Defaults to `false`.",
+			Optional:    true,
+			Type:        schema.TypeBool,
 		},
 		"last_snapshot_id": {
 			Optional: true,
 			Type:     schema.TypeString,
 		},
 		"project_id": {
-			Description: "The project ID associated with this deployment process.",
+			Description: "The project ID associated with this deployment process. Changing the project forces a new deployment process to be created, and the deployment process of the previous project is destroyed according to `delete_behavior`.",
 			Required:    true,
 			Type:        schema.TypeString,
 		},
@@ -55,35 +93,47 @@ func getDeploymentProcessSchema() map[string]*schema.Schema {
 	}
 }
 
+// isChangeOfExistingValue reports whether an attribute that was already set is changing. A branch that was not set
+// may be set in place, as the project of the deployment process has been converted to version control.
+func isChangeOfExistingValue(ctx context.Context, old, new, meta interface{}) bool {
+	return len(old.(string)) > 0
+}
+
 func resourceDeploymentProcessCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
-	deploymentProcess := expandDeploymentProcess(ctx, d, client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentProcess := expandDeploymentProcess(ctx, d, m, client)
 
 	log.Printf("[INFO] creating deployment process: %#v", deploymentProcess)
 
-	project, err := client.Projects.GetByID(deploymentProcess.ProjectID)
+	project, err := getProject(m, client, deploymentProcess.ProjectID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	var current *deployments.DeploymentProcess
-	if project.PersistenceSettings != nil && project.PersistenceSettings.Type() == projects.PersistenceSettingsTypeVersionControlled {
-		current, err = client.DeploymentProcesses.Get(project, deploymentProcess.Branch)
-		if err != nil {
-			return diag.FromErr(err)
+	var createdDeploymentProcess *deployments.DeploymentProcess
+	err = updateProcess(ctx, getProcessUpdateAttempts(m), func() error {
+		var current *deployments.DeploymentProcess
+		var err error
+		if project.PersistenceSettings != nil && project.PersistenceSettings.Type() == projects.PersistenceSettingsTypeVersionControlled {
+			current, err = client.DeploymentProcesses.Get(project, deploymentProcess.Branch)
+		} else {
+			current, err = client.DeploymentProcesses.GetByID(project.DeploymentProcessID)
 		}
-	} else {
-		current, err = client.DeploymentProcesses.GetByID(project.DeploymentProcessID)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
-	}
 
-	deploymentProcess.ID = current.ID
-	deploymentProcess.Links = current.Links
-	deploymentProcess.Version = current.Version
+		deploymentProcess.ID = current.ID
+		deploymentProcess.Links = current.Links
+		deploymentProcess.Version = current.Version
 
-	createdDeploymentProcess, err := client.DeploymentProcesses.Update(deploymentProcess)
+		createdDeploymentProcess, err = client.DeploymentProcesses.Update(deploymentProcess)
+		return getProcessUpdateError(client, current.Links["Self"], current.Version, err)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -94,7 +144,7 @@ func resourceDeploymentProcessCreate(ctx context.Context, d *schema.ResourceData
 
 	id := createdDeploymentProcess.GetID()
 	if project.PersistenceSettings != nil && project.PersistenceSettings.Type() == projects.PersistenceSettingsTypeVersionControlled {
-		id = "deploymentprocess-" + createdDeploymentProcess.ProjectID + "-" + deploymentProcess.Branch
+		id = getDeploymentProcessID(createdDeploymentProcess.ProjectID, deploymentProcess.Branch)
 	}
 
 	d.SetId(id)
@@ -106,46 +156,46 @@ func resourceDeploymentProcessCreate(ctx context.Context, d *schema.ResourceData
 func resourceDeploymentProcessDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting deployment process (%s)", d.Id())
 
-	client := m.(*client.Client)
-	current, err := client.DeploymentProcesses.GetByID(d.Id())
-	if err == nil {
-		deploymentProcess := &deployments.DeploymentProcess{
-			Version: current.Version,
-		}
-		deploymentProcess.Links = current.Links
-		deploymentProcess.ID = d.Id()
-
-		_, err = client.DeploymentProcesses.Update(deploymentProcess)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
+	switch d.Get("delete_behavior").(string) {
+	case deploymentProcessDeleteBehaviorFail:
+		return diag.Errorf("the deployment process (%s) has a delete_behavior of %s, and so cannot be destroyed; set delete_behavior to %s or %s and apply the change before destroying it", d.Id(), deploymentProcessDeleteBehaviorFail, deploymentProcessDeleteBehaviorClearSteps, deploymentProcessDeleteBehaviorLeaveUnmanaged)
+	case deploymentProcessDeleteBehaviorLeaveUnmanaged:
 		d.SetId("")
-		log.Printf("[INFO] deployment process deleted")
+		log.Printf("[INFO] deployment process removed from state; its steps were left unchanged")
 		return nil
 	}
 
-	r, _ := regexp.Compile(`Projects-\d+`)
-	projectID := r.FindString(d.Id())
-
-	project, err := client.Projects.GetByID(projectID)
+	client, err := getSpaceClient(m, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	gitRef := getGitRef(d)
-	current, err = client.DeploymentProcesses.Get(project, gitRef)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	err = updateProcess(ctx, getProcessUpdateAttempts(m), func() error {
+		current, err := client.DeploymentProcesses.GetByID(d.Id())
+		if err != nil {
+			projectID, _ := parseDeploymentProcessID(d.Id())
+
+			project, err := getProject(m, client, projectID)
+			if err != nil {
+				return err
+			}
+
+			gitRef := getGitRef(d)
+			current, err = client.DeploymentProcesses.Get(project, gitRef)
+			if err != nil {
+				return err
+			}
+		}
 
-	deploymentProcess := &deployments.DeploymentProcess{
-		Version: current.Version,
-	}
-	deploymentProcess.Links = current.Links
-	deploymentProcess.ID = d.Id()
+		deploymentProcess := &deployments.DeploymentProcess{
+			Version: current.Version,
+		}
+		deploymentProcess.Links = current.Links
+		deploymentProcess.ID = d.Id()
 
-	_, err = client.DeploymentProcesses.Update(deploymentProcess)
+		_, err = client.DeploymentProcesses.Update(deploymentProcess)
+		return getProcessUpdateError(client, current.Links["Self"], current.Version, err)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -158,10 +208,14 @@ func resourceDeploymentProcessDelete(ctx context.Context, d *schema.ResourceData
 func resourceDeploymentProcessRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading deployment process (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentProcess, err := client.DeploymentProcesses.GetByID(d.Id())
 	if err == nil {
-		if err := setDeploymentProcess(ctx, d, deploymentProcess); err != nil {
+		if err := refreshDeploymentProcess(ctx, d, deploymentProcess); err != nil {
 			return diag.FromErr(err)
 		}
 
@@ -169,10 +223,9 @@ func resourceDeploymentProcessRead(ctx context.Context, d *schema.ResourceData,
 		return nil
 	}
 
-	r, _ := regexp.Compile(`Projects-\d+`)
-	projectID := r.FindString(d.Id())
+	projectID, _ := parseDeploymentProcessID(d.Id())
 
-	project, err := client.Projects.GetByID(projectID)
+	project, err := getProject(m, client, projectID)
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "project")
 	}
@@ -180,7 +233,7 @@ func resourceDeploymentProcessRead(ctx context.Context, d *schema.ResourceData,
 	gitRef := getGitRef(d)
 	deploymentProcess, err = client.DeploymentProcesses.Get(project, gitRef)
 	if err == nil {
-		if err := setDeploymentProcess(ctx, d, deploymentProcess); err != nil {
+		if err := refreshDeploymentProcess(ctx, d, deploymentProcess); err != nil {
 			return diag.FromErr(err)
 		}
 
@@ -188,45 +241,72 @@ func resourceDeploymentProcessRead(ctx context.Context, d *schema.ResourceData,
 		return nil
 	}
 
-	return errors.DeleteFromState(ctx, d, "deployment process")
+	return errors.ProcessApiError(ctx, d, err, "deployment process")
 }
 
-func resourceDeploymentProcessUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[INFO] updating deployment process (%s)", d.Id())
+// refreshDeploymentProcess sets the state of a deployment process that was read from Octopus Deploy. The steps in
+// state are kept when ignore_remote_changes is set, so that steps changed outside Terraform do not produce a diff.
+func refreshDeploymentProcess(ctx context.Context, d *schema.ResourceData, deploymentProcess *deployments.DeploymentProcess) error {
+	if !d.Get("ignore_remote_changes").(bool) {
+		return setDeploymentProcess(ctx, d, deploymentProcess)
+	}
 
-	client := m.(*client.Client)
-	deploymentProcess := expandDeploymentProcess(ctx, d, client)
-	current, err := client.DeploymentProcesses.GetByID(d.Id())
-	if err != nil {
-		r, _ := regexp.Compile(`Projects-\d+`)
-		projectID := r.FindString(d.Id())
+	steps := d.Get("step")
+	if err := setDeploymentProcess(ctx, d, deploymentProcess); err != nil {
+		return err
+	}
 
-		project, err := client.Projects.GetByID(projectID)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	if err := d.Set("step", steps); err != nil {
+		return fmt.Errorf("error setting step: %s", err)
+	}
 
-		gitRef := getGitRef(d)
-		if deploymentProcess.Branch != gitRef && gitRef != "" { //if gitRef is empty, its likely this is a conversion of an existing deployment process
-			return diag.Errorf("you cannot change a deployment processes branch. instead create a new resource with the new branch and, if required, destroy the previous one")
-		}
+	log.Printf("[INFO] changes to the steps of deployment process (%s) made outside Terraform were ignored", d.Id())
+	return nil
+}
 
-		if project.PersistenceSettings != nil && project.PersistenceSettings.Type() == projects.PersistenceSettingsTypeVersionControlled {
-			deploymentProcess.ID = "deploymentprocess-" + projectID + "-" + deploymentProcess.Branch
-			d.SetId(deploymentProcess.ID)
-		}
+func resourceDeploymentProcessUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating deployment process (%s)", d.Id())
 
-		current, err = client.DeploymentProcesses.Get(project, deploymentProcess.Branch)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
+	deploymentProcess := expandDeploymentProcess(ctx, d, m, client)
+
+	var updatedDeploymentProcess *deployments.DeploymentProcess
+	err = updateProcess(ctx, getProcessUpdateAttempts(m), func() error {
+		current, err := client.DeploymentProcesses.GetByID(d.Id())
 		if err != nil {
-			return diag.FromErr(err)
+			projectID, _ := parseDeploymentProcessID(d.Id())
+
+			project, err := getProject(m, client, projectID)
+			if err != nil {
+				return err
+			}
+
+			gitRef := getGitRef(d)
+			if deploymentProcess.Branch != gitRef && gitRef != "" { //if gitRef is empty, its likely this is a conversion of an existing deployment process
+				return fmt.Errorf("you cannot change a deployment processes branch. instead create a new resource with the new branch and, if required, destroy the previous one")
+			}
+
+			if project.PersistenceSettings != nil && project.PersistenceSettings.Type() == projects.PersistenceSettingsTypeVersionControlled {
+				deploymentProcess.ID = getDeploymentProcessID(projectID, deploymentProcess.Branch)
+				d.SetId(deploymentProcess.ID)
+			}
+
+			current, err = client.DeploymentProcesses.Get(project, deploymentProcess.Branch)
+			if err != nil {
+				return err
+			}
 		}
-	}
 
-	deploymentProcess.Links = current.Links
-	deploymentProcess.Version = current.Version
+		deploymentProcess.Links = current.Links
+		deploymentProcess.Version = current.Version
 
-	updatedDeploymentProcess, err := client.DeploymentProcesses.Update(deploymentProcess)
+		updatedDeploymentProcess, err = client.DeploymentProcesses.Update(deploymentProcess)
+		return getProcessUpdateError(client, current.Links["Self"], current.Version, err)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -239,11 +319,57 @@ func resourceDeploymentProcessUpdate(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
-func getGitRef(d *schema.ResourceData) string {
-	r, _ := regexp.Compile(`\d+-\w+`)
-	parts := strings.SplitAfter(r.FindString(d.Id()), "-")
-	if len(parts) > 2 {
-		return parts[1]
+func resourceDeploymentProcessImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[INFO] importing deployment process (%s)", d.Id())
+
+	id := d.Id()
+	if !strings.HasPrefix(id, deploymentProcessIDPrefix) {
+		projectID, gitRef, _ := strings.Cut(id, ":")
+		if !projectIDPattern.MatchString(projectID) {
+			return nil, fmt.Errorf("octopusdeploy_deployment_process import must be in the form of ProjectID, or ProjectID:Branch for a project stored in version control (e.g. Projects-123 or Projects-123:main)")
+		}
+
+		id = getDeploymentProcessID(projectID, gitRef)
+		d.Set("branch", gitRef)
+		d.Set("project_id", projectID)
 	}
-	return ""
+
+	d.Set("delete_behavior", deploymentProcessDeleteBehaviorClearSteps)
+	d.Set("ignore_remote_changes", false)
+	d.SetId(id)
+	return []*schema.ResourceData{d}, nil
+}
+
+const deploymentProcessIDPrefix = "deploymentprocess-"
+
+var projectIDPattern = regexp.MustCompile(`^Projects-\d+$`)
+
+// getDeploymentProcessID returns the ID of the deployment process of a project. Deployment processes stored in
+// version control have no ID of their own, so the branch is appended to the ID of the process (e.g.
+// deploymentprocess-Projects-123-main).
+func getDeploymentProcessID(projectID string, gitRef string) string {
+	if len(gitRef) == 0 {
+		return deploymentProcessIDPrefix + projectID
+	}
+	return deploymentProcessIDPrefix + projectID + "-" + gitRef
+}
+
+// parseDeploymentProcessID returns the ID of the project and the Git ref, if any, of a deployment process ID that
+// was returned by getDeploymentProcessID. The Git ref may itself contain dashes and slashes (e.g. release/2023-10).
+func parseDeploymentProcessID(id string) (string, string) {
+	ids := strings.SplitN(strings.TrimPrefix(id, deploymentProcessIDPrefix), "-", 3)
+	if len(ids) < 2 {
+		return "", ""
+	}
+
+	projectID := ids[0] + "-" + ids[1]
+	if len(ids) == 3 {
+		return projectID, ids[2]
+	}
+	return projectID, ""
+}
+
+func getGitRef(d *schema.ResourceData) string {
+	_, gitRef := parseDeploymentProcessID(d.Id())
+	return gitRef
 }
@@ -1,6 +1,7 @@
 package octopusdeploy
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -9,7 +10,9 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
 )
 
 // func TestAccDeploymentProcess(t *testing.T) {
@@ -353,7 +356,7 @@ func testAccBuildTestAction(action string) string {
 
 func testAccCheckOctopusDeployDeploymentProcess() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 
 		process, err := getDeploymentProcess(s, client)
 		if err != nil {
@@ -390,7 +393,7 @@ func testAccDeploymentProcessExists(prefix string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", prefix)
 		}
 
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		if _, err := client.DeploymentProcesses.GetByID(rs.Primary.ID); err != nil {
 			return err
 		}
@@ -400,7 +403,7 @@ func testAccDeploymentProcessExists(prefix string) resource.TestCheckFunc {
 }
 
 func testAccDeploymentProcessCheckDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*client.Client)
+	client := getClient(testAccProvider.Meta())
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "octopusdeploy_deployment_process" {
 			continue
@@ -413,3 +416,143 @@ func testAccDeploymentProcessCheckDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestParseDeploymentProcessID(t *testing.T) {
+	for id, expected := range map[string][2]string{
+		"deploymentprocess-Projects-123":                    {"Projects-123", ""},
+		"deploymentprocess-Projects-123-main":               {"Projects-123", "main"},
+		"deploymentprocess-Projects-123-release/2023-10":    {"Projects-123", "release/2023-10"},
+		"deploymentprocess-Projects-123-refs/heads/feature": {"Projects-123", "refs/heads/feature"},
+	} {
+		projectID, gitRef := parseDeploymentProcessID(id)
+		require.Equal(t, expected[0], projectID, id)
+		require.Equal(t, expected[1], gitRef, id)
+		require.Equal(t, id, getDeploymentProcessID(projectID, gitRef))
+	}
+}
+
+func TestResourceDeploymentProcessImport(t *testing.T) {
+	importDeploymentProcess := func(id string) (*schema.ResourceData, error) {
+		d := resourceDeploymentProcess().TestResourceData()
+		d.SetId(id)
+		_, err := resourceDeploymentProcessImport(context.Background(), d, nil)
+		return d, err
+	}
+
+	d, err := importDeploymentProcess("Projects-123:release/2023-10")
+	require.NoError(t, err)
+	require.Equal(t, "deploymentprocess-Projects-123-release/2023-10", d.Id())
+	require.Equal(t, "Projects-123", d.Get("project_id"))
+	require.Equal(t, "release/2023-10", d.Get("branch"))
+	require.Equal(t, deploymentProcessDeleteBehaviorClearSteps, d.Get("delete_behavior"))
+
+	d, err = importDeploymentProcess("Projects-123")
+	require.NoError(t, err)
+	require.Equal(t, "deploymentprocess-Projects-123", d.Id())
+
+	d, err = importDeploymentProcess("deploymentprocess-Projects-123")
+	require.NoError(t, err)
+	require.Equal(t, "deploymentprocess-Projects-123", d.Id())
+
+	_, err = importDeploymentProcess("My Project:main")
+	require.Error(t, err)
+}
+
+func TestResourceDeploymentProcessDeleteBehavior(t *testing.T) {
+	deleteDeploymentProcess := func(deleteBehavior string) (*schema.ResourceData, error) {
+		d := schema.TestResourceDataRaw(t, getDeploymentProcessSchema(), map[string]interface{}{
+			"delete_behavior": deleteBehavior,
+			"project_id":      "Projects-123",
+		})
+		d.SetId("deploymentprocess-Projects-123")
+		diags := resourceDeploymentProcessDelete(context.Background(), d, nil)
+		if diags.HasError() {
+			return d, fmt.Errorf(diags[0].Summary)
+		}
+		return d, nil
+	}
+
+	d, err := deleteDeploymentProcess(deploymentProcessDeleteBehaviorLeaveUnmanaged)
+	require.NoError(t, err)
+	require.Empty(t, d.Id())
+
+	d, err = deleteDeploymentProcess(deploymentProcessDeleteBehaviorFail)
+	require.ErrorContains(t, err, "cannot be destroyed")
+	require.Equal(t, "deploymentprocess-Projects-123", d.Id())
+
+	d = schema.TestResourceDataRaw(t, getDeploymentProcessSchema(), map[string]interface{}{
+		"project_id": "Projects-123",
+	})
+	require.Equal(t, deploymentProcessDeleteBehaviorClearSteps, d.Get("delete_behavior"))
+}
+
+func TestResourceDeploymentProcessForceNew(t *testing.T) {
+	diffDeploymentProcess := func(attributes map[string]string, config map[string]interface{}) *terraform.InstanceDiff {
+		state := &terraform.InstanceState{
+			Attributes: attributes,
+			ID:         "deploymentprocess-Projects-123",
+		}
+		diff, err := resourceDeploymentProcess().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+		require.NoError(t, err)
+		return diff
+	}
+
+	diff := diffDeploymentProcess(map[string]string{
+		"delete_behavior": deploymentProcessDeleteBehaviorClearSteps,
+		"project_id":      "Projects-123",
+	}, map[string]interface{}{
+		"project_id": "Projects-456",
+	})
+	require.True(t, diff.RequiresNew())
+	require.True(t, diff.Attributes["project_id"].RequiresNew)
+
+	diff = diffDeploymentProcess(map[string]string{
+		"branch":          "main",
+		"delete_behavior": deploymentProcessDeleteBehaviorClearSteps,
+		"project_id":      "Projects-123",
+	}, map[string]interface{}{
+		"branch":     "develop",
+		"project_id": "Projects-123",
+	})
+	require.True(t, diff.RequiresNew())
+	require.True(t, diff.Attributes["branch"].RequiresNew)
+
+	// a project that is converted to version control sets the branch in place
+	diff = diffDeploymentProcess(map[string]string{
+		"branch":          "",
+		"delete_behavior": deploymentProcessDeleteBehaviorClearSteps,
+		"project_id":      "Projects-123",
+	}, map[string]interface{}{
+		"branch":     "main",
+		"project_id": "Projects-123",
+	})
+	require.False(t, diff.RequiresNew())
+}
+
+func TestRefreshDeploymentProcessIgnoreRemoteChanges(t *testing.T) {
+	remoteDeploymentProcess := deployments.NewDeploymentProcess("Projects-123")
+	remoteDeploymentProcess.Steps = []*deployments.DeploymentStep{deployments.NewDeploymentStep("Hotfix")}
+	remoteDeploymentProcess.Version = 7
+
+	refresh := func(ignoreRemoteChanges bool) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, getDeploymentProcessSchema(), map[string]interface{}{
+			"ignore_remote_changes": ignoreRemoteChanges,
+			"project_id":            "Projects-123",
+			"step": []interface{}{
+				map[string]interface{}{"name": "Deploy"},
+			},
+		})
+		d.SetId("deploymentprocess-Projects-123")
+		require.NoError(t, refreshDeploymentProcess(context.Background(), d, remoteDeploymentProcess))
+		return d
+	}
+
+	d := refresh(false)
+	require.Equal(t, "Hotfix", d.Get("step.0.name"))
+	require.Equal(t, 7, d.Get("version"))
+
+	d = refresh(true)
+	require.Equal(t, "Deploy", d.Get("step.0.name"))
+	require.Equal(t, 1, d.Get("step.#"))
+	require.Equal(t, 7, d.Get("version"))
+}
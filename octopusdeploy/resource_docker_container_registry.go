@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -17,7 +16,7 @@ func resourceDockerContainerRegistry() *schema.Resource {
 		CreateContext: resourceDockerContainerRegistryCreate,
 		DeleteContext: resourceDockerContainerRegistryDelete,
 		Description:   "This resource manages a Docker Container Registry in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("feed", findFeeds),
 		ReadContext:   resourceDockerContainerRegistryRead,
 		Schema:        getDockerContainerRegistrySchema(),
 		UpdateContext: resourceDockerContainerRegistryUpdate,
@@ -32,7 +31,11 @@ func resourceDockerContainerRegistryCreate(ctx context.Context, d *schema.Resour
 
 	tflog.Info(ctx, fmt.Sprintf("creating Docker container registry, %s", dockerContainerRegistry.GetName()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdDockerContainerRegistry, err := client.Feeds.Add(dockerContainerRegistry)
 	if err != nil {
 		return diag.FromErr(err)
@@ -51,8 +54,12 @@ func resourceDockerContainerRegistryCreate(ctx context.Context, d *schema.Resour
 func resourceDockerContainerRegistryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("deleting Docker container registry (%s)", d.Id()))
 
-	client := m.(*client.Client)
-	err := client.Feeds.DeleteByID(d.Id())
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.Feeds.DeleteByID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -66,7 +73,11 @@ func resourceDockerContainerRegistryDelete(ctx context.Context, d *schema.Resour
 func resourceDockerContainerRegistryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("reading Docker container registry (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	feed, err := client.Feeds.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "Docker container registry")
@@ -89,7 +100,11 @@ func resourceDockerContainerRegistryUpdate(ctx context.Context, d *schema.Resour
 
 	tflog.Info(ctx, fmt.Sprintf("updating Docker container registry (%s)", feed.GetID()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedFeed, err := client.Feeds.Update(feed)
 	if err != nil {
 		return diag.FromErr(err)
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -55,7 +54,7 @@ func testDockerContainerRegistryBasic(localName string, apiVersion string, feedU
 
 func testDockerContainerRegistryExists(prefix string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		feedID := s.RootModule().Resources[prefix].Primary.ID
 		if _, err := client.Feeds.GetByID(feedID); err != nil {
 			return err
@@ -71,7 +70,7 @@ func testDockerContainerRegistryCheckDestroy(s *terraform.State) error {
 			continue
 		}
 
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		feed, err := client.Feeds.GetByID(rs.Primary.ID)
 		if err == nil && feed != nil {
 			return fmt.Errorf("Docker Container Registry (%s) still exists", rs.Primary.ID)
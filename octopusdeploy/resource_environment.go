@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +14,7 @@ func resourceEnvironment() *schema.Resource {
 		CreateContext: resourceEnvironmentCreate,
 		DeleteContext: resourceEnvironmentDelete,
 		Description:   "This resource manages environments in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("environment", findEnvironments),
 		ReadContext:   resourceEnvironmentRead,
 		Schema:        getEnvironmentSchema(),
 		UpdateContext: resourceEnvironmentUpdate,
@@ -27,7 +26,11 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, m in
 
 	log.Printf("[INFO] creating environment: %#v", environment)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdEnvironment, err := client.Environments.Add(environment)
 	if err != nil {
 		return diag.FromErr(err)
@@ -46,7 +49,11 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, m in
 func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting environment (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Environments.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -59,7 +66,11 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, m in
 func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading environment (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	environment, err := client.Environments.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "environment")
@@ -77,7 +88,11 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, m in
 	log.Printf("[INFO] updating environment (%s)", d.Id())
 
 	environment := expandEnvironment(d)
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedEnvironment, err := client.Environments.Update(environment)
 	if err != nil {
 		return diag.FromErr(err)
@@ -5,7 +5,6 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -78,7 +77,7 @@ func testAccEnvironment(localName string, name string, description string, allow
 
 func testAccEnvironmentExists(prefix string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		environmentID := s.RootModule().Resources[prefix].Primary.ID
 		if _, err := client.Environments.GetByID(environmentID); err != nil {
 			return err
@@ -89,7 +88,7 @@ func testAccEnvironmentExists(prefix string) resource.TestCheckFunc {
 }
 
 func testAccEnvironmentCheckDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*client.Client)
+	client := getClient(testAccProvider.Meta())
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "octopusdeploy_environment" {
 			continue
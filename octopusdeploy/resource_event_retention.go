@@ -0,0 +1,80 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/configuration"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceEventRetention() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEventRetentionCreate,
+		DeleteContext: resourceEventRetentionDelete,
+		Description:   "This resource manages how long Octopus Deploy keeps audit events before archiving them. There is exactly one event retention setting per Octopus instance.",
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		ReadContext:   resourceEventRetentionRead,
+		Schema:        getEventRetentionSchema(),
+		UpdateContext: resourceEventRetentionUpdate,
+	}
+}
+
+func updateEventRetention(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	eventRetention := expandEventRetention(d)
+
+	tflog.Info(ctx, fmt.Sprintf("updating event retention (%d days)", eventRetention.EventRetentionDays))
+
+	updatedEventRetention, err := configuration.UpdateConfigurationValues(getClient(m), configuration.EventRetentionID, eventRetention)
+	if err != nil {
+		return err
+	}
+
+	setEventRetention(d, updatedEventRetention)
+	return nil
+}
+
+func resourceEventRetentionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := updateEventRetention(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("event retention created (%s)", d.Id()))
+	return nil
+}
+
+func resourceEventRetentionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the event retention setting cannot be deleted; it exists for as long as the Octopus instance does
+	tflog.Info(ctx, fmt.Sprintf("removing event retention from state (%s)", d.Id()))
+
+	d.SetId("")
+	return nil
+}
+
+func resourceEventRetentionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading event retention (%s)", d.Id()))
+
+	eventRetention, err := configuration.GetConfigurationValues[configuration.EventRetention](getClient(m), configuration.EventRetentionID)
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "event retention")
+	}
+
+	setEventRetention(d, eventRetention)
+
+	tflog.Info(ctx, fmt.Sprintf("event retention read (%s)", d.Id()))
+	return nil
+}
+
+func resourceEventRetentionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := updateEventRetention(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("event retention updated (%s)", d.Id()))
+	return nil
+}
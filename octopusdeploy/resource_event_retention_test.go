@@ -0,0 +1,42 @@
+package octopusdeploy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventRetentionRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api":
+			w.Write([]byte(`{"Links":{"Configuration":"/api/configuration{/id}"}}`))
+		case "/api/Spaces-1":
+			w.Write([]byte(`{"Id":"Spaces-1","Links":{}}`))
+		case "/api/configuration/event-retention/values":
+			w.Write([]byte(`{"EventRetentionDays":365}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"ErrorMessage":"Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+	meta := newProviderMeta(octopus)
+
+	d := resourceEventRetention().TestResourceData()
+	d.SetId("event-retention")
+
+	require.False(t, resourceEventRetentionRead(context.Background(), d, meta).HasError())
+	require.Equal(t, "event-retention", d.Id())
+	require.Equal(t, 365, d.Get("retention_days"))
+}
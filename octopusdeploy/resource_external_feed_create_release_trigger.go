@@ -0,0 +1,118 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	trg "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/triggers"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceExternalFeedCreateReleaseTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceExternalFeedCreateReleaseTriggerCreate,
+		DeleteContext: resourceExternalFeedCreateReleaseTriggerDelete,
+		Description:   "This resource manages an external feed trigger of a project in Octopus Deploy, which creates a release when new versions of the monitored packages are available in their external feeds.",
+		Importer:      getImporter(),
+		ReadContext:   resourceExternalFeedCreateReleaseTriggerRead,
+		Schema:        getExternalFeedCreateReleaseTriggerSchema(),
+		UpdateContext: resourceExternalFeedCreateReleaseTriggerUpdate,
+	}
+}
+
+func resourceExternalFeedCreateReleaseTriggerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := client.Projects.GetByID(d.Get("project_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	trigger := expandExternalFeedCreateReleaseTrigger(d, project)
+
+	tflog.Info(ctx, fmt.Sprintf("creating external feed trigger (%s)", trigger.Name))
+
+	createdTrigger, err := trg.AddReleaseCreationTrigger(client, trigger)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setExternalFeedCreateReleaseTrigger(d, createdTrigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdTrigger.GetID())
+
+	tflog.Info(ctx, fmt.Sprintf("external feed trigger created (%s)", d.Id()))
+	return nil
+}
+
+func resourceExternalFeedCreateReleaseTriggerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("deleting external feed trigger (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.ProjectTriggers.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	tflog.Info(ctx, "external feed trigger deleted")
+	return nil
+}
+
+func resourceExternalFeedCreateReleaseTriggerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading external feed trigger (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	trigger, err := trg.GetReleaseCreationTrigger(client, d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "external feed trigger")
+	}
+
+	if err := setExternalFeedCreateReleaseTrigger(d, trigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("external feed trigger read (%s)", d.Id()))
+	return nil
+}
+
+func resourceExternalFeedCreateReleaseTriggerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("updating external feed trigger (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := client.Projects.GetByID(d.Get("project_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updatedTrigger, err := trg.UpdateReleaseCreationTrigger(client, expandExternalFeedCreateReleaseTrigger(d, project))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setExternalFeedCreateReleaseTrigger(d, updatedTrigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("external feed trigger updated (%s)", d.Id()))
+	return nil
+}
@@ -0,0 +1,92 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/configuration"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceFeaturesConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFeaturesConfigurationCreate,
+		DeleteContext: resourceFeaturesConfigurationDelete,
+		Description:   "This resource manages the server features of Octopus Deploy, such as the built-in worker and the help sidebar. There is exactly one features configuration per Octopus instance.",
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		ReadContext:   resourceFeaturesConfigurationRead,
+		Schema:        getFeaturesConfigurationSchema(),
+		UpdateContext: resourceFeaturesConfigurationUpdate,
+	}
+}
+
+func updateFeaturesConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	octopus := getClient(m)
+	featuresConfiguration, err := configuration.GetFeaturesConfiguration(octopus)
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("updating features configuration (%s)", featuresConfigurationID))
+
+	updatedFeaturesConfiguration, err := configuration.UpdateFeaturesConfiguration(octopus, expandFeaturesConfiguration(d, d.GetRawConfig(), featuresConfiguration))
+	if err != nil {
+		return err
+	}
+
+	// Octopus drops the properties that it does not know, so a feature that was not kept is misspelled or not
+	// available in this version of Octopus
+	for property := range d.Get("features").(map[string]interface{}) {
+		if _, ok := updatedFeaturesConfiguration[property]; !ok {
+			return fmt.Errorf("the server feature %s is not available in this version of Octopus", property)
+		}
+	}
+
+	setFeaturesConfiguration(d, updatedFeaturesConfiguration)
+	return nil
+}
+
+func resourceFeaturesConfigurationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := updateFeaturesConfiguration(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("features configuration created (%s)", d.Id()))
+	return nil
+}
+
+func resourceFeaturesConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the features configuration cannot be deleted; it exists for as long as the Octopus instance does
+	tflog.Info(ctx, fmt.Sprintf("removing features configuration from state (%s)", d.Id()))
+
+	d.SetId("")
+	return nil
+}
+
+func resourceFeaturesConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading features configuration (%s)", d.Id()))
+
+	featuresConfiguration, err := configuration.GetFeaturesConfiguration(getClient(m))
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "features configuration")
+	}
+
+	setFeaturesConfiguration(d, featuresConfiguration)
+
+	tflog.Info(ctx, fmt.Sprintf("features configuration read (%s)", d.Id()))
+	return nil
+}
+
+func resourceFeaturesConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := updateFeaturesConfiguration(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("features configuration updated (%s)", d.Id()))
+	return nil
+}
@@ -0,0 +1,103 @@
+package octopusdeploy
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/configuration"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeaturesConfigurationCreate(t *testing.T) {
+	featuresConfiguration := map[string]interface{}{
+		"Id":                                "FeaturesConfiguration",
+		"HelpSidebarSupportLink":            nil,
+		"IsBuiltInWorkerEnabled":            true,
+		"IsCommunityActionTemplatesEnabled": true,
+		"IsHelpSidebarEnabled":              true,
+		"IsExperimentalFeatureEnabled":      false,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api":
+			w.Write([]byte(`{"Links":{"FeaturesConfiguration":"/api/featuresconfiguration"}}`))
+		case "/api/Spaces-1":
+			w.Write([]byte(`{"Id":"Spaces-1","Links":{}}`))
+		case "/api/featuresconfiguration":
+			if r.Method == http.MethodPut {
+				updatedFeaturesConfiguration := map[string]interface{}{}
+				body, _ := io.ReadAll(r.Body)
+				require.NoError(t, json.Unmarshal(body, &updatedFeaturesConfiguration))
+
+				// properties that Octopus does not know are dropped
+				for property, value := range updatedFeaturesConfiguration {
+					if _, ok := featuresConfiguration[property]; ok {
+						featuresConfiguration[property] = value
+					}
+				}
+			}
+			json.NewEncoder(w).Encode(featuresConfiguration)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"ErrorMessage":"Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+	meta := newProviderMeta(octopus)
+
+	d := schema.TestResourceDataRaw(t, getFeaturesConfigurationSchema(), map[string]interface{}{
+		"features": map[string]interface{}{"IsExperimentalFeatureEnabled": true},
+	})
+
+	require.False(t, resourceFeaturesConfigurationCreate(context.Background(), d, meta).HasError())
+	require.Equal(t, "FeaturesConfiguration", d.Id())
+	require.Equal(t, map[string]interface{}{"IsExperimentalFeatureEnabled": true}, d.Get("features"))
+	require.Equal(t, true, featuresConfiguration["IsExperimentalFeatureEnabled"])
+
+	// features that are not configured are left unchanged
+	require.Equal(t, true, featuresConfiguration["IsBuiltInWorkerEnabled"])
+	require.Equal(t, true, d.Get("is_built_in_worker_enabled"))
+
+	// a feature that Octopus does not know is reported
+	d = schema.TestResourceDataRaw(t, getFeaturesConfigurationSchema(), map[string]interface{}{
+		"features": map[string]interface{}{"IsUnknownFeatureEnabled": true},
+	})
+	diags := resourceFeaturesConfigurationCreate(context.Background(), d, meta)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "IsUnknownFeatureEnabled")
+}
+
+func TestExpandFeaturesConfiguration(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getFeaturesConfigurationSchema(), map[string]interface{}{
+		"is_built_in_worker_enabled": false,
+	})
+	config := cty.ObjectVal(map[string]cty.Value{
+		"features":                              cty.NullVal(cty.Map(cty.Bool)),
+		"help_sidebar_support_link":             cty.NullVal(cty.String),
+		"is_built_in_worker_enabled":            cty.False,
+		"is_community_action_templates_enabled": cty.NullVal(cty.Bool),
+		"is_help_sidebar_enabled":               cty.NullVal(cty.Bool),
+	})
+
+	featuresConfiguration := expandFeaturesConfiguration(d, config, configuration.FeaturesConfiguration{
+		"IsBuiltInWorkerEnabled": true,
+		"IsHelpSidebarEnabled":   true,
+	})
+	require.Equal(t, configuration.FeaturesConfiguration{
+		"IsBuiltInWorkerEnabled": false,
+		"IsHelpSidebarEnabled":   true,
+	}, featuresConfiguration)
+}
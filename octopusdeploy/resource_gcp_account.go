@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/accounts"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,9 +15,13 @@ func resourceGoogleCloudPlatformAccount() *schema.Resource {
 		CreateContext: resourceGoogleCloudPlatformAccountCreate,
 		DeleteContext: resourceGoogleCloudPlatformAccountDelete,
 		Description:   "This resource manages GCP accounts in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("account", findAccounts),
 		ReadContext:   resourceGoogleCloudPlatformAccountRead,
 		Schema:        getGoogleCloudPlatformAccountSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getGoogleCloudPlatformAccountSchema(), "environments", "tenant_tags", "tenants"),
+		},
 		UpdateContext: resourceGoogleCloudPlatformAccountUpdate,
 	}
 }
@@ -28,7 +31,11 @@ func resourceGoogleCloudPlatformAccountCreate(ctx context.Context, d *schema.Res
 
 	log.Printf("[INFO] creating GCP account: %#v", account)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdAccount, err := client.Accounts.Add(account)
 	if err != nil {
 		return diag.FromErr(err)
@@ -47,7 +54,11 @@ func resourceGoogleCloudPlatformAccountCreate(ctx context.Context, d *schema.Res
 func resourceGoogleCloudPlatformAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting GCP account (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Accounts.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -61,7 +72,11 @@ func resourceGoogleCloudPlatformAccountDelete(ctx context.Context, d *schema.Res
 func resourceGoogleCloudPlatformAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading GCP account (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	accountResource, err := client.Accounts.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "GCP account")
@@ -81,7 +96,11 @@ func resourceGoogleCloudPlatformAccountUpdate(ctx context.Context, d *schema.Res
 
 	log.Printf("[INFO] updating GCP account: %#v", account)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedAccount, err := client.Accounts.Update(account)
 	if err != nil {
 		return diag.FromErr(err)
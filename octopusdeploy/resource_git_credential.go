@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,7 +15,7 @@ func resourceGitCredential() *schema.Resource {
 		CreateContext: resourceGitCredentialCreate,
 		DeleteContext: resourceGitCredentialDelete,
 		Description:   "This resource manages Git credentials in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("Git credential", findGitCredentials),
 		ReadContext:   resourceGitCredentialRead,
 		Schema:        getGitCredentialSchema(),
 		UpdateContext: resourceGitCredentialUpdate,
@@ -28,7 +27,11 @@ func resourceGitCredentialCreate(ctx context.Context, d *schema.ResourceData, m
 
 	tflog.Info(ctx, fmt.Sprintf("creating Git credential, %s", resource.Name))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdResource, err := client.GitCredentials.Add(resource)
 	if err != nil {
 		return diag.FromErr(err)
@@ -52,7 +55,11 @@ func resourceGitCredentialCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceGitCredentialDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("deleting Git credential (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.GitCredentials.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -66,7 +73,11 @@ func resourceGitCredentialDelete(ctx context.Context, d *schema.ResourceData, m
 func resourceGitCredentialRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("reading Git credential (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	resource, err := client.GitCredentials.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "Git credential")
@@ -85,7 +96,11 @@ func resourceGitCredentialUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	tflog.Info(ctx, fmt.Sprintf("updating Git credential (%s)", resource.GetID()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedResource, err := client.GitCredentials.Update(resource)
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,122 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	trg "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/triggers"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGitTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitTriggerCreate,
+		DeleteContext: resourceGitTriggerDelete,
+		Description:   "This resource manages a Git trigger of a version-controlled project in Octopus Deploy, which creates a release when commits are pushed to the monitored paths of the Git repositories referenced by its deployment process.",
+		Importer:      getImporter(),
+		ReadContext:   resourceGitTriggerRead,
+		Schema:        getGitTriggerSchema(),
+		UpdateContext: resourceGitTriggerUpdate,
+	}
+}
+
+func resourceGitTriggerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := client.Projects.GetByID(d.Get("project_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !project.IsVersionControlled {
+		return diag.Errorf("Git triggers require a version-controlled project; project %s is not version-controlled", project.GetID())
+	}
+
+	trigger := expandGitTrigger(d, project)
+
+	tflog.Info(ctx, fmt.Sprintf("creating Git trigger (%s)", trigger.Name))
+
+	createdTrigger, err := trg.AddReleaseCreationTrigger(client, trigger)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setGitTrigger(d, createdTrigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdTrigger.GetID())
+
+	tflog.Info(ctx, fmt.Sprintf("Git trigger created (%s)", d.Id()))
+	return nil
+}
+
+func resourceGitTriggerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("deleting Git trigger (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.ProjectTriggers.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	tflog.Info(ctx, "Git trigger deleted")
+	return nil
+}
+
+func resourceGitTriggerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading Git trigger (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	trigger, err := trg.GetReleaseCreationTrigger(client, d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "Git trigger")
+	}
+
+	if err := setGitTrigger(d, trigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Git trigger read (%s)", d.Id()))
+	return nil
+}
+
+func resourceGitTriggerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("updating Git trigger (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := client.Projects.GetByID(d.Get("project_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updatedTrigger, err := trg.UpdateReleaseCreationTrigger(client, expandGitTrigger(d, project))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setGitTrigger(d, updatedTrigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Git trigger updated (%s)", d.Id()))
+	return nil
+}
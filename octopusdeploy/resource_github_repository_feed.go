@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -17,7 +16,7 @@ func resourceGitHubRepositoryFeed() *schema.Resource {
 		CreateContext: resourceGitHubRepositoryFeedCreate,
 		DeleteContext: resourceGitHubRepositoryFeedDelete,
 		Description:   "This resource manages a GitHub repository feed in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("feed", findFeeds),
 		ReadContext:   resourceGitHubRepositoryFeedRead,
 		Schema:        getGitHubRepositoryFeedSchema(),
 		UpdateContext: resourceGitHubRepositoryFeedUpdate,
@@ -32,7 +31,11 @@ func resourceGitHubRepositoryFeedCreate(ctx context.Context, d *schema.ResourceD
 
 	tflog.Info(ctx, fmt.Sprintf("creating GitHub repository feed, %s", feed.GetName()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdGitHubRepositoryFeed, err := client.Feeds.Add(feed)
 	if err != nil {
 		return diag.FromErr(err)
@@ -51,8 +54,12 @@ func resourceGitHubRepositoryFeedCreate(ctx context.Context, d *schema.ResourceD
 func resourceGitHubRepositoryFeedDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("deleting GitHub repository feed (%s)", d.Id()))
 
-	client := m.(*client.Client)
-	err := client.Feeds.DeleteByID(d.Id())
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.Feeds.DeleteByID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -66,7 +73,11 @@ func resourceGitHubRepositoryFeedDelete(ctx context.Context, d *schema.ResourceD
 func resourceGitHubRepositoryFeedRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("reading GitHub repository feed (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	feed, err := client.Feeds.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "GitHub repository feed")
@@ -89,7 +100,11 @@ func resourceGitHubRepositoryFeedUpdate(ctx context.Context, d *schema.ResourceD
 
 	tflog.Info(ctx, fmt.Sprintf("updating GitHub repository feed (%s)", feed.GetID()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedFeed, err := client.Feeds.Update(feed)
 	if err != nil {
 		return diag.FromErr(err)
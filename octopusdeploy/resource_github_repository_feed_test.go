@@ -5,7 +5,6 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -56,7 +55,7 @@ func testGitHubRepositoryFeedBasic(localName string, downloadAttempts int, downl
 
 func testGitHubRepositoryFeedExists(prefix string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		feedID := s.RootModule().Resources[prefix].Primary.ID
 		if _, err := client.Feeds.GetByID(feedID); err != nil {
 			return err
@@ -72,7 +71,7 @@ func testGitHubRepositoryFeedCheckDestroy(s *terraform.State) error {
 			continue
 		}
 
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		feed, err := client.Feeds.GetByID(rs.Primary.ID)
 		if err == nil && feed != nil {
 			return fmt.Errorf("GitHub repository feed (%s) still exists", rs.Primary.ID)
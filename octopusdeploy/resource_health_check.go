@@ -0,0 +1,119 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceHealthCheck() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHealthCheckCreate,
+		DeleteContext: resourceHealthCheckDelete,
+		Description:   "This resource runs a health check of the deployment targets of an environment, of a machine policy, or of a list of machines in Octopus Deploy and waits for it to finish, such as after rotating the credentials or certificates that the targets use. The health check is run again when any of its arguments change, including `triggers`. The health check fails if its task does not succeed within the create timeout. Destroying this resource only removes it from the Terraform state.",
+		ReadContext:   resourceHealthCheckRead,
+		Schema:        getHealthCheckSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceHealthCheckCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	task, err := expandHealthCheckTask(d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("creating health check (%s)", task.Description))
+
+	createdTask, err := client.Tasks.Add(task)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the ID is set before waiting so that a failed health check is kept in state and run again on the next apply
+	d.SetId(createdTask.GetID())
+	setHealthCheckTask(d, createdTask)
+
+	completedTask, err := waitForTask(ctx, client, createdTask.GetID(), d.Timeout(schema.TimeoutCreate))
+	if completedTask != nil {
+		setHealthCheckTask(d, completedTask)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("health check created (%s)", d.Id()))
+	return nil
+}
+
+func resourceHealthCheckDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("deleting health check (%s)", d.Id()))
+
+	d.SetId("")
+	tflog.Info(ctx, "health check deleted")
+	return nil
+}
+
+func resourceHealthCheckRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading health check (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	task, err := getTask(client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	setHealthCheckTask(d, task)
+
+	tflog.Info(ctx, fmt.Sprintf("health check read (%s)", d.Id()))
+	return nil
+}
+
+// expandHealthCheckTask creates the task that checks the health of the configured deployment targets. Health checks
+// cannot be scoped to a machine policy, so the deployment targets that use the policy are checked instead.
+func expandHealthCheckTask(d *schema.ResourceData, client *client.Client) (*tasks.Task, error) {
+	if v, ok := d.GetOk("environment_id"); ok {
+		environmentID := v.(string)
+		return newHealthCheckTask(fmt.Sprintf("Check the health of the deployment targets in environment %s", environmentID), environmentID, nil), nil
+	}
+
+	if v, ok := d.GetOk("machine_policy_id"); ok {
+		machinePolicyID := v.(string)
+		deploymentTargets, err := client.Machines.GetAll()
+		if err != nil {
+			return nil, err
+		}
+
+		machineIDs := []string{}
+		for _, deploymentTarget := range deploymentTargets {
+			if deploymentTarget.MachinePolicyID == machinePolicyID {
+				machineIDs = append(machineIDs, deploymentTarget.GetID())
+			}
+		}
+
+		if len(machineIDs) == 0 {
+			return nil, fmt.Errorf("no deployment targets use machine policy (%s)", machinePolicyID)
+		}
+
+		return newHealthCheckTask(fmt.Sprintf("Check the health of the deployment targets using machine policy %s", machinePolicyID), "", machineIDs), nil
+	}
+
+	machineIDs := getSliceFromTerraformTypeList(d.Get("machine_ids"))
+	return newHealthCheckTask("Check the health of the selected deployment targets", "", machineIDs), nil
+}
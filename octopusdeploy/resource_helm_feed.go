@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -17,7 +16,7 @@ func resourceHelmFeed() *schema.Resource {
 		CreateContext: resourceHelmFeedCreate,
 		DeleteContext: resourceHelmFeedDelete,
 		Description:   "This resource manages a Helm feed in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("feed", findFeeds),
 		ReadContext:   resourceHelmFeedRead,
 		Schema:        getHelmFeedSchema(),
 		UpdateContext: resourceHelmFeedUpdate,
@@ -32,7 +31,11 @@ func resourceHelmFeedCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	tflog.Info(ctx, fmt.Sprintf("creating Helm feed, %s", feed.GetName()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdFeed, err := client.Feeds.Add(feed)
 	if err != nil {
 		return diag.FromErr(err)
@@ -51,8 +54,12 @@ func resourceHelmFeedCreate(ctx context.Context, d *schema.ResourceData, m inter
 func resourceHelmFeedDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("deleting Helm feed (%s)", d.Id()))
 
-	client := m.(*client.Client)
-	err := client.Feeds.DeleteByID(d.Id())
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.Feeds.DeleteByID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -66,7 +73,11 @@ func resourceHelmFeedDelete(ctx context.Context, d *schema.ResourceData, m inter
 func resourceHelmFeedRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("reading Helm feed (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	feed, err := client.Feeds.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "Helm feed")
@@ -89,7 +100,11 @@ func resourceHelmFeedUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 	tflog.Info(ctx, fmt.Sprintf("updating Helm feed (%s)", feed.GetID()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedFeed, err := client.Feeds.Update(feed)
 	if err != nil {
 		return diag.FromErr(err)
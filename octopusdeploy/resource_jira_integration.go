@@ -0,0 +1,80 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/configuration"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceJiraIntegration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJiraIntegrationCreate,
+		DeleteContext: resourceJiraIntegrationDelete,
+		Description:   "This resource manages the Jira issue tracker integration of Octopus Deploy, which links releases to Jira issues and sends deployment information to Jira. There is exactly one Jira integration per Octopus instance.",
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		ReadContext:   resourceJiraIntegrationRead,
+		Schema:        getJiraIntegrationSchema(),
+		UpdateContext: resourceJiraIntegrationUpdate,
+	}
+}
+
+func updateJiraIntegration(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	jiraIntegration := expandJiraIntegration(d)
+
+	tflog.Info(ctx, fmt.Sprintf("updating Jira integration (%s)", jiraIntegration.BaseURL))
+
+	updatedJiraIntegration, err := configuration.UpdateConfigurationValues(getClient(m), configuration.JiraIntegrationID, jiraIntegration)
+	if err != nil {
+		return err
+	}
+
+	setJiraIntegration(d, updatedJiraIntegration)
+	return nil
+}
+
+func resourceJiraIntegrationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := updateJiraIntegration(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Jira integration created (%s)", d.Id()))
+	return nil
+}
+
+func resourceJiraIntegrationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the Jira integration cannot be deleted; it exists for as long as the Octopus instance does
+	tflog.Info(ctx, fmt.Sprintf("removing Jira integration from state (%s)", d.Id()))
+
+	d.SetId("")
+	return nil
+}
+
+func resourceJiraIntegrationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading Jira integration (%s)", d.Id()))
+
+	jiraIntegration, err := configuration.GetConfigurationValues[configuration.JiraIntegration](getClient(m), configuration.JiraIntegrationID)
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "Jira integration")
+	}
+
+	setJiraIntegration(d, jiraIntegration)
+
+	tflog.Info(ctx, fmt.Sprintf("Jira integration read (%s)", d.Id()))
+	return nil
+}
+
+func resourceJiraIntegrationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := updateJiraIntegration(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Jira integration updated (%s)", d.Id()))
+	return nil
+}
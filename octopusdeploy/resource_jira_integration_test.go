@@ -0,0 +1,62 @@
+package octopusdeploy
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/configuration"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJiraIntegrationCreate(t *testing.T) {
+	var updatedJiraIntegration configuration.JiraIntegration
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api":
+			w.Write([]byte(`{"Links":{"Configuration":"/api/configuration{/id}"}}`))
+		case r.URL.Path == "/api/Spaces-1":
+			w.Write([]byte(`{"Id":"Spaces-1","Links":{}}`))
+		case r.URL.Path == "/api/configuration/jira-integration/values" && r.Method == http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			require.NoError(t, json.Unmarshal(body, &updatedJiraIntegration))
+
+			// Octopus never returns the passwords
+			w.Write([]byte(`{"IsEnabled":true,"BaseUrl":"https://example.atlassian.net","Password":{"HasValue":true},"ReleaseNoteOptions":{"Username":"octopus@example.com","Password":{"HasValue":true},"ReleaseNotePrefix":"Release note:"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"ErrorMessage":"Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+	meta := newProviderMeta(octopus)
+
+	d := resourceJiraIntegration().TestResourceData()
+	d.Set("base_url", "https://example.atlassian.net")
+	d.Set("connect_app_password", "connect-app-password")
+	d.Set("release_note_prefix", "Release note:")
+	d.Set("release_notes_username", "octopus@example.com")
+
+	require.False(t, resourceJiraIntegrationCreate(context.Background(), d, meta).HasError())
+	require.Equal(t, "jira-integration", d.Id())
+	require.Equal(t, "connect-app-password", d.Get("connect_app_password"))
+
+	require.Equal(t, "https://example.atlassian.net", updatedJiraIntegration.BaseURL)
+	require.Equal(t, "connect-app-password", *updatedJiraIntegration.Password.NewValue)
+	require.Equal(t, "Release note:", updatedJiraIntegration.ReleaseNoteOptions.ReleaseNotePrefix)
+	require.Equal(t, "octopus@example.com", updatedJiraIntegration.ReleaseNoteOptions.Username)
+
+	// the password for release notes held by Octopus is kept when it is not configured
+	require.True(t, updatedJiraIntegration.ReleaseNoteOptions.Password.HasValue)
+	require.Nil(t, updatedJiraIntegration.ReleaseNoteOptions.Password.NewValue)
+}
@@ -3,8 +3,8 @@ package octopusdeploy
 import (
 	"context"
 	"log"
+	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,11 +13,19 @@ import (
 func resourceKubernetesClusterDeploymentTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceKubernetesClusterDeploymentTargetCreate,
+		CustomizeDiff: validateDeploymentTargetTenants,
 		DeleteContext: resourceKubernetesClusterDeploymentTargetDelete,
 		Description:   "This resource manages Kubernetes cluster deployment targets in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("deployment target", findDeploymentTargets),
 		ReadContext:   resourceKubernetesClusterDeploymentTargetRead,
 		Schema:        getKubernetesClusterDeploymentTargetSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getKubernetesClusterDeploymentTargetSchema(), "environments", "roles", "tenant_tags", "tenants"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		UpdateContext: resourceKubernetesClusterDeploymentTargetUpdate,
 	}
 }
@@ -27,7 +35,11 @@ func resourceKubernetesClusterDeploymentTargetCreate(ctx context.Context, d *sch
 
 	log.Printf("[INFO] creating Kubernetes cluster deployment target: %#v", deploymentTarget)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdDeploymentTarget, err := client.Machines.Add(deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
@@ -39,6 +51,10 @@ func resourceKubernetesClusterDeploymentTargetCreate(ctx context.Context, d *sch
 
 	d.SetId(createdDeploymentTarget.GetID())
 
+	if diags := waitForCreatedDeploymentTarget(ctx, d, client, setKubernetesClusterDeploymentTarget); diags.HasError() {
+		return diags
+	}
+
 	log.Printf("[INFO] Kubernetes cluster deployment target created (%s)", d.Id())
 	return nil
 }
@@ -46,7 +62,11 @@ func resourceKubernetesClusterDeploymentTargetCreate(ctx context.Context, d *sch
 func resourceKubernetesClusterDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting Kubernetes cluster deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Machines.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +80,11 @@ func resourceKubernetesClusterDeploymentTargetDelete(ctx context.Context, d *sch
 func resourceKubernetesClusterDeploymentTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading Kubernetes cluster deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentTarget, err := client.Machines.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "Kubernetes cluster deployment target")
@@ -78,7 +102,11 @@ func resourceKubernetesClusterDeploymentTargetUpdate(ctx context.Context, d *sch
 	log.Printf("[INFO] updating Kubernetes cluster deployment target (%s)", d.Id())
 
 	deploymentTarget := expandKubernetesClusterDeploymentTarget(d)
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedDeploymentTarget, err := client.Machines.Update(deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
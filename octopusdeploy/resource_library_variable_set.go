@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +14,7 @@ func resourceLibraryVariableSet() *schema.Resource {
 		CreateContext: resourceLibraryVariableSetCreate,
 		DeleteContext: resourceLibraryVariableSetDelete,
 		Description:   "This resource manages library variable sets in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("library variable set", findLibraryVariableSets),
 		ReadContext:   resourceLibraryVariableSetRead,
 		Schema:        getLibraryVariableSetSchema(),
 		UpdateContext: resourceLibraryVariableSetUpdate,
@@ -27,7 +26,11 @@ func resourceLibraryVariableSetCreate(ctx context.Context, d *schema.ResourceDat
 
 	log.Printf("[INFO] creating library variable set: %#v", libraryVariableSet)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdLibraryVariableSet, err := client.LibraryVariableSets.Add(libraryVariableSet)
 	if err != nil {
 		return diag.FromErr(err)
@@ -46,8 +49,12 @@ func resourceLibraryVariableSetCreate(ctx context.Context, d *schema.ResourceDat
 func resourceLibraryVariableSetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting library variable set (%s)", d.Id())
 
-	client := m.(*client.Client)
-	err := client.LibraryVariableSets.DeleteByID(d.Id())
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.LibraryVariableSets.DeleteByID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +67,11 @@ func resourceLibraryVariableSetDelete(ctx context.Context, d *schema.ResourceDat
 func resourceLibraryVariableSetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading library variable set (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	libraryVariableSet, err := client.LibraryVariableSets.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "library variable set")
@@ -79,7 +90,11 @@ func resourceLibraryVariableSetUpdate(ctx context.Context, d *schema.ResourceDat
 
 	libraryVariableSet := expandLibraryVariableSet(d)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedLibraryVariableSet, err := client.LibraryVariableSets.Update(libraryVariableSet)
 	if err != nil {
 		return diag.FromErr(err)
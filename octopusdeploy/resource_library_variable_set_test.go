@@ -189,7 +189,7 @@ func testLibraryVariableSetDestroy(s *terraform.State) error {
 
 func testAccCheckOctopusDeployLibraryVariableSetExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		if err := existsHelperLibraryVariableSet(s, client); err != nil {
 			return err
 		}
@@ -198,7 +198,7 @@ func testAccCheckOctopusDeployLibraryVariableSetExists(n string) resource.TestCh
 }
 
 func destroyHelperLibraryVariableSet(s *terraform.State) error {
-	client := testAccProvider.Meta().(*client.Client)
+	client := getClient(testAccProvider.Meta())
 	for _, rs := range s.RootModule().Resources {
 		libraryVariableSetID := rs.Primary.ID
 		libraryVariableSet, err := client.LibraryVariableSets.GetByID(libraryVariableSetID)
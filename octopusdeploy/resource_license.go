@@ -0,0 +1,91 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/licenses"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceLicense() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLicenseCreate,
+		DeleteContext: resourceLicenseDelete,
+		Description:   "This resource manages the license installed on the Octopus Deploy instance.",
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		ReadContext:   resourceLicenseRead,
+		Schema:        getLicenseSchema(),
+		UpdateContext: resourceLicenseUpdate,
+	}
+}
+
+func updateLicense(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	octopus := getClient(m)
+
+	tflog.Info(ctx, fmt.Sprintf("installing license (%s)", licenses.CurrentLicenseID))
+
+	license, err := licenses.UpdateCurrentLicense(octopus, expandLicense(d))
+	if err != nil {
+		return err
+	}
+
+	licenseStatus, err := licenses.GetCurrentLicenseStatus(octopus)
+	if err != nil {
+		return err
+	}
+
+	setLicense(d, license, licenseStatus)
+	return nil
+}
+
+func resourceLicenseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := updateLicense(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("license created (%s)", d.Id()))
+	return nil
+}
+
+func resourceLicenseDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// an Octopus instance always has a license, so the installed license is left in place
+	tflog.Info(ctx, fmt.Sprintf("removing license from state (%s)", d.Id()))
+
+	d.SetId("")
+	return nil
+}
+
+func resourceLicenseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading license (%s)", d.Id()))
+
+	octopus := getClient(m)
+	license, err := licenses.GetCurrentLicense(octopus)
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "license")
+	}
+
+	licenseStatus, err := licenses.GetCurrentLicenseStatus(octopus)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setLicense(d, license, licenseStatus)
+
+	tflog.Info(ctx, fmt.Sprintf("license read (%s)", d.Id()))
+	return nil
+}
+
+func resourceLicenseUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := updateLicense(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("license updated (%s)", d.Id()))
+	return nil
+}
@@ -0,0 +1,58 @@
+package octopusdeploy
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLicenseCreate(t *testing.T) {
+	var installedLicenseText string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api":
+			w.Write([]byte(`{"Links":{}}`))
+		case r.URL.Path == "/api/Spaces-1":
+			w.Write([]byte(`{"Id":"Spaces-1","Links":{}}`))
+		case r.URL.Path == "/api/licenses/licenses-current" && r.Method == http.MethodPut:
+			var license map[string]interface{}
+			body, _ := io.ReadAll(r.Body)
+			require.NoError(t, json.Unmarshal(body, &license))
+			installedLicenseText = license["LicenseText"].(string)
+
+			w.Write([]byte(`{"Id":"licenses-current","LicenseText":"<License>Test</License>"}`))
+		case r.URL.Path == "/api/licenses/licenses-current-status":
+			w.Write([]byte(`{"IsCompliant":true,"ComplianceSummary":"Within limits","EffectiveExpiryDate":"2027-01-01T00:00:00+00:00"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"ErrorMessage":"Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+	meta := newProviderMeta(octopus)
+
+	d := resourceLicense().TestResourceData()
+	d.Set("license_text", "<License>Test</License>\n")
+
+	require.False(t, resourceLicenseCreate(context.Background(), d, meta).HasError())
+	require.Equal(t, "<License>Test</License>\n", installedLicenseText)
+	require.Equal(t, "licenses-current", d.Id())
+	require.Equal(t, true, d.Get("is_compliant"))
+	require.Equal(t, "2027-01-01T00:00:00+00:00", d.Get("effective_expiry_date"))
+
+	// the whitespace around a license read from a file is not kept by Octopus
+	require.True(t, suppressLicenseTextDiff("license_text", "<License>Test</License>", "<License>Test</License>\n", d))
+	require.False(t, suppressLicenseTextDiff("license_text", "<License>Test</License>", "<License>Renewed</License>", d))
+}
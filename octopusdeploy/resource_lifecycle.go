@@ -4,20 +4,25 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceLifecycle() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceLifecycleCreate,
+		CustomizeDiff: customdiff.All(validateReferences, validateRetentionPolicies),
 		DeleteContext: resourceLifecycleDelete,
 		Description:   "This resource manages lifecycles in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("lifecycle", findLifecycles),
 		ReadContext:   resourceLifecycleRead,
 		Schema:        getLifecycleSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getLifecycleSchema(), "phase.automatic_deployment_targets", "phase.optional_deployment_targets"),
+		},
 		UpdateContext: resourceLifecycleUpdate,
 	}
 }
@@ -27,7 +32,11 @@ func resourceLifecycleCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 	log.Printf("[INFO] creating lifecycle: %#v", lifecycle)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdLifecycle, err := client.Lifecycles.Add(lifecycle)
 	if err != nil {
 		return diag.FromErr(err)
@@ -46,7 +55,11 @@ func resourceLifecycleCreate(ctx context.Context, d *schema.ResourceData, m inte
 func resourceLifecycleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting lifecycle (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Lifecycles.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -59,7 +72,11 @@ func resourceLifecycleDelete(ctx context.Context, d *schema.ResourceData, m inte
 func resourceLifecycleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading lifecycle (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	lifecycle, err := client.Lifecycles.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "lifecycle")
@@ -78,7 +95,11 @@ func resourceLifecycleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 	lifecycle := expandLifecycle(d)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedLifecycle, err := client.Lifecycles.Update(lifecycle)
 	if err != nil {
 		return diag.FromErr(err)
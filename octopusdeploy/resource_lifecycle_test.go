@@ -2,6 +2,7 @@ package octopusdeploy
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
@@ -147,6 +148,76 @@ func TestAccLifecycleComplex(t *testing.T) {
 	})
 }
 
+func TestAccLifecycleWithMultiplePhases(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	resourceName := "octopusdeploy_lifecycle." + localName
+
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	phaseIDs := map[string]string{}
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccLifecycleCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecycleExists(resourceName),
+					testAccRecordLifecyclePhaseIDs(resourceName, phaseIDs),
+					resource.TestCheckResourceAttr(resourceName, "phase.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "phase.0.name", "Development"),
+					resource.TestCheckResourceAttr(resourceName, "phase.1.name", "Test"),
+					resource.TestCheckResourceAttr(resourceName, "phase.2.name", "Production"),
+					testAccCheckLifecyclePhaseCount(name, 3),
+				),
+				Config: testAccLifecycleWithPhases(localName, name, "Development", "Test", "Production"),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecycleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "phase.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "phase.0.name", "Development"),
+					resource.TestCheckResourceAttr(resourceName, "phase.1.name", "Test"),
+					resource.TestCheckResourceAttr(resourceName, "phase.2.name", "Staging"),
+					resource.TestCheckResourceAttr(resourceName, "phase.3.name", "Production"),
+					testAccCheckLifecyclePhaseCount(name, 4),
+					testAccCheckLifecyclePhaseIDs(resourceName, phaseIDs),
+				),
+				Config: testAccLifecycleWithPhases(localName, name, "Development", "Test", "Staging", "Production"),
+			},
+		},
+	})
+}
+
+func TestAccLifecycleWithPhaseRetentionPolicies(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	resourceName := "octopusdeploy_lifecycle." + localName
+
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccLifecycleCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecycleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "phase.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "phase.0.release_retention_policy.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "phase.1.release_retention_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "phase.1.release_retention_policy.0.should_keep_forever", "true"),
+					resource.TestCheckResourceAttr(resourceName, "phase.1.tentacle_retention_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "phase.1.tentacle_retention_policy.0.quantity_to_keep", "3"),
+					resource.TestCheckResourceAttr(resourceName, "phase.1.tentacle_retention_policy.0.unit", "Items"),
+					testAccCheckLifecyclePhaseRetentionPolicies(name),
+				),
+				Config: testAccLifecycleWithPhaseRetentionPolicies(localName, name),
+			},
+		},
+	})
+}
+
 func testAccLifecycle(localName string, name string) string {
 	return fmt.Sprintf(`resource "octopusdeploy_lifecycle" "%s" {
 		name = "%s"
@@ -204,9 +275,48 @@ func testAccLifecycleComplex(localName string, name string) string {
 	}`, localName, name, environment2LocalName, environment3LocalName)
 }
 
+func testAccLifecycleWithPhases(localName string, name string, phaseNames ...string) string {
+	phases := ""
+	for _, phaseName := range phaseNames {
+		phases += fmt.Sprintf(`
+		phase {
+			name = "%s"
+		}
+`, phaseName)
+	}
+
+	return fmt.Sprintf(`resource "octopusdeploy_lifecycle" "%s" {
+		name = "%s"
+%s	}`, localName, name, phases)
+}
+
+func testAccLifecycleWithPhaseRetentionPolicies(localName string, name string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_lifecycle" "%s" {
+		name = "%s"
+
+		phase {
+			name = "Development"
+		}
+
+		phase {
+			name = "Production"
+
+			release_retention_policy {
+				quantity_to_keep    = 0
+				should_keep_forever = true
+			}
+
+			tentacle_retention_policy {
+				quantity_to_keep = 3
+				unit             = "Items"
+			}
+		}
+	}`, localName, name)
+}
+
 func testAccCheckLifecycleExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		if err := existsHelperLifecycle(s, client); err != nil {
 			return err
 		}
@@ -214,9 +324,66 @@ func testAccCheckLifecycleExists(n string) resource.TestCheckFunc {
 	}
 }
 
+// testAccRecordLifecyclePhaseIDs records the IDs of the phases of a lifecycle by phase name.
+func testAccRecordLifecyclePhaseIDs(n string, phaseIDs map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["phase.#"])
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < count; i++ {
+			phaseIDs[rs.Primary.Attributes[fmt.Sprintf("phase.%d.name", i)]] = rs.Primary.Attributes[fmt.Sprintf("phase.%d.id", i)]
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckLifecyclePhaseIDs checks that the phases recorded by testAccRecordLifecyclePhaseIDs kept their IDs and
+// that phases added since were given new IDs.
+func testAccCheckLifecyclePhaseIDs(n string, phaseIDs map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["phase.#"])
+		if err != nil {
+			return err
+		}
+
+		existingIDs := map[string]bool{}
+		for _, id := range phaseIDs {
+			existingIDs[id] = true
+		}
+
+		for i := 0; i < count; i++ {
+			name := rs.Primary.Attributes[fmt.Sprintf("phase.%d.name", i)]
+			id := rs.Primary.Attributes[fmt.Sprintf("phase.%d.id", i)]
+
+			if expectedID, ok := phaseIDs[name]; ok {
+				if id != expectedID {
+					return fmt.Errorf("phase %s has the ID %s instead of %s", name, id, expectedID)
+				}
+			} else if len(id) == 0 || existingIDs[id] {
+				return fmt.Errorf("new phase %s was not given a new ID (%s)", name, id)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckLifecyclePhaseCount(name string, expected int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		resourceList, err := client.Lifecycles.GetByPartialName(name)
 		if err != nil {
 			return err
@@ -232,6 +399,35 @@ func testAccCheckLifecyclePhaseCount(name string, expected int) resource.TestChe
 	}
 }
 
+func testAccCheckLifecyclePhaseRetentionPolicies(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := getClient(testAccProvider.Meta())
+		resourceList, err := client.Lifecycles.GetByPartialName(name)
+		if err != nil {
+			return err
+		}
+
+		phases := resourceList[0].Phases
+		if len(phases) != 2 {
+			return fmt.Errorf("lifecycle has %d phases instead of the expected 2", len(phases))
+		}
+
+		if phases[0].ReleaseRetentionPolicy != nil {
+			return fmt.Errorf("phase %s has a release retention policy instead of the retention policy of the lifecycle", phases[0].Name)
+		}
+
+		if phases[1].ReleaseRetentionPolicy == nil || !phases[1].ReleaseRetentionPolicy.ShouldKeepForever {
+			return fmt.Errorf("phase %s does not keep releases forever", phases[1].Name)
+		}
+
+		if phases[1].TentacleRetentionPolicy == nil || phases[1].TentacleRetentionPolicy.QuantityToKeep != 3 || phases[1].TentacleRetentionPolicy.Unit != "Items" {
+			return fmt.Errorf("phase %s does not keep the expected 3 items on Tentacles", phases[1].Name)
+		}
+
+		return nil
+	}
+}
+
 func existsHelperLifecycle(s *terraform.State, client *client.Client) error {
 	for _, r := range s.RootModule().Resources {
 		if r.Type == "octopusdeploy_lifecycle" {
@@ -249,7 +445,7 @@ func testAccLifecycleCheckDestroy(s *terraform.State) error {
 			continue
 		}
 
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		lifecycle, err := client.Lifecycles.GetByID(rs.Primary.ID)
 		if err == nil && lifecycle != nil {
 			return fmt.Errorf("lifecycle (%s) still exists", rs.Primary.ID)
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/test"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -97,7 +96,7 @@ func TestAccListeningTentacleDeploymentTarget(t *testing.T) {
 
 func testAccListeningTentacleDeploymentTargetExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		deploymentTargetID := s.RootModule().Resources[resourceName].Primary.ID
 		if _, err := client.Machines.GetByID(deploymentTargetID); err != nil {
 			return fmt.Errorf("error retrieving deployment target: %s", err)
@@ -108,7 +107,7 @@ func testAccListeningTentacleDeploymentTargetExists(resourceName string) resourc
 }
 
 func testAccListeningTentacleDeploymentTargetCheckDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*client.Client)
+	client := getClient(testAccProvider.Meta())
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "octopusdeploy_listening_tentacle_deployment_target" {
 			continue
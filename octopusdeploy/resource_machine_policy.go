@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +14,7 @@ func resourceMachinePolicy() *schema.Resource {
 		CreateContext: resourceMachinePolicyCreate,
 		DeleteContext: resourceMachinePolicyDelete,
 		Description:   "This resource manages machine policies in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("machine policy", findMachinePolicies),
 		ReadContext:   resourceMachinePolicyRead,
 		Schema:        getMachinePolicySchema(),
 		UpdateContext: resourceMachinePolicyUpdate,
@@ -27,7 +26,11 @@ func resourceMachinePolicyCreate(ctx context.Context, d *schema.ResourceData, m
 
 	log.Printf("[INFO] creating machine policy: %#v", machinePolicy)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdMachinePolicy, err := client.MachinePolicies.Add(machinePolicy)
 	if err != nil {
 		return diag.FromErr(err)
@@ -46,7 +49,11 @@ func resourceMachinePolicyCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceMachinePolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting machine policy (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.MachinePolicies.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +67,11 @@ func resourceMachinePolicyDelete(ctx context.Context, d *schema.ResourceData, m
 func resourceMachinePolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading machine policy (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	machinePolicy, err := client.MachinePolicies.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "machine policy")
@@ -78,7 +89,11 @@ func resourceMachinePolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[INFO] updating machine policy (%s)", d.Id())
 
 	machinePolicy := expandMachinePolicy(d)
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedMachinePolicy, err := client.MachinePolicies.Update(machinePolicy)
 	if err != nil {
 		return diag.FromErr(err)
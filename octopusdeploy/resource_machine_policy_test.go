@@ -121,7 +121,7 @@ package octopusdeploy
 
 // func testMachinePolicyExists(prefix string) resource.TestCheckFunc {
 // 	return func(s *terraform.State) error {
-// 		client := testAccProvider.Meta().(*client.Client)
+// 		client := getClient(testAccProvider.Meta())
 // 		id := s.RootModule().Resources[prefix].Primary.ID
 // 		if _, err := client.MachinePolicies.GetByID(id); err != nil {
 // 			return err
@@ -132,7 +132,7 @@ package octopusdeploy
 // }
 
 // func testAccMachinePolicyCheckDestroy(s *terraform.State) error {
-// 	client := testAccProvider.Meta().(*client.Client)
+// 	client := getClient(testAccProvider.Meta())
 // 	for _, rs := range s.RootModule().Resources {
 // 		id := rs.Primary.ID
 // 		machinePolicy, err := client.MachinePolicies.GetByID(id)
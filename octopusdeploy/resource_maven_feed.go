@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -17,7 +16,7 @@ func resourceMavenFeed() *schema.Resource {
 		CreateContext: resourceMavenFeedCreate,
 		DeleteContext: resourceMavenFeedDelete,
 		Description:   "This resource manages a Maven feed in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("feed", findFeeds),
 		ReadContext:   resourceMavenFeedRead,
 		Schema:        getMavenFeedSchema(),
 		UpdateContext: resourceMavenFeedUpdate,
@@ -32,7 +31,11 @@ func resourceMavenFeedCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 	tflog.Info(ctx, fmt.Sprintf("creating Maven feed: %s", mavenFeed.GetName()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdFeed, err := client.Feeds.Add(mavenFeed)
 	if err != nil {
 		return diag.FromErr(err)
@@ -51,8 +54,12 @@ func resourceMavenFeedCreate(ctx context.Context, d *schema.ResourceData, m inte
 func resourceMavenFeedDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("deleting Maven feed (%s)", d.Id()))
 
-	client := m.(*client.Client)
-	err := client.Feeds.DeleteByID(d.Id())
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.Feeds.DeleteByID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -66,7 +73,11 @@ func resourceMavenFeedDelete(ctx context.Context, d *schema.ResourceData, m inte
 func resourceMavenFeedRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("reading Maven feed (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	feed, err := client.Feeds.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "Maven feed")
@@ -89,7 +100,11 @@ func resourceMavenFeedUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 	tflog.Info(ctx, fmt.Sprintf("updating Maven feed (%s)", feed.GetID()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedFeed, err := client.Feeds.Update(feed)
 	if err != nil {
 		return diag.FromErr(err)
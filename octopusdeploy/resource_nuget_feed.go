@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -17,7 +16,7 @@ func resourceNuGetFeed() *schema.Resource {
 		CreateContext: resourceNuGetFeedCreate,
 		DeleteContext: resourceNuGetFeedDelete,
 		Description:   "This resource manages a NuGet feed in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("feed", findFeeds),
 		ReadContext:   resourceNuGetFeedRead,
 		Schema:        getNuGetFeedSchema(),
 		UpdateContext: resourceNuGetFeedUpdate,
@@ -32,7 +31,11 @@ func resourceNuGetFeedCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 	tflog.Info(ctx, fmt.Sprintf("creating NuGet feed: %s", feed.GetName()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdFeed, err := client.Feeds.Add(feed)
 	if err != nil {
 		return diag.FromErr(err)
@@ -51,8 +54,12 @@ func resourceNuGetFeedCreate(ctx context.Context, d *schema.ResourceData, m inte
 func resourceNuGetFeedDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("deleting NuGet feed (%s)", d.Id()))
 
-	client := m.(*client.Client)
-	err := client.Feeds.DeleteByID(d.Id())
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.Feeds.DeleteByID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -66,7 +73,11 @@ func resourceNuGetFeedDelete(ctx context.Context, d *schema.ResourceData, m inte
 func resourceNuGetFeedRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("reading NuGet feed (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	feed, err := client.Feeds.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "NuGet feed")
@@ -89,7 +100,11 @@ func resourceNuGetFeedUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 	tflog.Info(ctx, fmt.Sprintf("updating NuGet feed (%s)", feed.GetID()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedFeed, err := client.Feeds.Update(feed)
 	if err != nil {
 		return diag.FromErr(err)
@@ -3,8 +3,8 @@ package octopusdeploy
 import (
 	"context"
 	"log"
+	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,11 +13,19 @@ import (
 func resourceOfflinePackageDropDeploymentTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceOfflinePackageDropDeploymentTargetCreate,
+		CustomizeDiff: validateDeploymentTargetTenants,
 		DeleteContext: resourceOfflinePackageDropDeploymentTargetDelete,
 		Description:   "This resource manages offline package drop deployment targets in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("deployment target", findDeploymentTargets),
 		ReadContext:   resourceOfflinePackageDropDeploymentTargetRead,
 		Schema:        getOfflinePackageDropDeploymentTargetSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getOfflinePackageDropDeploymentTargetSchema(), "environments", "roles", "tenant_tags", "tenants"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		UpdateContext: resourceOfflinePackageDropDeploymentTargetUpdate,
 	}
 }
@@ -27,7 +35,11 @@ func resourceOfflinePackageDropDeploymentTargetCreate(ctx context.Context, d *sc
 
 	log.Printf("[INFO] creating offline package drop deployment target: %#v", deploymentTarget)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdDeploymentTarget, err := client.Machines.Add(deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
@@ -39,6 +51,10 @@ func resourceOfflinePackageDropDeploymentTargetCreate(ctx context.Context, d *sc
 
 	d.SetId(createdDeploymentTarget.GetID())
 
+	if diags := waitForCreatedDeploymentTarget(ctx, d, client, setOfflinePackageDropDeploymentTarget); diags.HasError() {
+		return diags
+	}
+
 	log.Printf("[INFO] offline package drop deployment target created (%s)", d.Id())
 	return nil
 }
@@ -46,7 +62,11 @@ func resourceOfflinePackageDropDeploymentTargetCreate(ctx context.Context, d *sc
 func resourceOfflinePackageDropDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting offline package drop deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Machines.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +80,11 @@ func resourceOfflinePackageDropDeploymentTargetDelete(ctx context.Context, d *sc
 func resourceOfflinePackageDropDeploymentTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading offline package drop deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentTarget, err := client.Machines.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "offline package drop deployment target")
@@ -78,7 +102,11 @@ func resourceOfflinePackageDropDeploymentTargetUpdate(ctx context.Context, d *sc
 	log.Printf("[INFO] updating offline package drop deployment target (%s)", d.Id())
 
 	deploymentTarget := expandOfflinePackageDropDeploymentTarget(d)
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedDeploymentTarget, err := client.Machines.Update(deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
@@ -3,8 +3,8 @@ package octopusdeploy
 import (
 	"context"
 	"log"
+	"time"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,11 +13,19 @@ import (
 func resourcePollingTentacleDeploymentTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourcePollingTentacleDeploymentTargetCreate,
+		CustomizeDiff: validateDeploymentTargetTenants,
 		DeleteContext: resourcePollingTentacleDeploymentTargetDelete,
 		Description:   "This resource manages polling tentacle deployment targets in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("deployment target", findDeploymentTargets),
 		ReadContext:   resourcePollingTentacleDeploymentTargetRead,
 		Schema:        getPollingTentacleDeploymentTargetSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getPollingTentacleDeploymentTargetSchema(), "environments", "roles", "tenant_tags", "tenants"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		UpdateContext: resourcePollingTentacleDeploymentTargetUpdate,
 	}
 }
@@ -27,7 +35,11 @@ func resourcePollingTentacleDeploymentTargetCreate(ctx context.Context, d *schem
 
 	log.Printf("[INFO] creating polling tentacle deployment target: %#v", deploymentTarget)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdDeploymentTarget, err := client.Machines.Add(deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
@@ -39,6 +51,10 @@ func resourcePollingTentacleDeploymentTargetCreate(ctx context.Context, d *schem
 
 	d.SetId(createdDeploymentTarget.GetID())
 
+	if diags := waitForCreatedDeploymentTarget(ctx, d, client, setPollingTentacleDeploymentTarget); diags.HasError() {
+		return diags
+	}
+
 	log.Printf("[INFO] polling tentacle deployment target created (%s)", d.Id())
 	return nil
 }
@@ -46,7 +62,11 @@ func resourcePollingTentacleDeploymentTargetCreate(ctx context.Context, d *schem
 func resourcePollingTentacleDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting polling tentacle deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Machines.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +80,11 @@ func resourcePollingTentacleDeploymentTargetDelete(ctx context.Context, d *schem
 func resourcePollingTentacleDeploymentTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading polling tentacle deployment target (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentTarget, err := client.Machines.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "polling tentacle deployment target")
@@ -78,7 +102,11 @@ func resourcePollingTentacleDeploymentTargetUpdate(ctx context.Context, d *schem
 	log.Printf("[INFO] updating polling tentacle deployment target (%s)", d.Id())
 
 	deploymentTarget := expandPollingTentacleDeploymentTarget(d)
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedDeploymentTarget, err := client.Machines.Update(deploymentTarget)
 	if err != nil {
 		return diag.FromErr(err)
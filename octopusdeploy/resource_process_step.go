@@ -0,0 +1,206 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceProcessStep() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProcessStepCreate,
+		CustomizeDiff: customdiff.All(validateActionProperties, validateReferences),
+		DeleteContext: resourceProcessStepDelete,
+		Description:   "This resource manages a single step of the deployment process of a project in Octopus Deploy. Steps of the same process may be declared in different modules; use `octopusdeploy_process_steps_order` to control the order in which they run. This resource must not be combined with `octopusdeploy_deployment_process` for the same project.",
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProcessStepImport,
+		},
+		ReadContext:   resourceProcessStepRead,
+		Schema:        getProcessStepSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getProcessStepSchema(), "*.channels", "*.environments", "*.excluded_environments", "*.tenant_tags"),
+		},
+		UpdateContext: resourceProcessStepUpdate,
+	}
+}
+
+// resourceProcessStepImport accepts a project ID and step ID separated by a colon (e.g.
+// Projects-1:2d63a8e1-cf3c-4c4b-8a6b-0a1b2c3d4e5f)
+func resourceProcessStepImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	projectID, stepID, ok := strings.Cut(d.Id(), ":")
+	if !ok || len(projectID) == 0 || len(stepID) == 0 {
+		return nil, fmt.Errorf("octopusdeploy_process_step import must be in the form of ProjectID:StepID (e.g. Projects-1:2d63a8e1-cf3c-4c4b-8a6b-0a1b2c3d4e5f)")
+	}
+
+	d.SetId(stepID)
+	d.Set("project_id", projectID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getProcessStepDeploymentProcess(d *schema.ResourceData, m interface{}, client *client.Client) (*deployments.DeploymentProcess, error) {
+	project, err := getProject(m, client, d.Get("project_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DeploymentProcesses.Get(project, d.Get("git_ref").(string))
+}
+
+func resourceProcessStepCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	defer lockDeploymentProcess(m, client, d.Get("project_id").(string))()
+
+	step := expandProcessStep(ctx, d)
+
+	tflog.Info(ctx, fmt.Sprintf("creating process step (%s)", step.Name))
+
+	var updatedDeploymentProcess *deployments.DeploymentProcess
+	err = updateProcess(ctx, getProcessUpdateAttempts(m), func() error {
+		deploymentProcess, err := getProcessStepDeploymentProcess(d, m, client)
+		if err != nil {
+			return err
+		}
+
+		for _, existingStep := range deploymentProcess.Steps {
+			if existingStep.Name == step.Name {
+				return fmt.Errorf("the deployment process of project %s already contains a step named '%s'", deploymentProcess.ProjectID, step.Name)
+			}
+		}
+
+		deploymentProcess.Steps = append(deploymentProcess.Steps, step)
+		updatedDeploymentProcess, err = client.DeploymentProcesses.Update(deploymentProcess)
+		return getProcessUpdateError(client, deploymentProcess.Links["Self"], deploymentProcess.Version, err)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// steps are identified by name within a process until Octopus has assigned their ID
+	for _, updatedStep := range updatedDeploymentProcess.Steps {
+		if updatedStep.Name == step.Name {
+			d.SetId(updatedStep.ID)
+			if err := setProcessStep(d, updatedStep); err != nil {
+				return diag.FromErr(err)
+			}
+
+			tflog.Info(ctx, fmt.Sprintf("process step created (%s)", d.Id()))
+			return nil
+		}
+	}
+
+	return diag.Errorf("the step '%s' was not found in the deployment process of project %s after it was created", step.Name, updatedDeploymentProcess.ProjectID)
+}
+
+func resourceProcessStepDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("deleting process step (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	defer lockDeploymentProcess(m, client, d.Get("project_id").(string))()
+
+	err = updateProcess(ctx, getProcessUpdateAttempts(m), func() error {
+		deploymentProcess, err := getProcessStepDeploymentProcess(d, m, client)
+		if err != nil {
+			return err
+		}
+
+		i := findDeploymentStep(deploymentProcess, d.Id())
+		if i < 0 {
+			return nil
+		}
+
+		deploymentProcess.Steps = append(deploymentProcess.Steps[:i], deploymentProcess.Steps[i+1:]...)
+		_, err = client.DeploymentProcesses.Update(deploymentProcess)
+		return getProcessUpdateError(client, deploymentProcess.Links["Self"], deploymentProcess.Version, err)
+	})
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "process step")
+	}
+
+	d.SetId("")
+	tflog.Info(ctx, "process step deleted")
+	return nil
+}
+
+func resourceProcessStepRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading process step (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentProcess, err := getProcessStepDeploymentProcess(d, m, client)
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "process step")
+	}
+
+	i := findDeploymentStep(deploymentProcess, d.Id())
+	if i < 0 {
+		return errors.DeleteFromState(ctx, d, "process step")
+	}
+
+	if err := setProcessStep(d, deploymentProcess.Steps[i]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("process step read (%s)", d.Id()))
+	return nil
+}
+
+func resourceProcessStepUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("updating process step (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	defer lockDeploymentProcess(m, client, d.Get("project_id").(string))()
+
+	var updatedDeploymentProcess *deployments.DeploymentProcess
+	err = updateProcess(ctx, getProcessUpdateAttempts(m), func() error {
+		deploymentProcess, err := getProcessStepDeploymentProcess(d, m, client)
+		if err != nil {
+			return err
+		}
+
+		i := findDeploymentStep(deploymentProcess, d.Id())
+		if i < 0 {
+			return fmt.Errorf("the deployment process of project %s no longer contains the step %s", deploymentProcess.ProjectID, d.Id())
+		}
+
+		deploymentProcess.Steps[i] = expandProcessStep(ctx, d)
+		updatedDeploymentProcess, err = client.DeploymentProcesses.Update(deploymentProcess)
+		return getProcessUpdateError(client, deploymentProcess.Links["Self"], deploymentProcess.Version, err)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if i := findDeploymentStep(updatedDeploymentProcess, d.Id()); i >= 0 {
+		if err := setProcessStep(d, updatedDeploymentProcess.Steps[i]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("process step updated (%s)", d.Id()))
+	return nil
+}
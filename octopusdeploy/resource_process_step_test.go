@@ -0,0 +1,158 @@
+package octopusdeploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/test"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccProcessStepBasic(t *testing.T) {
+	lifecycleTestOptions := test.NewLifecycleTestOptions()
+	projectGroupTestOptions := test.NewProjectGroupTestOptions()
+	projectTestOptions := test.NewProjectTestOptions(lifecycleTestOptions, projectGroupTestOptions)
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	firstStepName := "octopusdeploy_process_step." + localName + "_first"
+	secondStepName := "octopusdeploy_process_step." + localName + "_second"
+	orderName := "octopusdeploy_process_steps_order." + localName
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccProjectCheckDestroy,
+			testAccProjectGroupCheckDestroy,
+			testAccLifecycleCheckDestroy,
+		),
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectCheckExists(),
+					resource.TestCheckResourceAttrSet(firstStepName, "id"),
+					resource.TestCheckResourceAttr(firstStepName, "run_script_action.0.name", "First"),
+					resource.TestCheckResourceAttrSet(secondStepName, "id"),
+					resource.TestCheckResourceAttrPair(orderName, "steps.0", secondStepName, "id"),
+					resource.TestCheckResourceAttrPair(orderName, "steps.1", firstStepName, "id"),
+				),
+				Config: test.GetConfiguration([]string{
+					test.LifecycleConfiguration(lifecycleTestOptions),
+					test.ProjectGroupConfiguration(projectGroupTestOptions),
+					test.ProjectConfiguration(projectTestOptions),
+					testAccProcessSteps(localName, projectTestOptions.QualifiedName),
+				}),
+			},
+		},
+	})
+}
+
+func testAccProcessSteps(localName string, projectQualifiedName string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_process_step" "%[1]s_first" {
+		name       = "First"
+		project_id = %[2]s.id
+
+		run_script_action {
+			name          = "First"
+			run_on_server = true
+			script_body   = "Write-Host 'first'"
+		}
+	}
+
+	resource "octopusdeploy_process_step" "%[1]s_second" {
+		name       = "Second"
+		project_id = %[2]s.id
+
+		run_script_action {
+			name          = "Second"
+			run_on_server = true
+			script_body   = "Write-Host 'second'"
+		}
+	}
+
+	resource "octopusdeploy_process_steps_order" "%[1]s" {
+		project_id = %[2]s.id
+		steps      = [
+			octopusdeploy_process_step.%[1]s_second.id,
+			octopusdeploy_process_step.%[1]s_first.id,
+		]
+	}`, localName, projectQualifiedName)
+}
+
+func TestProcessStepCreateWithConflict(t *testing.T) {
+	deploymentProcess := map[string]interface{}{
+		"Id":        "deploymentprocess-Projects-1",
+		"ProjectId": "Projects-1",
+		"Steps":     []interface{}{},
+		"Version":   1,
+		"Links":     map[string]interface{}{"Self": "/api/Spaces-1/deploymentprocesses/deploymentprocess-Projects-1"},
+	}
+	projectRequests := 0
+	updates := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api":
+			w.Write([]byte(`{"Links":{}}`))
+		case r.URL.Path == "/api/Spaces-1":
+			w.Write([]byte(`{"Id":"Spaces-1","Links":{"DeploymentProcesses":"/api/Spaces-1/deploymentprocesses{/id}","Projects":"/api/Spaces-1/projects{/id}{?name,skip,ids,clone,take,partialName,clonedFromProjectId}"}}`))
+		case r.URL.Path == "/api/Spaces-1/projects/Projects-1":
+			projectRequests++
+			w.Write([]byte(`{"Id":"Projects-1","Name":"Web","DeploymentProcessId":"deploymentprocess-Projects-1","LifecycleId":"Lifecycles-1","ProjectGroupId":"ProjectGroups-1"}`))
+		case r.URL.Path == "/api/Spaces-1/deploymentprocesses/deploymentprocess-Projects-1" && r.Method == http.MethodPut:
+			updates++
+			if updates == 1 {
+				// another step is added to the process after it was read
+				deploymentProcess["Steps"] = append(deploymentProcess["Steps"].([]interface{}), map[string]interface{}{"Id": "Steps-1", "Name": "Other"})
+				deploymentProcess["Version"] = 2
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(`{"ErrorMessage":"The process has been changed","Errors":["The process has been changed"]}`))
+				return
+			}
+
+			updatedDeploymentProcess := map[string]interface{}{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&updatedDeploymentProcess))
+			require.EqualValues(t, 2, updatedDeploymentProcess["Version"])
+			for _, step := range updatedDeploymentProcess["Steps"].([]interface{}) {
+				if id, _ := step.(map[string]interface{})["Id"].(string); id == "" {
+					step.(map[string]interface{})["Id"] = "Steps-2"
+				}
+			}
+			updatedDeploymentProcess["Version"] = 3
+			deploymentProcess = updatedDeploymentProcess
+			json.NewEncoder(w).Encode(deploymentProcess)
+		case r.URL.Path == "/api/Spaces-1/deploymentprocesses/deploymentprocess-Projects-1":
+			json.NewEncoder(w).Encode(deploymentProcess)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"ErrorMessage":"Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+	meta := newProviderMeta(octopus)
+
+	d := resourceProcessStep().TestResourceData()
+	d.Set("name", "Run a Script")
+	d.Set("project_id", "Projects-1")
+
+	// the step is added to the process as it was changed
+	require.False(t, resourceProcessStepCreate(context.Background(), d, meta).HasError())
+	require.Equal(t, 2, updates)
+	require.Equal(t, "Steps-2", d.Id())
+	require.Len(t, deploymentProcess["Steps"], 2)
+
+	// the project is only requested once, although the process is read again
+	require.Equal(t, 1, projectRequests)
+}
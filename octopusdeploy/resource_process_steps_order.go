@@ -0,0 +1,144 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceProcessStepsOrder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProcessStepsOrderCreate,
+		DeleteContext: resourceProcessStepsOrderDelete,
+		Description:   "This resource manages the order of the steps of the deployment process of a project in Octopus Deploy. It is intended for use with `octopusdeploy_process_step`.",
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProcessStepsOrderImport,
+		},
+		ReadContext:   resourceProcessStepsOrderRead,
+		Schema:        getProcessStepsOrderSchema(),
+		UpdateContext: resourceProcessStepsOrderUpdate,
+	}
+}
+
+// resourceProcessStepsOrderImport accepts either a project ID or, for version-controlled projects, a project ID and
+// git reference separated by a colon (e.g. Projects-1:main)
+func resourceProcessStepsOrderImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	projectID, gitRef, _ := strings.Cut(d.Id(), ":")
+
+	d.SetId(projectID)
+	d.Set("project_id", projectID)
+	if len(gitRef) > 0 {
+		d.Set("git_ref", gitRef)
+	}
+
+	client := getClient(m)
+	deploymentProcess, err := getProcessStepDeploymentProcess(d, m, client)
+	if err != nil {
+		return nil, err
+	}
+
+	// all steps are ordered by an imported resource
+	stepIDs := []string{}
+	for _, step := range deploymentProcess.Steps {
+		stepIDs = append(stepIDs, step.ID)
+	}
+	d.Set("steps", stepIDs)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateProcessStepsOrder(ctx context.Context, d *schema.ResourceData, m interface{}, client *client.Client) error {
+	defer lockDeploymentProcess(m, client, d.Get("project_id").(string))()
+
+	stepIDs := getSliceFromTerraformTypeList(d.Get("steps"))
+
+	var updatedDeploymentProcess *deployments.DeploymentProcess
+	err := updateProcess(ctx, getProcessUpdateAttempts(m), func() error {
+		deploymentProcess, err := getProcessStepDeploymentProcess(d, m, client)
+		if err != nil {
+			return err
+		}
+
+		for _, stepID := range stepIDs {
+			if findDeploymentStep(deploymentProcess, stepID) < 0 {
+				return fmt.Errorf("the deployment process of project %s does not contain the step %s", deploymentProcess.ProjectID, stepID)
+			}
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("ordering process steps (%s)", deploymentProcess.ProjectID))
+
+		orderDeploymentSteps(deploymentProcess, stepIDs)
+		updatedDeploymentProcess, err = client.DeploymentProcesses.Update(deploymentProcess)
+		return getProcessUpdateError(client, deploymentProcess.Links["Self"], deploymentProcess.Version, err)
+	})
+	if err != nil {
+		return err
+	}
+
+	d.Set("steps", flattenDeploymentStepsOrder(updatedDeploymentProcess, stepIDs))
+	return nil
+}
+
+func resourceProcessStepsOrderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateProcessStepsOrder(ctx, d, m, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("project_id").(string))
+
+	tflog.Info(ctx, fmt.Sprintf("process steps order created (%s)", d.Id()))
+	return nil
+}
+
+func resourceProcessStepsOrderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the steps keep their current order; only the resource is removed
+	tflog.Info(ctx, fmt.Sprintf("removing process steps order from state (%s)", d.Id()))
+
+	d.SetId("")
+	return nil
+}
+
+func resourceProcessStepsOrderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading process steps order (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentProcess, err := getProcessStepDeploymentProcess(d, m, client)
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "process steps order")
+	}
+
+	d.Set("steps", flattenDeploymentStepsOrder(deploymentProcess, getSliceFromTerraformTypeList(d.Get("steps"))))
+
+	tflog.Info(ctx, fmt.Sprintf("process steps order read (%s)", d.Id()))
+	return nil
+}
+
+func resourceProcessStepsOrderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateProcessStepsOrder(ctx, d, m, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("process steps order updated (%s)", d.Id()))
+	return nil
+}
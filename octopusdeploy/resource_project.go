@@ -7,17 +7,20 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	prj "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/projects"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceProject() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceProjectCreate,
+		CustomizeDiff: customdiff.All(validateAutoCreateRelease, validateProjectTemplates, validateReferences),
 		DeleteContext: resourceProjectDelete,
 		Description:   "This resource manages projects in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("project", findProjects),
 		ReadContext:   resourceProjectRead,
 		Schema:        getProjectSchema(),
 		UpdateContext: resourceProjectUpdate,
@@ -33,8 +36,17 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	tflog.Info(ctx, fmt.Sprintf("creating project (%s)", project.Name))
 
-	client := m.(*client.Client)
-	createdProject, err := client.Projects.Add(project)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var createdProject *projects.Project
+	if v, ok := d.GetOk("clone_from_project_id"); ok {
+		createdProject, err = cloneProject(ctx, d, client, v.(string), project)
+	} else {
+		createdProject, err = client.Projects.Add(project)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -55,6 +67,10 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 
+	if err := prj.LoadGitHubGitCredential(client, createdProject); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := setProject(ctx, d, createdProject); err != nil {
 		return diag.FromErr(err)
 	}
@@ -65,14 +81,71 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 	return nil
 }
 
+// cloneProject creates a project by cloning another one, which copies its deployment process, runbooks, channels and
+// variables. The clone is then updated with the configured settings, and settings that are not configured are kept
+// from the source project where Octopus has no default for them.
+func cloneProject(ctx context.Context, d *schema.ResourceData, client *client.Client, sourceProjectID string, project *projects.Project) (*projects.Project, error) {
+	tflog.Info(ctx, fmt.Sprintf("cloning project (%s)", sourceProjectID))
+
+	sourceProject, err := client.Projects.GetByID(sourceProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the project to clone (%s): %s", sourceProjectID, err)
+	}
+
+	clonedProject, err := client.Projects.Clone(sourceProject, projects.ProjectCloneRequest{
+		Description:    project.Description,
+		LifecycleID:    project.LifecycleID,
+		Name:           project.Name,
+		ProjectGroupID: project.ProjectGroupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	project.ID = clonedProject.GetID()
+	project.ClonedFromProjectID = clonedProject.ClonedFromProjectID
+	project.DeploymentProcessID = clonedProject.DeploymentProcessID
+	project.Links = clonedProject.Links
+	project.VariableSetID = clonedProject.VariableSetID
+
+	// version control is enabled after the project has been created, as for any other project
+	project.PersistenceSettings = clonedProject.PersistenceSettings
+
+	if _, ok := d.GetOk("included_library_variable_sets"); !ok {
+		project.IncludedLibraryVariableSets = clonedProject.IncludedLibraryVariableSets
+	}
+
+	if _, ok := d.GetOk("slug"); !ok {
+		project.Slug = clonedProject.Slug
+	}
+
+	if _, ok := d.GetOk("template"); !ok {
+		project.Templates = clonedProject.Templates
+	}
+
+	updatedProject, err := client.Projects.Update(project)
+	if err != nil {
+		client.Projects.DeleteByID(clonedProject.GetID())
+		return nil, err
+	}
+
+	return updatedProject, nil
+}
+
 func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("deleting project (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Projects.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
 
+	forgetProject(m, d.Id())
+
 	tflog.Info(ctx, fmt.Sprintf("project deleted (%s)", d.Id()))
 	d.SetId("")
 	return nil
@@ -81,12 +154,20 @@ func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m interf
 func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("reading project (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	project, err := client.Projects.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "project")
 	}
 
+	if err := prj.LoadGitHubGitCredential(client, project); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := setProject(ctx, d, project); err != nil {
 		return diag.FromErr(err)
 	}
@@ -98,16 +179,28 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("updating project (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	project := expandProject(ctx, d)
 	var updatedProject *projects.Project
-	var err error
+
+	// the library variable sets of the project may be included by octopusdeploy_project_library_variable_set, so they
+	// are read again while those resources cannot change them
+	projectLibraryVariableSetMutex.Lock()
+	defer projectLibraryVariableSetMutex.Unlock()
 
 	projectLinks, err := client.Projects.GetByID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if !d.HasChange("included_library_variable_sets") {
+		project.IncludedLibraryVariableSets = projectLinks.IncludedLibraryVariableSets
+	}
+
 	if project.PersistenceSettings != nil && project.PersistenceSettings.Type() == projects.PersistenceSettingsTypeVersionControlled {
 		convertToVcsLink := projectLinks.Links["ConvertToVcs"]
 
@@ -122,16 +215,27 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interf
 				return diag.FromErr(err)
 			}
 			project.PersistenceSettings = vcsProject.PersistenceSettings
+
+			// the converted project is saved again below, so it must keep its GitHub App connection
+			if err := prj.LoadGitHubGitCredential(client, vcsProject); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	}
 
 	project.Links = projectLinks.Links
 
+	// the project may have been converted to version control even if the update fails
 	updatedProject, err = client.Projects.Update(project)
+	forgetProject(m, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err := prj.LoadGitHubGitCredential(client, updatedProject); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := setProject(ctx, d, updatedProject); err != nil {
 		return diag.FromErr(err)
 	}
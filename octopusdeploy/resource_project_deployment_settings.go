@@ -0,0 +1,130 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceProjectDeploymentSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectDeploymentSettingsCreate,
+		CustomizeDiff: validateVersioningStrategy,
+		DeleteContext: resourceProjectDeploymentSettingsDelete,
+		Description:   "This resource manages the deployment settings of a project in Octopus Deploy, including projects whose settings are stored in version control.",
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProjectDeploymentSettingsImport,
+		},
+		ReadContext:   resourceProjectDeploymentSettingsRead,
+		Schema:        getProjectDeploymentSettingsSchema(),
+		UpdateContext: resourceProjectDeploymentSettingsUpdate,
+	}
+}
+
+// resourceProjectDeploymentSettingsImport accepts either a project ID or, for version-controlled projects, a project
+// ID and git reference separated by a colon (e.g. Projects-1:main)
+func resourceProjectDeploymentSettingsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	projectID, gitRef, _ := strings.Cut(d.Id(), ":")
+
+	d.SetId(projectID)
+	d.Set("project_id", projectID)
+	if len(gitRef) > 0 {
+		d.Set("git_ref", gitRef)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getProjectDeploymentSettings(d *schema.ResourceData, client *client.Client) (*deployments.DeploymentSettings, error) {
+	project, err := client.Projects.GetByID(d.Get("project_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Deployments.GetDeploymentSettings(project, d.Get("git_ref").(string))
+}
+
+func updateProjectDeploymentSettings(ctx context.Context, d *schema.ResourceData, client *client.Client) error {
+	deploymentSettings, err := getProjectDeploymentSettings(d, client)
+	if err != nil {
+		return err
+	}
+
+	deploymentSettings = expandProjectDeploymentSettings(d, deploymentSettings)
+
+	tflog.Info(ctx, fmt.Sprintf("updating deployment settings (%s)", deploymentSettings.ProjectID))
+
+	updatedDeploymentSettings, err := services.ApiUpdate(client.Deployments.GetClient(), deploymentSettings, new(deployments.DeploymentSettings), deploymentSettings.Links["Self"])
+	if err != nil {
+		return err
+	}
+
+	return setProjectDeploymentSettings(d, updatedDeploymentSettings.(*deployments.DeploymentSettings))
+}
+
+func resourceProjectDeploymentSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateProjectDeploymentSettings(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("project_id").(string))
+
+	tflog.Info(ctx, fmt.Sprintf("deployment settings created (%s)", d.Id()))
+	return nil
+}
+
+func resourceProjectDeploymentSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// deployment settings cannot be deleted; they exist for as long as the project does
+	tflog.Info(ctx, fmt.Sprintf("removing deployment settings from state (%s)", d.Id()))
+
+	d.SetId("")
+	return nil
+}
+
+func resourceProjectDeploymentSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading deployment settings (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentSettings, err := getProjectDeploymentSettings(d, client)
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "deployment settings")
+	}
+
+	if err := setProjectDeploymentSettings(d, deploymentSettings); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("deployment settings read (%s)", d.Id()))
+	return nil
+}
+
+func resourceProjectDeploymentSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateProjectDeploymentSettings(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("deployment settings updated (%s)", d.Id()))
+	return nil
+}
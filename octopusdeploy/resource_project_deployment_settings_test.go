@@ -0,0 +1,69 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/test"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProjectDeploymentSettingsBasic(t *testing.T) {
+	lifecycleTestOptions := test.NewLifecycleTestOptions()
+	projectGroupTestOptions := test.NewProjectGroupTestOptions()
+	projectTestOptions := test.NewProjectTestOptions(lifecycleTestOptions, projectGroupTestOptions)
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	resourceName := "octopusdeploy_project_deployment_settings." + localName
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccProjectCheckDestroy,
+			testAccProjectGroupCheckDestroy,
+			testAccLifecycleCheckDestroy,
+		),
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectCheckExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", projectTestOptions.QualifiedName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "connectivity_policy.0.exclude_unhealthy_targets", "true"),
+					resource.TestCheckResourceAttr(resourceName, "connectivity_policy.0.skip_machine_behavior", "SkipUnavailableMachines"),
+					resource.TestCheckResourceAttr(resourceName, "default_guided_failure_mode", "On"),
+					resource.TestCheckResourceAttr(resourceName, "release_notes_template", "Release #{Octopus.Release.Number}"),
+					resource.TestCheckResourceAttr(resourceName, "versioning_strategy.#", "1"),
+				),
+				Config: test.GetConfiguration([]string{
+					test.LifecycleConfiguration(lifecycleTestOptions),
+					test.ProjectGroupConfiguration(projectGroupTestOptions),
+					test.ProjectConfiguration(projectTestOptions),
+					testAccProjectDeploymentSettings(localName, projectTestOptions.QualifiedName),
+				}),
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccProjectDeploymentSettings(localName string, projectQualifiedName string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_project_deployment_settings" "%s" {
+		default_guided_failure_mode = "On"
+		project_id                  = %s.id
+		release_notes_template      = "Release #{Octopus.Release.Number}"
+
+		connectivity_policy {
+			exclude_unhealthy_targets = true
+			skip_machine_behavior     = "SkipUnavailableMachines"
+		}
+
+		versioning_strategy {
+			template = "#{Octopus.Version.LastMajor}.#{Octopus.Version.LastMinor}.#{Octopus.Version.NextPatch}"
+		}
+	}`, localName, projectQualifiedName)
+}
@@ -5,10 +5,9 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actions"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/filters"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/triggers"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	trg "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/triggers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -20,17 +19,29 @@ func resourceProjectDeploymentTargetTrigger() *schema.Resource {
 		Importer:      getImporter(),
 		ReadContext:   resourceProjectDeploymentTargetTriggerRead,
 		Schema:        getProjectDeploymentTargetTriggerSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getProjectDeploymentTargetTriggerSchema(), "environment_ids", "roles", "tenant_tags", "run_runbook_action.environment_ids", "run_runbook_action.tenant_ids", "run_runbook_action.tenant_tags"),
+		},
 		UpdateContext: resourceProjectDeploymentTargetTriggerUpdate,
 	}
 }
 
-func buildProjectDeploymentTargetTriggerResource(d *schema.ResourceData, client *client.Client) (*triggers.ProjectTrigger, error) {
+func buildProjectDeploymentTargetTriggerResource(d *schema.ResourceData, client *client.Client) (*trg.DeploymentTargetTrigger, error) {
 	name := d.Get("name").(string)
 	projectID := d.Get("project_id").(string)
 	shouldRedeploy := d.Get("should_redeploy").(bool)
 
-	action := actions.NewAutoDeployAction(shouldRedeploy)
-	filter := filters.NewDeploymentTargetFilter([]string{}, []string{}, []string{}, []string{})
+	action := trg.DeploymentTargetTriggerAction{
+		ActionType:     trg.AutoDeployActionType,
+		ShouldRedeploy: shouldRedeploy,
+	}
+
+	if v, ok := d.GetOk("run_runbook_action"); ok {
+		action = expandDeploymentTargetTriggerRunRunbookAction(v.([]interface{}))
+	}
+
+	filter := trg.DeploymentTargetTriggerFilter{}
 
 	if attr, ok := d.GetOk("event_groups"); ok {
 		eventGroups := getSliceFromTerraformTypeList(attr)
@@ -79,7 +90,11 @@ func buildProjectDeploymentTargetTriggerResource(d *schema.ResourceData, client
 	}
 
 	if attr, ok := d.GetOk("environment_ids"); ok {
-		filter.Environments = getSliceFromTerraformTypeList(attr)
+		filter.EnvironmentIDs = getSliceFromTerraformTypeList(attr)
+	}
+
+	if attr, ok := d.GetOk("tenant_tags"); ok {
+		filter.TenantTags = getSliceFromTerraformTypeList(attr)
 	}
 
 	project, err := client.Projects.GetByID(projectID)
@@ -87,20 +102,23 @@ func buildProjectDeploymentTargetTriggerResource(d *schema.ResourceData, client
 		return nil, err
 	}
 
-	deploymentTargetTrigger := triggers.NewProjectTrigger(name, "", false, project, action, filter)
+	deploymentTargetTrigger := trg.NewDeploymentTargetTrigger(name, project.GetID(), project.SpaceID, action, filter)
 
 	return deploymentTargetTrigger, nil
 }
 
 func resourceProjectDeploymentTargetTriggerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	projectTrigger, err := buildProjectDeploymentTargetTriggerResource(d, client)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	resource, err := client.ProjectTriggers.Add(projectTrigger)
+	resource, err := trg.AddDeploymentTargetTrigger(client, projectTrigger)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -117,40 +135,52 @@ func resourceProjectDeploymentTargetTriggerCreate(ctx context.Context, d *schema
 func resourceProjectDeploymentTargetTriggerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 
-	client := m.(*client.Client)
-	resource, err := client.ProjectTriggers.GetByID(id)
+	client, err := getSpaceClient(m, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	resource, err := trg.GetDeploymentTargetTrigger(client, id)
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "project deployment target trigger")
+	}
 	if resource == nil {
-		d.SetId("")
-		return nil
+		return errors.DeleteFromState(ctx, d, "project deployment target trigger")
 	}
 
 	logResource("project_trigger", m)
 
-	action := resource.Action.(*actions.AutoDeployAction)
-	filter := resource.Filter.(*filters.DeploymentTargetFilter)
+	filter := resource.Filter
 
-	d.Set("environment_ids", filter.Environments)
+	d.Set("environment_ids", filter.EnvironmentIDs)
 	d.Set("event_groups", filter.EventGroups)
 	d.Set("event_categories", filter.EventCategories)
 	d.Set("name", resource.Name)
+	d.Set("project_id", resource.ProjectID)
 	d.Set("roles", filter.Roles)
-	d.Set("should_redeploy", action.ShouldRedeploy)
+	d.Set("should_redeploy", resource.Action.ShouldRedeploy)
+	d.Set("tenant_tags", filter.TenantTags)
+
+	if err := d.Set("run_runbook_action", flattenDeploymentTargetTriggerRunRunbookAction(resource.Action)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting run_runbook_action: %s", err))
+	}
 
 	return nil
 }
 
 func resourceProjectDeploymentTargetTriggerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	projectTrigger, err := buildProjectDeploymentTargetTriggerResource(d, client)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	projectTrigger.ID = d.Id() // set ID so Octopus API knows which project trigger to update
 
-	resource, err := client.ProjectTriggers.Update(projectTrigger)
+	resource, err := trg.UpdateDeploymentTargetTrigger(client, projectTrigger)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -161,8 +191,12 @@ func resourceProjectDeploymentTargetTriggerUpdate(ctx context.Context, d *schema
 }
 
 func resourceProjectDeploymentTargetTriggerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
-	err := client.ProjectTriggers.DeleteByID(d.Id())
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.ProjectTriggers.DeleteByID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
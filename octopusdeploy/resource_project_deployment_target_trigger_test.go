@@ -137,7 +137,7 @@ package octopusdeploy
 // 			return fmt.Errorf("Not found: %s", resourceName)
 // 		}
 
-// 		client := testAccProvider.Meta().(*client.Client)
+// 		client := getClient(testAccProvider.Meta())
 // 		resource, err := client.ProjectTriggers.GetByID(rs.Primary.ID)
 // 		if err != nil {
 // 			return err
@@ -149,7 +149,7 @@ package octopusdeploy
 // }
 
 // func testAccProjectDeploymentTriggerCheckDestroy(s *terraform.State) error {
-// 	client := testAccProvider.Meta().(*client.Client)
+// 	client := getClient(testAccProvider.Meta())
 // 	for _, rs := range s.RootModule().Resources {
 // 		if rs.Type != "octopusdeploy_project_deployment_target_trigger" {
 // 			continue
@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +14,7 @@ func resourceProjectGroup() *schema.Resource {
 		CreateContext: resourceProjectGroupCreate,
 		DeleteContext: resourceProjectGroupDelete,
 		Description:   "This resource manages project groups in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("project group", findProjectGroups),
 		ReadContext:   resourceProjectGroupRead,
 		Schema:        getProjectGroupSchema(),
 		UpdateContext: resourceProjectGroupUpdate,
@@ -27,7 +26,11 @@ func resourceProjectGroupCreate(ctx context.Context, d *schema.ResourceData, m i
 
 	log.Printf("[INFO] creating project group: %#v", projectGroup)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdProjectGroup, err := client.ProjectGroups.Add(projectGroup)
 	if err != nil {
 		return diag.FromErr(err)
@@ -46,7 +49,11 @@ func resourceProjectGroupCreate(ctx context.Context, d *schema.ResourceData, m i
 func resourceProjectGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting project group (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.ProjectGroups.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -59,7 +66,11 @@ func resourceProjectGroupDelete(ctx context.Context, d *schema.ResourceData, m i
 func resourceProjectGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading project group (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	projectGroup, err := client.ProjectGroups.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "project group")
@@ -77,7 +88,11 @@ func resourceProjectGroupUpdate(ctx context.Context, d *schema.ResourceData, m i
 	log.Printf("[INFO] updating project group (%s)", d.Id())
 
 	projectGroup := expandProjectGroup(d)
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedProjectGroup, err := client.ProjectGroups.Update(*projectGroup)
 	if err != nil {
 		return diag.FromErr(err)
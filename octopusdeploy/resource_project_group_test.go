@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/test"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -37,7 +36,7 @@ func testAccProjectGroup(localName string, name string) string {
 }
 
 func testProjectGroupDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*client.Client)
+	client := getClient(testAccProvider.Meta())
 	for _, rs := range s.RootModule().Resources {
 		projectGroupID := rs.Primary.ID
 		projectGroup, err := client.ProjectGroups.GetByID(projectGroupID)
@@ -58,7 +57,7 @@ func testProjectGroupExists(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		if _, err := client.ProjectGroups.GetByID(rs.Primary.ID); err != nil {
 			return err
 		}
@@ -68,7 +67,7 @@ func testProjectGroupExists(resourceName string) resource.TestCheckFunc {
 }
 
 func testAccProjectGroupCheckDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*client.Client)
+	client := getClient(testAccProvider.Meta())
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "octopusdeploy_project_group" {
 			continue
@@ -0,0 +1,148 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	prj "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/projects"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// projectLibraryVariableSetMutex serializes changes to the library variable sets of projects so that resources
+// sharing a project do not overwrite each other's changes.
+var projectLibraryVariableSetMutex = &sync.Mutex{}
+
+func resourceProjectLibraryVariableSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectLibraryVariableSetCreate,
+		DeleteContext: resourceProjectLibraryVariableSetDelete,
+		Description:   "This resource includes a library variable set in a project in Octopus Deploy, so that shared variable sets can be managed separately from the project that uses them. The library variable sets of a project that are not managed by this resource are left unchanged. This resource must not be combined with the `included_library_variable_sets` argument of `octopusdeploy_project` for the same project.",
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProjectLibraryVariableSetImport,
+		},
+		ReadContext: resourceProjectLibraryVariableSetRead,
+		Schema:      getProjectLibraryVariableSetSchema(),
+	}
+}
+
+// resourceProjectLibraryVariableSetImport accepts a project ID and library variable set ID separated by a colon (e.g.
+// Projects-1:LibraryVariableSets-1)
+func resourceProjectLibraryVariableSetImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	projectID, libraryVariableSetID, ok := strings.Cut(d.Id(), ":")
+	if !ok || len(projectID) == 0 || len(libraryVariableSetID) == 0 {
+		return nil, fmt.Errorf("octopusdeploy_project_library_variable_set import must be in the form of ProjectID:LibraryVariableSetID (e.g. Projects-1:LibraryVariableSets-1)")
+	}
+
+	d.Set("library_variable_set_id", libraryVariableSetID)
+	d.Set("project_id", projectID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getProjectForLibraryVariableSet returns the project of the resource. The GitHub App connection of a
+// version-controlled project is loaded as well, so that it is kept when the project is saved.
+func getProjectForLibraryVariableSet(d *schema.ResourceData, client *client.Client) (*projects.Project, error) {
+	project, err := client.Projects.GetByID(d.Get("project_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := prj.LoadGitHubGitCredential(client, project); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+func resourceProjectLibraryVariableSetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	projectLibraryVariableSetMutex.Lock()
+	defer projectLibraryVariableSetMutex.Unlock()
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := getProjectForLibraryVariableSet(d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	libraryVariableSetID := d.Get("library_variable_set_id").(string)
+	if validateStringInSlice(libraryVariableSetID, project.IncludedLibraryVariableSets) {
+		return diag.Errorf("project %s already includes the library variable set %s; import it with the ID %s:%s to manage it", project.GetID(), libraryVariableSetID, project.GetID(), libraryVariableSetID)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("including library variable set (%s) in project (%s)", libraryVariableSetID, project.GetID()))
+
+	project.IncludedLibraryVariableSets = append(project.IncludedLibraryVariableSets, libraryVariableSetID)
+	_, err = client.Projects.Update(project)
+	forgetProject(m, project.GetID())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(project.GetID() + ":" + libraryVariableSetID)
+
+	tflog.Info(ctx, fmt.Sprintf("project library variable set created (%s)", d.Id()))
+	return nil
+}
+
+func resourceProjectLibraryVariableSetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	projectLibraryVariableSetMutex.Lock()
+	defer projectLibraryVariableSetMutex.Unlock()
+
+	tflog.Info(ctx, fmt.Sprintf("deleting project library variable set (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := getProjectForLibraryVariableSet(d, client)
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "project library variable set")
+	}
+
+	libraryVariableSetID := d.Get("library_variable_set_id").(string)
+	if validateStringInSlice(libraryVariableSetID, project.IncludedLibraryVariableSets) {
+		project.IncludedLibraryVariableSets = removeLibraryVariableSet(project.IncludedLibraryVariableSets, libraryVariableSetID)
+		_, err = client.Projects.Update(project)
+		forgetProject(m, project.GetID())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	tflog.Info(ctx, "project library variable set deleted")
+	return nil
+}
+
+func resourceProjectLibraryVariableSetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading project library variable set (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := client.Projects.GetByID(d.Get("project_id").(string))
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "project library variable set")
+	}
+
+	if !validateStringInSlice(d.Get("library_variable_set_id").(string), project.IncludedLibraryVariableSets) {
+		return errors.DeleteFromState(ctx, d, "project library variable set")
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("project library variable set read (%s)", d.Id()))
+	return nil
+}
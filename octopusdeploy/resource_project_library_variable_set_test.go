@@ -0,0 +1,196 @@
+package octopusdeploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectLibraryVariableSet(t *testing.T) {
+	project := map[string]interface{}{
+		"Id":                            "Projects-1",
+		"SpaceId":                       "Spaces-1",
+		"Name":                          "Web",
+		"LifecycleId":                   "Lifecycles-1",
+		"ProjectGroupId":                "ProjectGroups-1",
+		"IncludedLibraryVariableSetIds": []interface{}{"LibraryVariableSets-1"},
+		"Links":                         map[string]interface{}{},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api":
+			w.Write([]byte(`{"Links":{}}`))
+		case r.URL.Path == "/api/Spaces-1":
+			w.Write([]byte(`{"Id":"Spaces-1","Links":{"Projects":"/api/Spaces-1/projects{/id}{?name,skip,ids,clone,take,partialName,clonedFromProjectId}"}}`))
+		case r.URL.Path == "/api/Spaces-1/projects/Projects-1" && r.Method == http.MethodPut:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&project))
+			json.NewEncoder(w).Encode(project)
+		case r.URL.Path == "/api/Spaces-1/projects/Projects-1":
+			json.NewEncoder(w).Encode(project)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"ErrorMessage":"Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+	meta := newProviderMeta(octopus)
+
+	d := resourceProjectLibraryVariableSet().TestResourceData()
+	d.Set("library_variable_set_id", "LibraryVariableSets-2")
+	d.Set("project_id", "Projects-1")
+
+	// the library variable set is added to those that the project already includes
+	require.False(t, resourceProjectLibraryVariableSetCreate(context.Background(), d, meta).HasError())
+	require.Equal(t, "Projects-1:LibraryVariableSets-2", d.Id())
+	require.Equal(t, []interface{}{"LibraryVariableSets-1", "LibraryVariableSets-2"}, project["IncludedLibraryVariableSetIds"])
+
+	require.False(t, resourceProjectLibraryVariableSetRead(context.Background(), d, meta).HasError())
+	require.Equal(t, "Projects-1:LibraryVariableSets-2", d.Id())
+
+	// the project keeps the library variable set when it is updated with state read before it was included
+	projectData := resourceProject().Data(&terraform.InstanceState{
+		ID: "Projects-1",
+		Attributes: map[string]string{
+			"included_library_variable_sets.#": "1",
+			"included_library_variable_sets.0": "LibraryVariableSets-1",
+			"lifecycle_id":                     "Lifecycles-1",
+			"name":                             "Web",
+			"project_group_id":                 "ProjectGroups-1",
+		},
+	})
+	projectData.Set("description", "Deploys the web site")
+	require.False(t, resourceProjectUpdate(context.Background(), projectData, meta).HasError())
+	require.Equal(t, "Deploys the web site", project["Description"])
+	require.Equal(t, []interface{}{"LibraryVariableSets-1", "LibraryVariableSets-2"}, project["IncludedLibraryVariableSetIds"])
+
+	// a library variable set that is already included must be imported rather than created
+	existing := resourceProjectLibraryVariableSet().TestResourceData()
+	existing.Set("library_variable_set_id", "LibraryVariableSets-1")
+	existing.Set("project_id", "Projects-1")
+
+	diags := resourceProjectLibraryVariableSetCreate(context.Background(), existing, meta)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "already includes the library variable set LibraryVariableSets-1")
+
+	// only the library variable set of the resource is removed from the project
+	require.False(t, resourceProjectLibraryVariableSetDelete(context.Background(), d, meta).HasError())
+	require.Empty(t, d.Id())
+	require.Equal(t, []interface{}{"LibraryVariableSets-1"}, project["IncludedLibraryVariableSetIds"])
+
+	// a library variable set that is removed outside of Terraform is removed from state
+	d.SetId("Projects-1:LibraryVariableSets-2")
+	require.False(t, resourceProjectLibraryVariableSetRead(context.Background(), d, meta).HasError())
+	require.Empty(t, d.Id())
+}
+
+func TestProjectLibraryVariableSetImport(t *testing.T) {
+	d := resourceProjectLibraryVariableSet().TestResourceData()
+	d.SetId("Projects-1:LibraryVariableSets-2")
+
+	imported, err := resourceProjectLibraryVariableSetImport(context.Background(), d, nil)
+	require.NoError(t, err)
+	require.Equal(t, "Projects-1", imported[0].Get("project_id"))
+	require.Equal(t, "LibraryVariableSets-2", imported[0].Get("library_variable_set_id"))
+
+	d.SetId("Projects-1")
+	_, err = resourceProjectLibraryVariableSetImport(context.Background(), d, nil)
+	require.Error(t, err)
+}
+
+func TestAccProjectLibraryVariableSetWithProject(t *testing.T) {
+	lifecycleLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectGroupName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	libraryVariableSetLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	libraryVariableSetName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	projectPrefix := "octopusdeploy_project." + localName
+	prefix := "octopusdeploy_project_library_variable_set." + localName
+
+	description := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	newDescription := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccProjectCheckDestroy,
+			testLibraryVariableSetDestroy,
+			testAccLifecycleCheckDestroy,
+		),
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectLibraryVariableSetCheckIncluded(prefix),
+					resource.TestCheckResourceAttr(projectPrefix, "description", description),
+				),
+				Config: testAccProjectLibraryVariableSetWithProject(lifecycleLocalName, lifecycleName, projectGroupLocalName, projectGroupName, libraryVariableSetLocalName, libraryVariableSetName, localName, name, description),
+			},
+			// updating the project keeps the library variable set that it includes through the other resource
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectLibraryVariableSetCheckIncluded(prefix),
+					resource.TestCheckResourceAttr(projectPrefix, "description", newDescription),
+					resource.TestCheckResourceAttr(projectPrefix, "included_library_variable_sets.#", "1"),
+					resource.TestCheckResourceAttrPair(projectPrefix, "included_library_variable_sets.0", "octopusdeploy_library_variable_set."+libraryVariableSetLocalName, "id"),
+				),
+				Config: testAccProjectLibraryVariableSetWithProject(lifecycleLocalName, lifecycleName, projectGroupLocalName, projectGroupName, libraryVariableSetLocalName, libraryVariableSetName, localName, name, newDescription),
+			},
+		},
+	})
+}
+
+func testAccProjectLibraryVariableSetWithProject(lifecycleLocalName string, lifecycleName string, projectGroupLocalName string, projectGroupName string, libraryVariableSetLocalName string, libraryVariableSetName string, localName string, name string, description string) string {
+	return fmt.Sprintf(testAccLifecycle(lifecycleLocalName, lifecycleName)+"\n"+
+		testAccProjectGroup(projectGroupLocalName, projectGroupName)+"\n"+
+		testLibraryVariableSetBasic(libraryVariableSetLocalName, libraryVariableSetName)+"\n"+
+		`resource "octopusdeploy_project" "%s" {
+			description      = "%s"
+			lifecycle_id     = octopusdeploy_lifecycle.%s.id
+			name             = "%s"
+			project_group_id = octopusdeploy_project_group.%s.id
+		}
+
+		resource "octopusdeploy_project_library_variable_set" "%s" {
+			library_variable_set_id = octopusdeploy_library_variable_set.%s.id
+			project_id              = octopusdeploy_project.%s.id
+		}`, localName, description, lifecycleLocalName, name, projectGroupLocalName, localName, libraryVariableSetLocalName, localName)
+}
+
+func testAccProjectLibraryVariableSetCheckIncluded(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[prefix]
+		if !ok {
+			return fmt.Errorf("not found: %s", prefix)
+		}
+
+		client := getClient(testAccProvider.Meta())
+		project, err := client.Projects.GetByID(rs.Primary.Attributes["project_id"])
+		if err != nil {
+			return err
+		}
+
+		libraryVariableSetID := rs.Primary.Attributes["library_variable_set_id"]
+		if !validateStringInSlice(libraryVariableSetID, project.IncludedLibraryVariableSets) {
+			return fmt.Errorf("project (%s) does not include the library variable set (%s)", project.GetID(), libraryVariableSetID)
+		}
+		return nil
+	}
+}
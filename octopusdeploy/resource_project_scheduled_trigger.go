@@ -0,0 +1,125 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceProjectScheduledTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectScheduledTriggerCreate,
+		DeleteContext: resourceProjectScheduledTriggerDelete,
+		Description:   "This resource manages a scheduled trigger of a project in Octopus Deploy, which deploys or promotes a release on a schedule.",
+		Importer:      getImporter(),
+		ReadContext:   resourceProjectScheduledTriggerRead,
+		Schema:        getProjectScheduledTriggerSchema(),
+		UpdateContext: resourceProjectScheduledTriggerUpdate,
+	}
+}
+
+func resourceProjectScheduledTriggerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := client.Projects.GetByID(d.Get("project_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectTrigger, err := expandProjectScheduledTrigger(d, project)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("creating project scheduled trigger (%s)", projectTrigger.Name))
+
+	createdProjectTrigger, err := client.ProjectTriggers.Add(projectTrigger)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setProjectScheduledTrigger(d, createdProjectTrigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdProjectTrigger.GetID())
+
+	tflog.Info(ctx, fmt.Sprintf("project scheduled trigger created (%s)", d.Id()))
+	return nil
+}
+
+func resourceProjectScheduledTriggerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("deleting project scheduled trigger (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.ProjectTriggers.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	tflog.Info(ctx, "project scheduled trigger deleted")
+	return nil
+}
+
+func resourceProjectScheduledTriggerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading project scheduled trigger (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectTrigger, err := client.ProjectTriggers.GetByID(d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "project scheduled trigger")
+	}
+
+	if err := setProjectScheduledTrigger(d, projectTrigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("project scheduled trigger read (%s)", d.Id()))
+	return nil
+}
+
+func resourceProjectScheduledTriggerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("updating project scheduled trigger (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := client.Projects.GetByID(d.Get("project_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectTrigger, err := expandProjectScheduledTrigger(d, project)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updatedProjectTrigger, err := client.ProjectTriggers.Update(projectTrigger)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setProjectScheduledTrigger(d, updatedProjectTrigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("project scheduled trigger updated (%s)", d.Id()))
+	return nil
+}
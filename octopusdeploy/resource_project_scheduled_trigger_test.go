@@ -0,0 +1,114 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/test"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccProjectScheduledTriggerBasic(t *testing.T) {
+	lifecycleTestOptions := test.NewLifecycleTestOptions()
+	projectGroupTestOptions := test.NewProjectGroupTestOptions()
+	projectTestOptions := test.NewProjectTestOptions(lifecycleTestOptions, projectGroupTestOptions)
+	environmentLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	environmentName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_project_scheduled_trigger." + localName
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccProjectScheduledTriggerCheckDestroy,
+			testAccEnvironmentCheckDestroy,
+			testAccProjectCheckDestroy,
+			testAccProjectGroupCheckDestroy,
+			testAccLifecycleCheckDestroy,
+		),
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(prefix, "id"),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "timezone", "UTC"),
+					resource.TestCheckResourceAttr(prefix, "once_daily_schedule.0.start_time", "2024-01-01T09:00:00"),
+					resource.TestCheckResourceAttr(prefix, "once_daily_schedule.0.days_of_week.#", "2"),
+					resource.TestCheckResourceAttrPair(prefix, "deploy_new_release_action.0.destination_environment_id", "octopusdeploy_environment."+environmentLocalName, "id"),
+				),
+				Config: test.GetConfiguration([]string{
+					test.LifecycleConfiguration(lifecycleTestOptions),
+					test.ProjectGroupConfiguration(projectGroupTestOptions),
+					test.ProjectConfiguration(projectTestOptions),
+					testAccEnvironment(environmentLocalName, environmentName, "", false, 0, false),
+					testAccProjectScheduledTriggerOnceDaily(localName, name, projectTestOptions.QualifiedName, environmentLocalName),
+				}),
+			},
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(prefix, "cron_expression_schedule.0.cron_expression", "0 0 9 * * Mon-Fri"),
+					resource.TestCheckResourceAttr(prefix, "once_daily_schedule.#", "0"),
+					resource.TestCheckResourceAttr(prefix, "timezone", "AUS Eastern Standard Time"),
+				),
+				Config: test.GetConfiguration([]string{
+					test.LifecycleConfiguration(lifecycleTestOptions),
+					test.ProjectGroupConfiguration(projectGroupTestOptions),
+					test.ProjectConfiguration(projectTestOptions),
+					testAccEnvironment(environmentLocalName, environmentName, "", false, 0, false),
+					testAccProjectScheduledTriggerCron(localName, name, projectTestOptions.QualifiedName, environmentLocalName),
+				}),
+			},
+		},
+	})
+}
+
+func testAccProjectScheduledTriggerOnceDaily(localName string, name string, projectQualifiedName string, environmentLocalName string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_project_scheduled_trigger" "%s" {
+		name       = "%s"
+		project_id = %s.id
+
+		once_daily_schedule {
+			days_of_week = ["Monday", "Friday"]
+			start_time   = "2024-01-01T09:00:00"
+		}
+
+		deploy_new_release_action {
+			destination_environment_id = octopusdeploy_environment.%s.id
+		}
+	}`, localName, name, projectQualifiedName, environmentLocalName)
+}
+
+func testAccProjectScheduledTriggerCron(localName string, name string, projectQualifiedName string, environmentLocalName string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_project_scheduled_trigger" "%s" {
+		name       = "%s"
+		project_id = %s.id
+		timezone   = "AUS Eastern Standard Time"
+
+		cron_expression_schedule {
+			cron_expression = "0 0 9 * * Mon-Fri"
+		}
+
+		deploy_new_release_action {
+			destination_environment_id = octopusdeploy_environment.%s.id
+		}
+	}`, localName, name, projectQualifiedName, environmentLocalName)
+}
+
+func testAccProjectScheduledTriggerCheckDestroy(s *terraform.State) error {
+	client := getClient(testAccProvider.Meta())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "octopusdeploy_project_scheduled_trigger" {
+			continue
+		}
+
+		if projectTrigger, err := client.ProjectTriggers.GetByID(rs.Primary.ID); err == nil {
+			return fmt.Errorf("project scheduled trigger (%s) still exists", projectTrigger.GetID())
+		}
+	}
+
+	return nil
+}
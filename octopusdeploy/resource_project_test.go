@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/test"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -215,7 +214,7 @@ func testAccProjectCaC(spaceID string, lifecycleLocalName string, lifecycleName
 }
 
 func testAccProjectCheckDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*client.Client)
+	client := getClient(testAccProvider.Meta())
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "octopusdeploy_project" {
 			continue
@@ -231,7 +230,7 @@ func testAccProjectCheckDestroy(s *terraform.State) error {
 
 func testAccProjectCheckExists() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 
 		for _, r := range s.RootModule().Resources {
 			if r.Type == "octopusdeploy_project" {
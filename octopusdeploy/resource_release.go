@@ -0,0 +1,116 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/releases"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRelease() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceReleaseCreate,
+		DeleteContext: resourceReleaseDelete,
+		Description:   "This resource manages a release of a project in Octopus Deploy. Only the release notes of a release can be changed; changing anything else creates a new release.",
+		Importer:      getImporter(),
+		ReadContext:   resourceReleaseRead,
+		Schema:        getReleaseSchema(),
+		UpdateContext: resourceReleaseUpdate,
+	}
+}
+
+func resourceReleaseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	release := expandRelease(d)
+
+	tflog.Info(ctx, fmt.Sprintf("creating release (%s)", release.Version))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createdRelease, err := client.Releases.Add(release)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setRelease(d, createdRelease); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdRelease.GetID())
+
+	tflog.Info(ctx, fmt.Sprintf("release created (%s)", d.Id()))
+	return nil
+}
+
+func resourceReleaseDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("deleting release (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.Releases.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	tflog.Info(ctx, "release deleted")
+	return nil
+}
+
+func resourceReleaseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading release (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	release, err := client.Releases.GetByID(d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "release")
+	}
+
+	if err := setRelease(d, release); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("release read (%s)", d.Id()))
+	return nil
+}
+
+func resourceReleaseUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("updating release (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	release, err := client.Releases.GetByID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the release service cannot update releases, so the release is saved through its own link
+	release.ReleaseNotes = d.Get("release_notes").(string)
+	updatedRelease, err := services.ApiUpdate(client.Releases.GetClient(), release, new(releases.Release), release.Links["Self"])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setRelease(d, updatedRelease.(*releases.Release)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("release updated (%s)", d.Id()))
+	return nil
+}
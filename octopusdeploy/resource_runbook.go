@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbooks"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -20,6 +19,10 @@ func resourceRunbook() *schema.Resource {
 		Importer:      getImporter(),
 		ReadContext:   resourceRunbookRead,
 		Schema:        getRunbookSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getRunbookSchema(), "environments"),
+		},
 		UpdateContext: resourceRunbookUpdate,
 	}
 }
@@ -29,7 +32,11 @@ func resourceRunbookCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	tflog.Info(ctx, fmt.Sprintf("creating runbook (%s)", runbook.Name))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdRunbook, err := client.Runbooks.Add(runbook)
 	if err != nil {
 		return diag.FromErr(err)
@@ -48,7 +55,11 @@ func resourceRunbookCreate(ctx context.Context, d *schema.ResourceData, m interf
 func resourceRunbookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("deleting runbook (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Runbooks.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -61,7 +72,11 @@ func resourceRunbookDelete(ctx context.Context, d *schema.ResourceData, m interf
 func resourceRunbookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("reading runbook (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	runbook, err := client.Runbooks.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "runbook")
@@ -78,10 +93,13 @@ func resourceRunbookRead(ctx context.Context, d *schema.ResourceData, m interfac
 func resourceRunbookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("updating runbook (%s)", d.Id()))
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	runbook := expandRunbook(ctx, d)
 	var updatedRunbook *runbooks.Runbook
-	var err error
 
 	runbookLinks, err := client.Runbooks.GetByID(d.Id())
 	if err != nil {
@@ -4,21 +4,26 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbooks"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceRunbookProcess() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceRunbookProcessCreate,
+		CustomizeDiff: customdiff.All(validateActionProperties, validateReferences),
 		DeleteContext: resourceRunbookProcessDelete,
 		Description:   "This resource manages runbook processes in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceRunbookProcessRead,
 		Schema:        getRunbookProcessSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getRunbookProcessSchema(), "step.*.channels", "step.*.environments", "step.*.excluded_environments", "step.*.tenant_tags"),
+		},
 		UpdateContext: resourceRunbookProcessUpdate,
 	}
 }
@@ -56,7 +61,11 @@ func getRunbookProcessSchema() map[string]*schema.Schema {
 // resourceRunbookProcessCreate "creates" a new runbook deployment process. In reality every runbook has a deployment process
 // already, so this function retrieves the existing process and updates it.
 func resourceRunbookProcessCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	runbookProcess := expandRunbookProcess(ctx, d, client)
 
 	log.Printf("[INFO] creating runbook process: %#v", runbookProcess)
@@ -66,14 +75,20 @@ func resourceRunbookProcessCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	var current *runbooks.RunbookProcess
-	current, err = client.RunbookProcesses.GetByID(runbook.RunbookProcessID)
+	var createdRunbookProcess *runbooks.RunbookProcess
+	err = updateProcess(ctx, getProcessUpdateAttempts(m), func() error {
+		current, err := client.RunbookProcesses.GetByID(runbook.RunbookProcessID)
+		if err != nil {
+			return err
+		}
 
-	runbookProcess.ID = current.ID
-	runbookProcess.Links = current.Links
-	runbookProcess.Version = current.Version
+		runbookProcess.ID = current.ID
+		runbookProcess.Links = current.Links
+		runbookProcess.Version = current.Version
 
-	createdRunbookProcess, err := client.RunbookProcesses.Update(runbookProcess)
+		createdRunbookProcess, err = client.RunbookProcesses.Update(runbookProcess)
+		return getProcessUpdateError(client, current.Links["Self"], getRunbookProcessVersion(current), err)
+	})
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -95,20 +110,26 @@ func resourceRunbookProcessDelete(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[INFO] deleting runbook process (%s)", d.Id())
 
 	// "Deleting" a runbook process just means to clear it out
-	client := m.(*client.Client)
-	current, err := client.RunbookProcesses.GetByID(d.Id())
-
+	client, err := getSpaceClient(m, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	runbookProcess := &runbooks.RunbookProcess{
-		Version: current.Version,
-	}
-	runbookProcess.Links = current.Links
-	runbookProcess.ID = d.Id()
+	err = updateProcess(ctx, getProcessUpdateAttempts(m), func() error {
+		current, err := client.RunbookProcesses.GetByID(d.Id())
+		if err != nil {
+			return err
+		}
 
-	_, err = client.RunbookProcesses.Update(runbookProcess)
+		runbookProcess := &runbooks.RunbookProcess{
+			Version: current.Version,
+		}
+		runbookProcess.Links = current.Links
+		runbookProcess.ID = d.Id()
+
+		_, err = client.RunbookProcesses.Update(runbookProcess)
+		return getProcessUpdateError(client, current.Links["Self"], getRunbookProcessVersion(current), err)
+	})
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -122,7 +143,11 @@ func resourceRunbookProcessDelete(ctx context.Context, d *schema.ResourceData, m
 func resourceRunbookProcessRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading runbook process (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	runbookProcess, err := client.RunbookProcesses.GetByID(d.Id())
 
 	if err != nil {
@@ -140,18 +165,26 @@ func resourceRunbookProcessRead(ctx context.Context, d *schema.ResourceData, m i
 func resourceRunbookProcessUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] updating runbook process (%s)", d.Id())
 
-	client := m.(*client.Client)
-	runbookProcess := expandRunbookProcess(ctx, d, client)
-	current, err := client.RunbookProcesses.GetByID(d.Id())
-
+	client, err := getSpaceClient(m, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	runbookProcess.Links = current.Links
-	runbookProcess.Version = current.Version
+	runbookProcess := expandRunbookProcess(ctx, d, client)
+
+	var updatedRunbookProcess *runbooks.RunbookProcess
+	err = updateProcess(ctx, getProcessUpdateAttempts(m), func() error {
+		current, err := client.RunbookProcesses.GetByID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		runbookProcess.Links = current.Links
+		runbookProcess.Version = current.Version
 
-	updatedRunbookProcess, err := client.RunbookProcesses.Update(runbookProcess)
+		updatedRunbookProcess, err = client.RunbookProcesses.Update(runbookProcess)
+		return getProcessUpdateError(client, current.Links["Self"], getRunbookProcessVersion(current), err)
+	})
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,125 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRunbookScheduledTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRunbookScheduledTriggerCreate,
+		DeleteContext: resourceRunbookScheduledTriggerDelete,
+		Description:   "This resource manages a scheduled trigger in Octopus Deploy that runs the published snapshot of a runbook on a schedule.",
+		Importer:      getImporter(),
+		ReadContext:   resourceRunbookScheduledTriggerRead,
+		Schema:        getRunbookScheduledTriggerSchema(),
+		UpdateContext: resourceRunbookScheduledTriggerUpdate,
+	}
+}
+
+func resourceRunbookScheduledTriggerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := client.Projects.GetByID(d.Get("project_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectTrigger, err := expandRunbookScheduledTrigger(d, project)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("creating runbook scheduled trigger (%s)", projectTrigger.Name))
+
+	createdProjectTrigger, err := client.ProjectTriggers.Add(projectTrigger)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setRunbookScheduledTrigger(d, createdProjectTrigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdProjectTrigger.GetID())
+
+	tflog.Info(ctx, fmt.Sprintf("runbook scheduled trigger created (%s)", d.Id()))
+	return nil
+}
+
+func resourceRunbookScheduledTriggerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("deleting runbook scheduled trigger (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.ProjectTriggers.DeleteByID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	tflog.Info(ctx, "runbook scheduled trigger deleted")
+	return nil
+}
+
+func resourceRunbookScheduledTriggerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading runbook scheduled trigger (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectTrigger, err := client.ProjectTriggers.GetByID(d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "runbook scheduled trigger")
+	}
+
+	if err := setRunbookScheduledTrigger(d, projectTrigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("runbook scheduled trigger read (%s)", d.Id()))
+	return nil
+}
+
+func resourceRunbookScheduledTriggerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("updating runbook scheduled trigger (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := client.Projects.GetByID(d.Get("project_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectTrigger, err := expandRunbookScheduledTrigger(d, project)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updatedProjectTrigger, err := client.ProjectTriggers.Update(projectTrigger)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setRunbookScheduledTrigger(d, updatedProjectTrigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("runbook scheduled trigger updated (%s)", d.Id()))
+	return nil
+}
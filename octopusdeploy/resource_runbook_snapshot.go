@@ -0,0 +1,157 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbooks"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceRunbookSnapshot() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRunbookSnapshotCreate,
+		DeleteContext: resourceRunbookSnapshotDelete,
+		Description:   "This resource takes a snapshot of a runbook in Octopus Deploy and, by default, publishes it. A new snapshot is taken when any argument other than `notes` changes, including `triggers`, so that changes to the runbook process can be published in the same apply. The published snapshot of a runbook is kept when this resource is destroyed; other snapshots are deleted.",
+		Importer:      getImporter(),
+		ReadContext:   resourceRunbookSnapshotRead,
+		Schema:        getRunbookSnapshotSchema(),
+		UpdateContext: resourceRunbookSnapshotUpdate,
+	}
+}
+
+func resourceRunbookSnapshotCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	runbook, err := client.Runbooks.GetByID(d.Get("runbook_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	template, err := client.Runbooks.GetRunbookSnapshotTemplate(runbook)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	runbookSnapshot, err := expandRunbookSnapshot(d, runbook, template)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("creating runbook snapshot (%s)", runbookSnapshot.Name))
+
+	createdRunbookSnapshot, err := client.RunbookSnapshots.Add(runbookSnapshot)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the ID is set before publishing so that a snapshot that fails to publish is kept in state and replaced on the
+	// next apply
+	d.SetId(createdRunbookSnapshot.GetID())
+	if err := setRunbookSnapshot(d, createdRunbookSnapshot); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("publish").(bool) {
+		tflog.Info(ctx, fmt.Sprintf("publishing runbook snapshot (%s)", d.Id()))
+
+		runbook.PublishedRunbookSnapshotID = createdRunbookSnapshot.GetID()
+		if _, err := client.Runbooks.Update(runbook); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("runbook snapshot created (%s)", d.Id()))
+	return nil
+}
+
+func resourceRunbookSnapshotDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("deleting runbook snapshot (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	isPublished, err := isPublishedRunbookSnapshot(client, d.Get("runbook_id").(string), d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "runbook snapshot")
+	}
+
+	// the published snapshot is the one that runs of the runbook use, so it is only removed from state
+	if !isPublished {
+		if err := client.RunbookSnapshots.DeleteByID(d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	tflog.Info(ctx, "runbook snapshot deleted")
+	return nil
+}
+
+func resourceRunbookSnapshotRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading runbook snapshot (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	runbookSnapshot, err := client.RunbookSnapshots.GetByID(d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "runbook snapshot")
+	}
+
+	if err := setRunbookSnapshot(d, runbookSnapshot); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("runbook snapshot read (%s)", d.Id()))
+	return nil
+}
+
+func resourceRunbookSnapshotUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("updating runbook snapshot (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	runbookSnapshot, err := client.RunbookSnapshots.GetByID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the runbook snapshot service cannot update snapshots, so the snapshot is saved through its own link
+	runbookSnapshot.Notes = d.Get("notes").(string)
+	updatedRunbookSnapshot, err := services.ApiUpdate(client.RunbookSnapshots.GetClient(), runbookSnapshot, new(runbooks.RunbookSnapshot), runbookSnapshot.Links["Self"])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setRunbookSnapshot(d, updatedRunbookSnapshot.(*runbooks.RunbookSnapshot)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("runbook snapshot updated (%s)", d.Id()))
+	return nil
+}
+
+func isPublishedRunbookSnapshot(client *client.Client, runbookID string, id string) (bool, error) {
+	runbook, err := client.Runbooks.GetByID(runbookID)
+	if err != nil {
+		return false, err
+	}
+
+	return runbook.PublishedRunbookSnapshotID == id, nil
+}
@@ -0,0 +1,105 @@
+package octopusdeploy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunbookSnapshot(t *testing.T) {
+	runbook := map[string]interface{}{
+		"Id":                       "Runbooks-1",
+		"SpaceId":                  "Spaces-1",
+		"Name":                     "Restart web server",
+		"ProjectId":                "Projects-1",
+		"DefaultGuidedFailureMode": "EnvironmentDefault",
+		"EnvironmentScope":         "All",
+		"MultiTenancyMode":         "Untenanted",
+		"Links": map[string]interface{}{
+			"RunbookSnapshotTemplate": "/api/Spaces-1/runbooks/Runbooks-1/runbookSnapshotTemplate",
+		},
+	}
+	runbookSnapshots := map[string]map[string]interface{}{}
+	deleted := []string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api":
+			w.Write([]byte(`{"Links":{}}`))
+		case r.URL.Path == "/api/Spaces-1":
+			w.Write([]byte(`{"Id":"Spaces-1","Links":{"Runbooks":"/api/Spaces-1/runbooks{/id}{?skip,take,ids,partialName}","RunbookSnapshots":"/api/Spaces-1/runbookSnapshots{/id}{?skip,take,ids,publish}"}}`))
+		case r.URL.Path == "/api/Spaces-1/runbooks/Runbooks-1" && r.Method == http.MethodPut:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&runbook))
+			json.NewEncoder(w).Encode(runbook)
+		case r.URL.Path == "/api/Spaces-1/runbooks/Runbooks-1":
+			json.NewEncoder(w).Encode(runbook)
+		case r.URL.Path == "/api/Spaces-1/runbooks/Runbooks-1/runbookSnapshotTemplate":
+			w.Write([]byte(`{"NextNameIncrement":"Snapshot 7H3JK2A","Packages":[{"ActionName":"Restart","PackageId":"scripts","VersionSelectedLastRelease":"1.0.0"}]}`))
+		case r.URL.Path == "/api/Spaces-1/runbookSnapshots" && r.Method == http.MethodPost:
+			runbookSnapshot := map[string]interface{}{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&runbookSnapshot))
+			runbookSnapshot["Id"] = "RunbookSnapshots-" + string(rune('1'+len(runbookSnapshots)))
+			runbookSnapshots[runbookSnapshot["Id"].(string)] = runbookSnapshot
+			json.NewEncoder(w).Encode(runbookSnapshot)
+		case r.URL.Path == "/api/Spaces-1/runbookSnapshots/RunbookSnapshots-1" || r.URL.Path == "/api/Spaces-1/runbookSnapshots/RunbookSnapshots-2":
+			id := r.URL.Path[len("/api/Spaces-1/runbookSnapshots/"):]
+			if r.Method == http.MethodDelete {
+				deleted = append(deleted, id)
+				delete(runbookSnapshots, id)
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			json.NewEncoder(w).Encode(runbookSnapshots[id])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"ErrorMessage":"Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+	meta := newProviderMeta(octopus)
+
+	// the snapshot takes the suggested name and the last version of each package, and is published
+	published := resourceRunbookSnapshot().TestResourceData()
+	published.Set("publish", true)
+	published.Set("runbook_id", "Runbooks-1")
+
+	require.False(t, resourceRunbookSnapshotCreate(context.Background(), published, meta).HasError())
+	require.Equal(t, "RunbookSnapshots-1", published.Id())
+	require.Equal(t, "Snapshot 7H3JK2A", published.Get("name"))
+	require.Equal(t, "Projects-1", published.Get("project_id"))
+	require.Equal(t, "RunbookSnapshots-1", runbook["PublishedRunbookSnapshotId"])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"ActionName": "Restart", "Version": "1.0.0"},
+	}, runbookSnapshots["RunbookSnapshots-1"]["SelectedPackages"])
+
+	// a snapshot that is not published leaves the published snapshot unchanged
+	draft := resourceRunbookSnapshot().TestResourceData()
+	draft.Set("name", "Draft")
+	draft.Set("publish", false)
+	draft.Set("runbook_id", "Runbooks-1")
+
+	require.False(t, resourceRunbookSnapshotCreate(context.Background(), draft, meta).HasError())
+	require.Equal(t, "RunbookSnapshots-2", draft.Id())
+	require.Equal(t, "Draft", draft.Get("name"))
+	require.Equal(t, "RunbookSnapshots-1", runbook["PublishedRunbookSnapshotId"])
+
+	// the published snapshot is only removed from state
+	require.False(t, resourceRunbookSnapshotDelete(context.Background(), published, meta).HasError())
+	require.Empty(t, published.Id())
+	require.Empty(t, deleted)
+
+	require.False(t, resourceRunbookSnapshotDelete(context.Background(), draft, meta).HasError())
+	require.Empty(t, draft.Id())
+	require.Equal(t, []string{"RunbookSnapshots-2"}, deleted)
+}
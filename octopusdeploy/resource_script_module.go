@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +14,7 @@ func resourceScriptModule() *schema.Resource {
 		CreateContext: resourceScriptModuleCreate,
 		DeleteContext: resourceScriptModuleDelete,
 		Description:   "This resource manages script modules in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("script module", findScriptModules),
 		ReadContext:   resourceScriptModuleRead,
 		Schema:        getScriptModuleSchema(),
 		UpdateContext: resourceScriptModuleUpdate,
@@ -27,7 +26,11 @@ func resourceScriptModuleCreate(ctx context.Context, d *schema.ResourceData, m i
 
 	log.Printf("[INFO] creating script module: %#v", scriptModule)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdScriptModule, err := client.ScriptModules.Add(scriptModule)
 	if err != nil {
 		return diag.FromErr(err)
@@ -46,8 +49,12 @@ func resourceScriptModuleCreate(ctx context.Context, d *schema.ResourceData, m i
 func resourceScriptModuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting script module (%s)", d.Id())
 
-	client := m.(*client.Client)
-	err := client.ScriptModules.DeleteByID(d.Id())
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.ScriptModules.DeleteByID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +67,11 @@ func resourceScriptModuleDelete(ctx context.Context, d *schema.ResourceData, m i
 func resourceScriptModuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading script module (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	scriptModule, err := client.ScriptModules.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "script module")
@@ -79,7 +90,11 @@ func resourceScriptModuleUpdate(ctx context.Context, d *schema.ResourceData, m i
 
 	scriptModule := expandScriptModule(d)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedScriptModule, err := client.ScriptModules.Update(scriptModule)
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,80 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/configuration"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSmtpConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSmtpConfigurationCreate,
+		DeleteContext: resourceSmtpConfigurationDelete,
+		Description:   "This resource manages the SMTP server that Octopus Deploy sends emails through. There is exactly one SMTP configuration per Octopus instance.",
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		ReadContext:   resourceSmtpConfigurationRead,
+		Schema:        getSmtpConfigurationSchema(),
+		UpdateContext: resourceSmtpConfigurationUpdate,
+	}
+}
+
+func updateSmtpConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	smtpConfiguration := expandSmtpConfiguration(d)
+
+	tflog.Info(ctx, fmt.Sprintf("updating SMTP configuration (%s)", smtpConfiguration.SmtpHost))
+
+	updatedSmtpConfiguration, err := configuration.UpdateSmtpConfiguration(getClient(m), smtpConfiguration)
+	if err != nil {
+		return err
+	}
+
+	setSmtpConfiguration(d, updatedSmtpConfiguration)
+	return nil
+}
+
+func resourceSmtpConfigurationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := updateSmtpConfiguration(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("SMTP configuration created (%s)", d.Id()))
+	return nil
+}
+
+func resourceSmtpConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the SMTP configuration cannot be deleted; it exists for as long as the Octopus instance does
+	tflog.Info(ctx, fmt.Sprintf("removing SMTP configuration from state (%s)", d.Id()))
+
+	d.SetId("")
+	return nil
+}
+
+func resourceSmtpConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading SMTP configuration (%s)", d.Id()))
+
+	smtpConfiguration, err := configuration.GetSmtpConfiguration(getClient(m))
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "SMTP configuration")
+	}
+
+	setSmtpConfiguration(d, smtpConfiguration)
+
+	tflog.Info(ctx, fmt.Sprintf("SMTP configuration read (%s)", d.Id()))
+	return nil
+}
+
+func resourceSmtpConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := updateSmtpConfiguration(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("SMTP configuration updated (%s)", d.Id()))
+	return nil
+}
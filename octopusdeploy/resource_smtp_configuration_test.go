@@ -0,0 +1,60 @@
+package octopusdeploy
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmtpConfigurationCreate(t *testing.T) {
+	var updatedSmtpConfiguration map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api":
+			w.Write([]byte(`{"Links":{"SmtpConfiguration":"/api/smtpconfiguration"}}`))
+		case r.URL.Path == "/api/Spaces-1":
+			w.Write([]byte(`{"Id":"Spaces-1","Links":{}}`))
+		case r.URL.Path == "/api/smtpconfiguration" && r.Method == http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			require.NoError(t, json.Unmarshal(body, &updatedSmtpConfiguration))
+
+			// Octopus never returns the password
+			w.Write([]byte(`{"Id":"smtpconfiguration","SmtpHost":"smtp.example.com","SmtpPort":587,"SendEmailFrom":"octopus@example.com","SmtpLogin":"octopus","SmtpPassword":{"HasValue":true},"EnableSsl":true,"Timeout":12000}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"ErrorMessage":"Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+	meta := newProviderMeta(octopus)
+
+	d := resourceSmtpConfiguration().TestResourceData()
+	d.Set("enable_ssl", true)
+	d.Set("host", "smtp.example.com")
+	d.Set("login", "octopus")
+	d.Set("password", "secret")
+	d.Set("port", 587)
+	d.Set("send_email_from", "octopus@example.com")
+	d.Set("timeout", 12000)
+
+	require.False(t, resourceSmtpConfigurationCreate(context.Background(), d, meta).HasError())
+	require.Equal(t, "smtpconfiguration", d.Id())
+	require.Equal(t, "secret", d.Get("password"))
+
+	require.Equal(t, "smtp.example.com", updatedSmtpConfiguration["SmtpHost"])
+	require.Equal(t, float64(587), updatedSmtpConfiguration["SmtpPort"])
+	require.Equal(t, true, updatedSmtpConfiguration["EnableSsl"])
+	require.Equal(t, map[string]interface{}{"HasValue": true, "Hint": nil, "NewValue": "secret"}, updatedSmtpConfiguration["SmtpPassword"])
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +14,7 @@ func resourceSpace() *schema.Resource {
 		CreateContext: resourceSpaceCreate,
 		DeleteContext: resourceSpaceDelete,
 		Description:   "This resource manages spaces in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("space", findSpaces),
 		ReadContext:   resourceSpaceRead,
 		Schema:        getSpaceSchema(),
 		UpdateContext: resourceSpaceUpdate,
@@ -27,7 +26,7 @@ func resourceSpaceCreate(ctx context.Context, d *schema.ResourceData, m interfac
 
 	log.Printf("[INFO] creating space: %#v", space)
 
-	client := m.(*client.Client)
+	client := getClient(m)
 	createdSpace, err := client.Spaces.Add(space)
 	if err != nil {
 		return diag.FromErr(err)
@@ -49,7 +48,7 @@ func resourceSpaceDelete(ctx context.Context, d *schema.ResourceData, m interfac
 	space := expandSpace(d)
 	space.TaskQueueStopped = true
 
-	client := m.(*client.Client)
+	client := getClient(m)
 	updatedSpace, err := client.Spaces.Update(space)
 	if err != nil {
 		return diag.FromErr(err)
@@ -68,7 +67,7 @@ func resourceSpaceDelete(ctx context.Context, d *schema.ResourceData, m interfac
 func resourceSpaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading space (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := getClient(m)
 	space, err := client.Spaces.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "space")
@@ -86,7 +85,7 @@ func resourceSpaceUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 	log.Printf("[INFO] updating space (%s)", d.Id())
 
 	space := expandSpace(d)
-	client := m.(*client.Client)
+	client := getClient(m)
 	updatedSpace, err := client.Spaces.Update(space)
 	if err != nil {
 		return diag.FromErr(err)
@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/accounts"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,9 +15,13 @@ func resourceSSHKeyAccount() *schema.Resource {
 		CreateContext: resourceSSHKeyAccountCreate,
 		DeleteContext: resourceSSHKeyAccountDelete,
 		Description:   "This resource manages SSH key accounts in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("account", findAccounts),
 		ReadContext:   resourceSSHKeyAccountRead,
 		Schema:        getSSHKeyAccountSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getSSHKeyAccountSchema(), "environments", "tenant_tags", "tenants"),
+		},
 		UpdateContext: resourceSSHKeyAccountUpdate,
 	}
 }
@@ -28,7 +31,11 @@ func resourceSSHKeyAccountCreate(ctx context.Context, d *schema.ResourceData, m
 
 	log.Printf("[INFO] creating SSH key account: %#v", account)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdAccount, err := client.Accounts.Add(account)
 	if err != nil {
 		return diag.FromErr(err)
@@ -47,7 +54,11 @@ func resourceSSHKeyAccountCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceSSHKeyAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting SSH key account (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Accounts.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -61,7 +72,11 @@ func resourceSSHKeyAccountDelete(ctx context.Context, d *schema.ResourceData, m
 func resourceSSHKeyAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading SSH key account (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	accountResource, err := client.Accounts.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "SSH key account")
@@ -80,7 +95,11 @@ func resourceSSHKeyAccountUpdate(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[INFO] updating SSH key account (%s)", d.Id())
 
 	account := expandSSHKeyAccount(d)
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedAccount, err := client.Accounts.Update(account)
 	if err != nil {
 		return diag.FromErr(err)
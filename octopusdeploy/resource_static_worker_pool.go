@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/workerpools"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,7 +15,7 @@ func resourceStaticWorkerPool() *schema.Resource {
 		CreateContext: resourceStaticWorkerPoolCreate,
 		DeleteContext: resourceStaticWorkerPoolDelete,
 		Description:   "This resource manages static worker pools in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("worker pool", findWorkerPools),
 		ReadContext:   resourceStaticWorkerPoolRead,
 		Schema:        getStaticWorkerPoolSchema(),
 		UpdateContext: resourceStaticWorkerPoolUpdate,
@@ -28,7 +27,11 @@ func resourceStaticWorkerPoolCreate(ctx context.Context, d *schema.ResourceData,
 
 	log.Printf("[INFO] creating static worker pool: %#v", workerPool)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdWorkerPool, err := client.WorkerPools.Add(workerPool)
 	if err != nil {
 		return diag.FromErr(err)
@@ -48,7 +51,11 @@ func resourceStaticWorkerPoolCreate(ctx context.Context, d *schema.ResourceData,
 func resourceStaticWorkerPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting static worker pool (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.WorkerPools.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -62,7 +69,11 @@ func resourceStaticWorkerPoolDelete(ctx context.Context, d *schema.ResourceData,
 func resourceStaticWorkerPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading static worker pool (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	workerPoolResource, err := client.WorkerPools.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "static worker pool")
@@ -82,7 +93,11 @@ func resourceStaticWorkerPoolUpdate(ctx context.Context, d *schema.ResourceData,
 
 	log.Printf("[INFO] updating static worker pool (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedWorkerPool, err := client.WorkerPools.Update(workerPool)
 	if err != nil {
 		return diag.FromErr(err)
@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tagsets"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
@@ -35,10 +34,14 @@ func resourceTagCreate(ctx context.Context, d *schema.ResourceData, m interface{
 
 	tagSetID := d.Get("tag_set_id").(string)
 
-	octopus := m.(*client.Client)
+	octopus, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	tagSet, err := octopus.TagSets.GetByID(tagSetID)
 	if err != nil {
-		return processUnknownTagSetError(ctx, d, err)
+		return errors.ProcessApiError(ctx, d, err, "tag")
 	}
 
 	name := d.Get("name").(string)
@@ -68,10 +71,14 @@ func resourceTagDelete(ctx context.Context, d *schema.ResourceData, m interface{
 
 	log.Printf("[INFO] deleting tag (%s)", d.Id())
 
-	octopus := m.(*client.Client)
+	octopus, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	tagSet, err := octopus.TagSets.GetByID(tagSetID)
 	if err != nil {
-		return processUnknownTagSetError(ctx, d, err)
+		return errors.ProcessApiError(ctx, d, err, "tag")
 	}
 
 	tag := expandTag(d)
@@ -127,10 +134,14 @@ func resourceTagRead(ctx context.Context, d *schema.ResourceData, m interface{})
 		log.Printf("[INFO] reading tag (%s)", d.Id())
 	}
 
-	octopus := m.(*client.Client)
+	octopus, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	tagSet, err := octopus.TagSets.GetByID(tagSetID)
 	if err != nil {
-		return processUnknownTagSetError(ctx, d, err)
+		return errors.ProcessApiError(ctx, d, err, "tag")
 	}
 
 	tag := expandTag(d)
@@ -146,7 +157,10 @@ func resourceTagUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 
 	log.Printf("[INFO] updating tag (%s)", d.Id())
 
-	octopus := m.(*client.Client)
+	octopus, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	// if the tag is reassigned to another tag set
 	if d.HasChange("tag_set_id") {
@@ -211,7 +225,7 @@ func resourceTagUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 
 	tagSet, err := octopus.TagSets.GetByID(tagSetID)
 	if err != nil {
-		return processUnknownTagSetError(ctx, d, err)
+		return errors.ProcessApiError(ctx, d, err, "tag")
 	}
 
 	// find and update the tag that matches the one updated in configuration
@@ -266,19 +280,3 @@ func findByIdOrNameAndSetTag(ctx context.Context, d *schema.ResourceData, tag *t
 
 	return errors.DeleteFromState(ctx, d, "tag")
 }
-
-func processUnknownTagSetError(ctx context.Context, d *schema.ResourceData, err error) diag.Diagnostics {
-	if err == nil {
-		return nil
-	}
-
-	if apiError, ok := err.(*core.APIError); ok {
-		if apiError.StatusCode == 404 {
-			log.Printf("[INFO] tag set (%s) not found; deleting tag from state", d.Id())
-			d.SetId("")
-			return nil
-		}
-	}
-
-	return diag.FromErr(err)
-}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,7 +16,7 @@ func resourceTagSet() *schema.Resource {
 		CreateContext: resourceTagSetCreate,
 		DeleteContext: resourceTagSetDelete,
 		Description:   "This resource manages tag sets in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("tag set", findTagSets),
 		ReadContext:   resourceTagSetRead,
 		Schema:        getTagSetSchema(),
 		UpdateContext: resourceTagSetUpdate,
@@ -29,7 +28,11 @@ func resourceTagSetCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	log.Printf("[INFO] creating tag set: %#v", tagSet)
 
-	octopus := m.(*client.Client)
+	octopus, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdTagSet, err := octopus.TagSets.Add(tagSet)
 	if err != nil {
 		return diag.FromErr(err)
@@ -48,7 +51,11 @@ func resourceTagSetCreate(ctx context.Context, d *schema.ResourceData, m interfa
 func resourceTagSetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting tag set (%s)", d.Id())
 
-	octopus := m.(*client.Client)
+	octopus, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := octopus.TagSets.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -62,7 +69,11 @@ func resourceTagSetDelete(ctx context.Context, d *schema.ResourceData, m interfa
 func resourceTagSetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Info(ctx, fmt.Sprintf("reading tag set (%s)", d.Id()))
 
-	octopus := m.(*client.Client)
+	octopus, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	tagSet, err := octopus.TagSets.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "tag set")
@@ -81,7 +92,11 @@ func resourceTagSetUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	log.Printf("[INFO] updating tag set: %#v", tagSet)
 
-	octopus := m.(*client.Client)
+	octopus, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	existingTagSet, err := octopus.TagSets.GetByID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -7,8 +7,8 @@ import (
 	"log"
 	"sort"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/teams"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/userroles"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
@@ -21,7 +21,7 @@ func resourceTeam() *schema.Resource {
 		CreateContext: resourceTeamCreate,
 		DeleteContext: resourceTeamDelete,
 		Description:   "This resource manages teams in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("team", findTeams),
 		ReadContext:   resourceTeamRead,
 		Schema:        getTeamSchema(),
 		UpdateContext: resourceTeamUpdate,
@@ -33,7 +33,11 @@ func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, m interface
 
 	log.Printf("[INFO] creating team: %#v", team)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdTeam, err := client.Teams.Add(team)
 	if err != nil {
 		return diag.FromErr(err)
@@ -56,7 +60,11 @@ func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, m interface
 func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting team (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Teams.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -70,17 +78,28 @@ func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, m interface
 func resourceTeamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading team (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	team, err := client.Teams.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "team")
 	}
 
-	userRoles, err := client.Teams.GetScopedUserRoles(*team, core.SkipTakeQuery{})
+	firstPage, err := client.Teams.GetScopedUserRoles(*team, core.SkipTakeQuery{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userRoles, err := getAllPages(firstPage, 0, 0, func(skip int) (*resources.Resources[*userroles.ScopedUserRole], error) {
+		return client.Teams.GetScopedUserRoles(*team, core.SkipTakeQuery{Skip: skip})
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	remoteUserRoles := flattenScopedUserRoles(userRoles.Items)
+	remoteUserRoles := flattenScopedUserRoles(userRoles)
 	d.Set("user_role", remoteUserRoles)
 
 	if err := setTeam(ctx, d, team); err != nil {
@@ -95,7 +114,11 @@ func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	log.Printf("[INFO] updating team (%s)", d.Id())
 
 	team := expandTeam(d)
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedTeam, err := client.Teams.Update(team)
 	if err != nil {
 		return diag.FromErr(err)
@@ -165,7 +188,7 @@ func resourceTeamUpdateUserRoles(ctx context.Context, d *schema.ResourceData, m
 
 		if len(remove) > 0 || len(add) > 0 {
 			log.Printf("[INFO] user role found diff (%s)", d.Id())
-			client := m.(*client.Client)
+			client := getClient(m)
 			if len(remove) > 0 {
 				log.Printf("[INFO] removing user roles from team (%s)", d.Id())
 				for _, userRole := range remove {
@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,9 +14,13 @@ func resourceTenant() *schema.Resource {
 		CreateContext: resourceTenantCreate,
 		DeleteContext: resourceTenantDelete,
 		Description:   "This resource manages tenants in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("tenant", findTenants),
 		ReadContext:   resourceTenantRead,
 		Schema:        getTenantSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getTenantSchema(), "tenant_tags"),
+		},
 		UpdateContext: resourceTenantUpdate,
 	}
 }
@@ -27,7 +30,11 @@ func resourceTenantCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	log.Printf("[INFO] creating tenant: %#v", tenant)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	createdTenant, err := client.Tenants.Add(tenant)
 	if err != nil {
 		return diag.FromErr(err)
@@ -46,7 +53,11 @@ func resourceTenantCreate(ctx context.Context, d *schema.ResourceData, m interfa
 func resourceTenantDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting tenant (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := client.Tenants.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -59,7 +70,11 @@ func resourceTenantDelete(ctx context.Context, d *schema.ResourceData, m interfa
 func resourceTenantRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading tenant (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	tenant, err := client.Tenants.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "tenant")
@@ -77,7 +92,11 @@ func resourceTenantUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 	log.Printf("[INFO] updating tenant (%s)", d.Id())
 
 	tenant := expandTenant(d)
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	updatedTenant, err := client.Tenants.Update(tenant)
 	if err != nil {
 		return diag.FromErr(err)
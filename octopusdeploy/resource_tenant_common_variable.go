@@ -6,7 +6,6 @@ import (
 	"log"
 	"strings"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,32 +13,37 @@ import (
 )
 
 func resourceTenantCommonVariable() *schema.Resource {
+	tenantCommonVariableSchema := map[string]*schema.Schema{
+		"library_variable_set_id": {
+			Required: true,
+			Type:     schema.TypeString,
+		},
+		"space_id": getSpaceIDInputSchema(),
+		"template_id": {
+			Required: true,
+			Type:     schema.TypeString,
+		},
+		"tenant_id": {
+			Required: true,
+			Type:     schema.TypeString,
+		},
+		"value": {
+			Default:   "",
+			Optional:  true,
+			Sensitive: true,
+			Type:      schema.TypeString,
+		},
+	}
+
+	addSecretVersions(tenantCommonVariableSchema, "value")
+
 	return &schema.Resource{
 		CreateContext: resourceTenantCommonVariableCreate,
 		DeleteContext: resourceTenantCommonVariableDelete,
 		Description:   "This resource manages tenant common variables in Octopus Deploy.",
 		Importer:      &schema.ResourceImporter{State: resourceTenantCommonVariableImporter},
 		ReadContext:   resourceTenantCommonVariableRead,
-		Schema: map[string]*schema.Schema{
-			"library_variable_set_id": {
-				Required: true,
-				Type:     schema.TypeString,
-			},
-			"template_id": {
-				Required: true,
-				Type:     schema.TypeString,
-			},
-			"tenant_id": {
-				Required: true,
-				Type:     schema.TypeString,
-			},
-			"value": {
-				Default:   "",
-				Optional:  true,
-				Sensitive: true,
-				Type:      schema.TypeString,
-			},
-		},
+		Schema:        tenantCommonVariableSchema,
 		UpdateContext: resourceTenantCommonVariableUpdate,
 	}
 }
@@ -51,13 +55,17 @@ func resourceTenantCommonVariableCreate(ctx context.Context, d *schema.ResourceD
 	libraryVariableSetID := d.Get("library_variable_set_id").(string)
 	tenantID := d.Get("tenant_id").(string)
 	templateID := d.Get("template_id").(string)
-	value := d.Get("value").(string)
+	value := getSensitiveString(d, "value")
 
 	id := tenantID + ":" + libraryVariableSetID + ":" + templateID
 
 	log.Printf("[INFO] creating tenant common variable (%s)", id)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	tenant, err := client.Tenants.GetByID(tenantID)
 	if err != nil {
 		return diag.FromErr(err)
@@ -80,6 +88,7 @@ func resourceTenantCommonVariableCreate(ctx context.Context, d *schema.ResourceD
 		client.Tenants.UpdateVariables(tenant, tenantVariables)
 
 		d.SetId(id)
+		clearVersionedSecrets(d, "value")
 		log.Printf("[INFO] tenant common variable created (%s)", d.Id())
 		return nil
 	}
@@ -100,19 +109,16 @@ func resourceTenantCommonVariableDelete(ctx context.Context, d *schema.ResourceD
 
 	log.Printf("[INFO] deleting tenant common variable (%s)", id)
 
-	client := m.(*client.Client)
-	tenant, err := client.Tenants.GetByID(tenantID)
+	client, err := getSpaceClient(m, d)
 	if err != nil {
-		if apiError, ok := err.(*core.APIError); ok {
-			if apiError.StatusCode == 404 {
-				log.Printf("[INFO] tenant (%s) not found; deleting tenant common variable from state", d.Id())
-				d.SetId("")
-				return nil
-			}
-		}
 		return diag.FromErr(err)
 	}
 
+	tenant, err := client.Tenants.GetByID(tenantID)
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "tenant common variable")
+	}
+
 	tenantVariables, err := client.Tenants.GetVariables(tenant)
 	if err != nil {
 		return diag.FromErr(err)
@@ -173,19 +179,16 @@ func resourceTenantCommonVariableRead(ctx context.Context, d *schema.ResourceDat
 
 	log.Printf("[INFO] reading tenant common variable (%s)", id)
 
-	client := m.(*client.Client)
-	tenant, err := client.Tenants.GetByID(tenantID)
+	client, err := getSpaceClient(m, d)
 	if err != nil {
-		if apiError, ok := err.(*core.APIError); ok {
-			if apiError.StatusCode == 404 {
-				log.Printf("[INFO] tenant (%s) not found; deleting common variable from state", d.Id())
-				d.SetId("")
-				return nil
-			}
-		}
 		return diag.FromErr(err)
 	}
 
+	tenant, err := client.Tenants.GetByID(tenantID)
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "tenant common variable")
+	}
+
 	tenantVariables, err := client.Tenants.GetVariables(tenant)
 	if err != nil {
 		return diag.FromErr(err)
@@ -205,6 +208,7 @@ func resourceTenantCommonVariableRead(ctx context.Context, d *schema.ResourceDat
 			}
 
 			d.SetId(id)
+			clearVersionedSecrets(d, "value")
 			log.Printf("[INFO] tenant common variable read (%s)", d.Id())
 			return nil
 		}
@@ -220,13 +224,17 @@ func resourceTenantCommonVariableUpdate(ctx context.Context, d *schema.ResourceD
 	libraryVariableSetID := d.Get("library_variable_set_id").(string)
 	tenantID := d.Get("tenant_id").(string)
 	templateID := d.Get("template_id").(string)
-	value := d.Get("value").(string)
+	value := getSensitiveString(d, "value")
 
 	id := tenantID + ":" + libraryVariableSetID + ":" + templateID
 
 	log.Printf("[INFO] updating tenant common variable (%s)", id)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	tenant, err := client.Tenants.GetByID(tenantID)
 	if err != nil {
 		return diag.FromErr(err)
@@ -249,6 +257,7 @@ func resourceTenantCommonVariableUpdate(ctx context.Context, d *schema.ResourceD
 		client.Tenants.UpdateVariables(tenant, tenantVariables)
 
 		d.SetId(id)
+		clearVersionedSecrets(d, "value")
 		log.Printf("[INFO] tenant common variable updated (%s)", d.Id())
 		return nil
 	}
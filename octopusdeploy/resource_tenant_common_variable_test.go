@@ -5,7 +5,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/test"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -124,7 +123,7 @@ func testTenantCommonVariableExists(resourceName string) resource.TestCheckFunc
 		libraryVariableSetID := importStrings[1]
 		templateID := importStrings[2]
 
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		tenant, err := client.Tenants.GetByID(tenantID)
 		if err != nil {
 			return err
@@ -146,7 +145,7 @@ func testTenantCommonVariableExists(resourceName string) resource.TestCheckFunc
 }
 
 func testAccTenantCommonVariableCheckDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*client.Client)
+	client := getClient(testAccProvider.Meta())
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "octopusdeploy_tenant_common_variable" {
 			continue
@@ -6,7 +6,6 @@ import (
 	"log"
 	"strings"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,36 +13,41 @@ import (
 )
 
 func resourceTenantProjectVariable() *schema.Resource {
+	tenantProjectVariableSchema := map[string]*schema.Schema{
+		"environment_id": {
+			Required: true,
+			Type:     schema.TypeString,
+		},
+		"project_id": {
+			Required: true,
+			Type:     schema.TypeString,
+		},
+		"space_id": getSpaceIDInputSchema(),
+		"template_id": {
+			Required: true,
+			Type:     schema.TypeString,
+		},
+		"tenant_id": {
+			Required: true,
+			Type:     schema.TypeString,
+		},
+		"value": {
+			Default:   "",
+			Optional:  true,
+			Sensitive: true,
+			Type:      schema.TypeString,
+		},
+	}
+
+	addSecretVersions(tenantProjectVariableSchema, "value")
+
 	return &schema.Resource{
 		CreateContext: resourceTenantProjectVariableCreate,
 		DeleteContext: resourceTenantProjectVariableDelete,
 		Description:   "This resource manages tenant project variables in Octopus Deploy.",
 		Importer:      &schema.ResourceImporter{State: resourceTenantProjectVariableImporter},
 		ReadContext:   resourceTenantProjectVariableRead,
-		Schema: map[string]*schema.Schema{
-			"environment_id": {
-				Required: true,
-				Type:     schema.TypeString,
-			},
-			"project_id": {
-				Required: true,
-				Type:     schema.TypeString,
-			},
-			"template_id": {
-				Required: true,
-				Type:     schema.TypeString,
-			},
-			"tenant_id": {
-				Required: true,
-				Type:     schema.TypeString,
-			},
-			"value": {
-				Default:   "",
-				Optional:  true,
-				Sensitive: true,
-				Type:      schema.TypeString,
-			},
-		},
+		Schema:        tenantProjectVariableSchema,
 		UpdateContext: resourceTenantProjectVariableUpdate,
 	}
 }
@@ -56,13 +60,17 @@ func resourceTenantProjectVariableCreate(ctx context.Context, d *schema.Resource
 	projectID := d.Get("project_id").(string)
 	templateID := d.Get("template_id").(string)
 	tenantID := d.Get("tenant_id").(string)
-	value := d.Get("value").(string)
+	value := getSensitiveString(d, "value")
 
 	id := tenantID + ":" + projectID + ":" + environmentID + ":" + templateID
 
 	log.Printf("[INFO] creating tenant project variable (%s)", id)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	tenant, err := client.Tenants.GetByID(tenantID)
 	if err != nil {
 		return diag.FromErr(err)
@@ -86,6 +94,7 @@ func resourceTenantProjectVariableCreate(ctx context.Context, d *schema.Resource
 			client.Tenants.UpdateVariables(tenant, tenantVariables)
 
 			d.SetId(id)
+			clearVersionedSecrets(d, "value")
 			log.Printf("[INFO] tenant project variable created (%s)", d.Id())
 			return nil
 		}
@@ -108,19 +117,16 @@ func resourceTenantProjectVariableDelete(ctx context.Context, d *schema.Resource
 
 	log.Printf("[INFO] deleting tenant project variable (%s)", id)
 
-	client := m.(*client.Client)
-	tenant, err := client.Tenants.GetByID(tenantID)
+	client, err := getSpaceClient(m, d)
 	if err != nil {
-		if apiError, ok := err.(*core.APIError); ok {
-			if apiError.StatusCode == 404 {
-				log.Printf("[INFO] tenant (%s) not found; deleting common variable from state", d.Id())
-				d.SetId("")
-				return nil
-			}
-		}
 		return diag.FromErr(err)
 	}
 
+	tenant, err := client.Tenants.GetByID(tenantID)
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "tenant project variable")
+	}
+
 	tenantVariables, err := client.Tenants.GetVariables(tenant)
 	if err != nil {
 		return diag.FromErr(err)
@@ -183,19 +189,16 @@ func resourceTenantProjectVariableRead(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("[INFO] reading tenant project variable (%s)", id)
 
-	client := m.(*client.Client)
-	tenant, err := client.Tenants.GetByID(tenantID)
+	client, err := getSpaceClient(m, d)
 	if err != nil {
-		if apiError, ok := err.(*core.APIError); ok {
-			if apiError.StatusCode == 404 {
-				log.Printf("[INFO] tenant (%s) not found; deleting tenant project variable from state", d.Id())
-				d.SetId("")
-				return nil
-			}
-		}
 		return diag.FromErr(err)
 	}
 
+	tenant, err := client.Tenants.GetByID(tenantID)
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "tenant project variable")
+	}
+
 	tenantVariables, err := client.Tenants.GetVariables(tenant)
 	if err != nil {
 		return diag.FromErr(err)
@@ -215,6 +218,7 @@ func resourceTenantProjectVariableRead(ctx context.Context, d *schema.ResourceDa
 			}
 
 			d.SetId(id)
+			clearVersionedSecrets(d, "value")
 			log.Printf("[INFO] tenant project variable read (%s)", d.Id())
 			return nil
 		}
@@ -231,13 +235,17 @@ func resourceTenantProjectVariableUpdate(ctx context.Context, d *schema.Resource
 	projectID := d.Get("project_id").(string)
 	templateID := d.Get("template_id").(string)
 	tenantID := d.Get("tenant_id").(string)
-	value := d.Get("value").(string)
+	value := getSensitiveString(d, "value")
 
 	id := tenantID + ":" + projectID + ":" + environmentID + ":" + templateID
 
 	log.Printf("[INFO] updating tenant project variable (%s)", id)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	tenant, err := client.Tenants.GetByID(tenantID)
 	if err != nil {
 		return diag.FromErr(err)
@@ -261,6 +269,7 @@ func resourceTenantProjectVariableUpdate(ctx context.Context, d *schema.Resource
 			client.Tenants.UpdateVariables(tenant, tenantVariables)
 
 			d.SetId(id)
+			clearVersionedSecrets(d, "value")
 			log.Printf("[INFO] tenant project variable updated (%s)", d.Id())
 			return nil
 		}
@@ -5,12 +5,34 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
 )
 
+func TestTenantVariableValueVersion(t *testing.T) {
+	for _, tenantVariable := range []*schema.Resource{resourceTenantProjectVariable(), resourceTenantCommonVariable()} {
+		d := tenantVariable.Data(&terraform.InstanceState{
+			ID: "Tenants-1:Projects-1:Environments-1:template",
+			Attributes: map[string]string{
+				"template_id":   "template",
+				"tenant_id":     "Tenants-1",
+				"value_version": "1",
+			},
+			RawConfig: cty.ObjectVal(map[string]cty.Value{"value": cty.StringVal("secret")}),
+		})
+
+		// the value is read from the configuration and removed from state once its version is set
+		require.True(t, suppressVersionedSecretDiff("value", "", "secret", d))
+		require.Equal(t, "secret", getSensitiveString(d, "value"))
+		clearVersionedSecrets(d, "value")
+		require.Empty(t, d.State().Attributes["value"])
+	}
+}
+
 func TestAccTenantProjectVariableBasic(t *testing.T) {
 	lifecycleLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
 	lifecycleName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
@@ -116,7 +138,7 @@ func testTenantProjectVariableExists(prefix string) resource.TestCheckFunc {
 			}
 		}
 
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		tenant, err := client.Tenants.GetByID(tenantID)
 		if err != nil {
 			return err
@@ -140,7 +162,7 @@ func testTenantProjectVariableExists(prefix string) resource.TestCheckFunc {
 }
 
 func testAccTenantProjectVariableCheckDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*client.Client)
+	client := getClient(testAccProvider.Meta())
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "octopusdeploy_tenant_project_variable" {
 			continue
@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +14,7 @@ func resourceUser() *schema.Resource {
 		CreateContext: resourceUserCreate,
 		DeleteContext: resourceUserDelete,
 		Description:   "This resource manages users in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("user", findUsers),
 		ReadContext:   resourceUserRead,
 		Schema:        getUserSchema(),
 		UpdateContext: resourceUserUpdate,
@@ -27,7 +26,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 
 	log.Printf("[DEBUG] creating user")
 
-	client := m.(*client.Client)
+	client := getClient(m)
 	createdUser, err := client.Users.Add(user)
 	if err != nil {
 		return diag.FromErr(err)
@@ -46,7 +45,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting user (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := getClient(m)
 	if err := client.Users.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +59,7 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface
 func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading user (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := getClient(m)
 	user, err := client.Users.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "user")
@@ -78,7 +77,7 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	log.Printf("[INFO] updating user (%s)", d.Id())
 
 	user := expandUser(d)
-	client := m.(*client.Client)
+	client := getClient(m)
 	updatedUser, err := client.Users.Update(user)
 	if err != nil {
 		return diag.FromErr(err)
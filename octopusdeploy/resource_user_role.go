@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +14,7 @@ func resourceUserRole() *schema.Resource {
 		CreateContext: resourceUserRoleCreate,
 		DeleteContext: resourceUserRoleDelete,
 		Description:   "This resource manages user roles in Octopus Deploy.",
-		Importer:      getImporter(),
+		Importer:      getImporterByName("user role", findUserRoles),
 		ReadContext:   resourceUserRoleRead,
 		Schema:        getUserRoleSchema(),
 		UpdateContext: resourceUserRoleUpdate,
@@ -27,7 +26,7 @@ func resourceUserRoleCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	log.Printf("[INFO] creating user role: %#v", userRole)
 
-	client := m.(*client.Client)
+	client := getClient(m)
 	createdUserRole, err := client.UserRoles.Add(userRole)
 	if err != nil {
 		return diag.FromErr(err)
@@ -46,7 +45,7 @@ func resourceUserRoleCreate(ctx context.Context, d *schema.ResourceData, m inter
 func resourceUserRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting user role (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := getClient(m)
 	if err := client.UserRoles.DeleteByID(d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,7 +59,7 @@ func resourceUserRoleDelete(ctx context.Context, d *schema.ResourceData, m inter
 func resourceUserRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] reading user role (%s)", d.Id())
 
-	client := m.(*client.Client)
+	client := getClient(m)
 	userRole, err := client.UserRoles.GetByID(d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "user role")
@@ -78,7 +77,7 @@ func resourceUserRoleUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	log.Printf("[INFO] updating user role (%s)", d.Id())
 
 	userRole := expandUserRole(d)
-	client := m.(*client.Client)
+	client := getClient(m)
 	updatedUserRole, err := client.UserRoles.Update(userRole)
 	if err != nil {
 		return diag.FromErr(err)
@@ -7,7 +7,6 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,6 +22,10 @@ func resourceVariable() *schema.Resource {
 		Importer:      &schema.ResourceImporter{State: resourceVariableImport},
 		ReadContext:   resourceVariableRead,
 		Schema:        getVariableSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getVariableSchema(), "scope.actions", "scope.channels", "scope.environments", "scope.machines", "scope.processes", "scope.roles", "scope.tenant_tags"),
+		},
 		UpdateContext: resourceVariableUpdate,
 	}
 }
@@ -68,7 +71,11 @@ func resourceVariableCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	log.Printf("[INFO] creating variable: %#v", variable)
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	variableSet, err := client.Variables.AddSingle(variableOwnerID, variable)
 	if err != nil {
 		return diag.FromErr(err)
@@ -82,6 +89,9 @@ func resourceVariableCreate(ctx context.Context, d *schema.ResourceData, m inter
 			}
 			if scopeMatches {
 				d.SetId(v.ID)
+				if isSensitiveValueVersioned(d) {
+					d.Set("sensitive_value", nil)
+				}
 				log.Printf("[INFO] variable created (%s)", d.Id())
 				return nil
 			}
@@ -112,7 +122,11 @@ func resourceVariableRead(ctx context.Context, d *schema.ResourceData, m interfa
 		variableOwnerID = ownerID.(string)
 	}
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	variable, err := client.Variables.GetByID(variableOwnerID, id)
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "variable")
@@ -153,7 +167,11 @@ func resourceVariableUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		variableOwnerID = ownerID.(string)
 	}
 
-	client := m.(*client.Client)
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	variableSet, err := client.Variables.UpdateSingle(variableOwnerID, variable)
 	if err != nil {
 		return diag.FromErr(err)
@@ -166,6 +184,9 @@ func resourceVariableUpdate(ctx context.Context, d *schema.ResourceData, m inter
 				if err := setVariable(ctx, d, v); err != nil {
 					return diag.FromErr(err)
 				}
+				if isSensitiveValueVersioned(d) {
+					d.Set("sensitive_value", nil)
+				}
 				log.Printf("[INFO] variable updated (%s)", d.Id())
 				return nil
 			}
@@ -197,8 +218,12 @@ func resourceVariableDelete(ctx context.Context, d *schema.ResourceData, m inter
 		variableOwnerID = ownerID.(string)
 	}
 
-	client := m.(*client.Client)
-	_, err := client.Variables.DeleteSingle(variableOwnerID, d.Id())
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Variables.DeleteSingle(variableOwnerID, d.Id())
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "variable")
 	}
@@ -223,5 +248,9 @@ func validateVariable(d *schema.ResourceData) error {
 		return fmt.Errorf("when type is set to 'Sensitive', is_sensitive needs to be true")
 	}
 
-	return nil
+	if err := validateVariableValue(tfType, d.Get("value").(string)); err != nil {
+		return err
+	}
+
+	return validatePromptedDisplaySettings(d.Get("prompt"))
 }
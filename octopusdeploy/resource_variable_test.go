@@ -5,7 +5,6 @@ import (
 	"os"
 	"testing"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -23,7 +22,7 @@ func TestAccOctopusDeployVariableBasic(t *testing.T) {
 	variableType := "String"
 
 	accountVariableType := "GoogleCloudAccount"
-	accountValue := "octopusdeploy_gcp_account." + localName + ".id"
+	accountValue := "${octopusdeploy_gcp_account." + localName + ".id}"
 
 	channelLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
 	channelName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
@@ -53,7 +52,7 @@ func TestAccOctopusDeployVariableBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(prefix, "type", variableType),
 					resource.TestCheckResourceAttr(prefix, "value", value),
 					resource.TestCheckResourceAttr(prefix, "scope.#", "1"),
-					resource.TestCheckResourceAttr(prefix, "scope.0.%", "6"),
+					resource.TestCheckResourceAttr(prefix, "scope.0.%", "7"),
 					resource.TestCheckResourceAttr(prefix, "scope.0.environments.#", "1"),
 				),
 				Config: testVariableBasic(spaceID, environmentLocalName, environmentName, lifecycleLocalName, lifecycleName, projectGroupLocalName, projectGroupName, projectLocalName, projectName, channelLocalName, channelName, localName, name, description, isSensitive, value, variableType),
@@ -67,7 +66,7 @@ func TestAccOctopusDeployVariableBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(prefix, "type", variableType),
 					resource.TestCheckResourceAttr(prefix, "value", newValue),
 					resource.TestCheckResourceAttr(prefix, "scope.#", "1"),
-					resource.TestCheckResourceAttr(prefix, "scope.0.%", "6"),
+					resource.TestCheckResourceAttr(prefix, "scope.0.%", "7"),
 					resource.TestCheckResourceAttr(prefix, "scope.0.environments.#", "1"),
 				),
 				Config: testVariableBasic(spaceID, environmentLocalName, environmentName, lifecycleLocalName, lifecycleName, projectGroupLocalName, projectGroupName, projectLocalName, projectName, channelLocalName, channelName, localName, name, description, isSensitive, newValue, variableType),
@@ -79,9 +78,9 @@ func TestAccOctopusDeployVariableBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(prefix, "description", description),
 					resource.TestCheckResourceAttrSet(prefix, "owner_id"),
 					resource.TestCheckResourceAttr(prefix, "type", accountVariableType),
-					resource.TestCheckResourceAttr(prefix, "value", accountValue),
+					resource.TestCheckResourceAttrPair(prefix, "value", "octopusdeploy_gcp_account."+localName, "id"),
 					resource.TestCheckResourceAttr(prefix, "scope.#", "1"),
-					resource.TestCheckResourceAttr(prefix, "scope.0.%", "6"),
+					resource.TestCheckResourceAttr(prefix, "scope.0.%", "7"),
 					resource.TestCheckResourceAttr(prefix, "scope.0.environments.#", "1"),
 				),
 				Config: fmt.Sprintf(`%s
@@ -188,7 +187,7 @@ func testAccCheckVariableExists() resource.TestCheckFunc {
 			}
 		}
 
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 		if _, err := client.Variables.GetByID(ownerID, variableID); err != nil {
 			return fmt.Errorf("error retrieving variable %s", err)
 		}
@@ -211,7 +210,7 @@ func testVariableDestroy(s *terraform.State) error {
 		}
 	}
 
-	client := testAccProvider.Meta().(*client.Client)
+	client := getClient(testAccProvider.Meta())
 	variable, err := client.Variables.GetByID(ownerID, variableID)
 	if err == nil {
 		if variable != nil {
@@ -0,0 +1,147 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceVariables() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVariablesCreate,
+		DeleteContext: resourceVariablesDelete,
+		Description:   "This resource manages all of the variables of a project or library variable set in Octopus Deploy as a single resource. The variable set is saved in one request, so it must not be combined with `octopusdeploy_variable` for the same owner.",
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVariablesImport,
+		},
+		ReadContext:   resourceVariablesRead,
+		Schema:        getVariablesSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			getListToSetStateUpgrader(0, getVariablesSchema(), "variable.scope.actions", "variable.scope.channels", "variable.scope.environments", "variable.scope.machines", "variable.scope.processes", "variable.scope.roles", "variable.scope.tenant_tags"),
+		},
+		UpdateContext: resourceVariablesUpdate,
+	}
+}
+
+func resourceVariablesImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.Set("owner_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateVariables(ctx context.Context, d *schema.ResourceData, client *client.Client) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	flattenedVariables := d.Get("variable").([]interface{})
+	if err := validateVariables(flattenedVariables); err != nil {
+		return err
+	}
+
+	ownerID := d.Get("owner_id").(string)
+	variableSet, err := client.Variables.GetAll(ownerID)
+	if err != nil {
+		return err
+	}
+
+	setConfiguredSensitiveValues(d, flattenedVariables)
+	variableSet.Variables = expandVariables(flattenedVariables)
+
+	tflog.Info(ctx, fmt.Sprintf("updating variables (%s)", ownerID))
+
+	updatedVariableSet, err := client.Variables.Update(ownerID, variableSet)
+	if err != nil {
+		return err
+	}
+
+	d.Set("space_id", updatedVariableSet.SpaceID)
+	if err := d.Set("variable", flattenVariables(updatedVariableSet.Variables, flattenedVariables)); err != nil {
+		return fmt.Errorf("error setting variable: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVariablesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateVariables(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("owner_id").(string))
+
+	tflog.Info(ctx, fmt.Sprintf("variables created (%s)", d.Id()))
+	return nil
+}
+
+func resourceVariablesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	tflog.Info(ctx, fmt.Sprintf("deleting variables (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	variableSet, err := client.Variables.GetAll(d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "variables")
+	}
+
+	variableSet.Variables = nil
+	if _, err := client.Variables.Update(d.Id(), variableSet); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	tflog.Info(ctx, "variables deleted")
+	return nil
+}
+
+func resourceVariablesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("reading variables (%s)", d.Id()))
+
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	variableSet, err := client.Variables.GetAll(d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "variables")
+	}
+
+	d.Set("owner_id", variableSet.OwnerID)
+	d.Set("space_id", variableSet.SpaceID)
+	if err := d.Set("variable", flattenVariables(variableSet.Variables, d.Get("variable").([]interface{}))); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting variable: %s", err))
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("variables read (%s)", d.Id()))
+	return nil
+}
+
+func resourceVariablesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := getSpaceClient(m, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateVariables(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("variables updated (%s)", d.Id()))
+	return nil
+}
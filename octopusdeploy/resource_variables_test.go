@@ -0,0 +1,66 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/test"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVariablesBasic(t *testing.T) {
+	lifecycleTestOptions := test.NewLifecycleTestOptions()
+	projectGroupTestOptions := test.NewProjectGroupTestOptions()
+	projectTestOptions := test.NewProjectTestOptions(lifecycleTestOptions, projectGroupTestOptions)
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	resourceName := "octopusdeploy_variables." + localName
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccProjectCheckDestroy,
+			testAccProjectGroupCheckDestroy,
+			testAccLifecycleCheckDestroy,
+		),
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "owner_id", projectTestOptions.QualifiedName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "variable.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.name", "Plain"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.value", "plain value"),
+					resource.TestCheckResourceAttrSet(resourceName, "variable.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "variable.1.name", "Secret"),
+					resource.TestCheckResourceAttr(resourceName, "variable.1.sensitive_value", "secret value"),
+				),
+				Config: test.GetConfiguration([]string{
+					test.LifecycleConfiguration(lifecycleTestOptions),
+					test.ProjectGroupConfiguration(projectGroupTestOptions),
+					test.ProjectConfiguration(projectTestOptions),
+					testAccVariables(localName, projectTestOptions.QualifiedName),
+				}),
+			},
+		},
+	})
+}
+
+func testAccVariables(localName string, projectQualifiedName string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_variables" "%s" {
+		owner_id = %s.id
+
+		variable {
+			name  = "Plain"
+			type  = "String"
+			value = "plain value"
+		}
+
+		variable {
+			is_sensitive    = true
+			name            = "Secret"
+			sensitive_value = "secret value"
+			type            = "Sensitive"
+		}
+	}`, localName, projectQualifiedName)
+}
@@ -55,7 +55,8 @@ func getAccountResourceDataSchema() map[string]*schema.Schema {
 		"ids":          getQueryIDs(),
 		"partial_name": getQueryPartialName(),
 		"skip":         getQuerySkip(),
-		"take":         getQueryTake(),
+		"space_id":     getQuerySpaceID(),
+		"take":         getQueryTakeAll(),
 	}
 }
 
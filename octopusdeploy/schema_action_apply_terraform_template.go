@@ -288,41 +288,7 @@ func expandApplyTerraformTemplateAction(flattenedAction map[string]interface{})
 	if v, ok := flattenedAction["aws_account"]; ok && len(v.(*schema.Set).List()) > 0 {
 		action.Properties["Octopus.Action.Terraform.ManagedAccount"] = core.NewPropertyValue("AWS", false)
 
-		awsAccount := v.(*schema.Set).List()[0].(map[string]interface{})
-
-		if v, ok := awsAccount["region"]; ok {
-			action.Properties["Octopus.Action.Aws.Region"] = core.NewPropertyValue(v.(string), false)
-		}
-
-		if v, ok := awsAccount["role"]; ok && len(v.(*schema.Set).List()) > 0 {
-			action.Properties["Octopus.Action.Aws.AssumeRole"] = core.NewPropertyValue("True", false)
-
-			role := v.(*schema.Set).List()[0].(map[string]interface{})
-
-			if v, ok := role["arn"]; ok {
-				action.Properties["Octopus.Action.Aws.AssumedRoleArn"] = core.NewPropertyValue(v.(string), false)
-			}
-
-			if v, ok := role["external_id"]; ok {
-				action.Properties["Octopus.Action.Aws.AssumeRoleExternalId"] = core.NewPropertyValue(v.(string), false)
-			}
-
-			if v, ok := role["role_session_name"]; ok {
-				action.Properties["Octopus.Action.Aws.AssumedRoleSession"] = core.NewPropertyValue(v.(string), false)
-			}
-
-			if v, ok := role["session_duration"]; ok {
-				action.Properties["Octopus.Action.Aws.AssumeRoleSessionDurationSeconds"] = core.NewPropertyValue(strconv.Itoa(v.(int)), false)
-			}
-		}
-
-		if v, ok := awsAccount["variable"]; ok {
-			action.Properties["Octopus.Action.AwsAccount.Variable"] = core.NewPropertyValue(v.(string), false)
-		}
-
-		if v, ok := awsAccount["use_instance_role"]; ok {
-			action.Properties["Octopus.Action.AwsAccount.UseInstanceRole"] = core.NewPropertyValue(cases.Title(language.Und, cases.NoLower).String(strconv.FormatBool(v.(bool))), false)
-		}
+		addAwsAccountToActionResource(v.(*schema.Set).List()[0].(map[string]interface{}), action)
 	}
 
 	if v, ok := flattenedAction["azure_account"]; ok && len(v.(*schema.Set).List()) > 0 {
@@ -372,6 +338,42 @@ func expandApplyTerraformTemplateAction(flattenedAction map[string]interface{})
 	return action
 }
 
+func addAwsAccountToActionResource(awsAccount map[string]interface{}, action *deployments.DeploymentAction) {
+	if v, ok := awsAccount["region"]; ok {
+		action.Properties["Octopus.Action.Aws.Region"] = core.NewPropertyValue(v.(string), false)
+	}
+
+	if v, ok := awsAccount["role"]; ok && len(v.(*schema.Set).List()) > 0 {
+		action.Properties["Octopus.Action.Aws.AssumeRole"] = core.NewPropertyValue("True", false)
+
+		role := v.(*schema.Set).List()[0].(map[string]interface{})
+
+		if v, ok := role["arn"]; ok {
+			action.Properties["Octopus.Action.Aws.AssumedRoleArn"] = core.NewPropertyValue(v.(string), false)
+		}
+
+		if v, ok := role["external_id"]; ok {
+			action.Properties["Octopus.Action.Aws.AssumeRoleExternalId"] = core.NewPropertyValue(v.(string), false)
+		}
+
+		if v, ok := role["role_session_name"]; ok {
+			action.Properties["Octopus.Action.Aws.AssumedRoleSession"] = core.NewPropertyValue(v.(string), false)
+		}
+
+		if v, ok := role["session_duration"]; ok {
+			action.Properties["Octopus.Action.Aws.AssumeRoleSessionDurationSeconds"] = core.NewPropertyValue(strconv.Itoa(v.(int)), false)
+		}
+	}
+
+	if v, ok := awsAccount["variable"]; ok {
+		action.Properties["Octopus.Action.AwsAccount.Variable"] = core.NewPropertyValue(v.(string), false)
+	}
+
+	if v, ok := awsAccount["use_instance_role"]; ok {
+		action.Properties["Octopus.Action.AwsAccount.UseInstanceRole"] = core.NewPropertyValue(cases.Title(language.Und, cases.NoLower).String(strconv.FormatBool(v.(bool))), false)
+	}
+}
+
 func flattenTerraformTemplateAdvancedOptions(properties map[string]core.PropertyValue) []interface{} {
 	if len(properties) == 0 {
 		return nil
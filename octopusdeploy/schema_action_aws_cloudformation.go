@@ -0,0 +1,335 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+type cloudFormationParameter struct {
+	ParameterKey   string
+	ParameterValue string
+}
+
+type cloudFormationTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func addAwsCloudFormationStackSchema(element *schema.Resource) {
+	addTerraformTemplateAwsAccountSchema(element)
+	element.Schema["aws_account"].Description = "The AWS account (or OIDC account) variable, region and assumed role used to run the step"
+	element.Schema["aws_account"].Required = true
+	element.Schema["aws_account"].Optional = false
+
+	element.Schema["stack_name"] = &schema.Schema{
+		Description:      "The name of the CloudFormation stack",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+	}
+
+	element.Schema["wait_for_completion"] = &schema.Schema{
+		Default:     true,
+		Description: "Whether to wait for the stack operation to complete before finishing the step",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+}
+
+func getDeployAwsCloudFormationActionSchema() *schema.Schema {
+	actionSchema, element := getActionSchema()
+	addExecutionLocationSchema(element)
+	addWorkerPoolSchema(element)
+	addWorkerPoolVariableSchema(element)
+	addPrimaryPackageSchema(element, false)
+	addAwsCloudFormationStackSchema(element)
+
+	element.Schema["capabilities"] = &schema.Schema{
+		Description: "The capabilities granted to the stack, any of 'CAPABILITY_IAM', 'CAPABILITY_NAMED_IAM' or 'CAPABILITY_AUTO_EXPAND'",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				"CAPABILITY_AUTO_EXPAND",
+				"CAPABILITY_IAM",
+				"CAPABILITY_NAMED_IAM",
+			}, false)),
+		},
+		Optional: true,
+		Type:     schema.TypeList,
+	}
+
+	element.Schema["disable_rollback"] = &schema.Schema{
+		Default:     false,
+		Description: "Whether to disable the rollback of the stack if the stack creation fails",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+
+	element.Schema["inline_template"] = &schema.Schema{
+		Description: "The CloudFormation template (JSON or YAML) used when the template is not sourced from the primary package",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	element.Schema["parameters"] = &schema.Schema{
+		Description: "The values of the parameters defined by the inline template",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Type:        schema.TypeMap,
+	}
+
+	element.Schema["role_arn"] = &schema.Schema{
+		Description: "The ARN of the IAM service role that CloudFormation assumes to create and update the stack",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	element.Schema["tags"] = &schema.Schema{
+		Description: "The tags applied to the stack",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Type:        schema.TypeMap,
+	}
+
+	element.Schema["template_file"] = &schema.Schema{
+		Description: "The path of the template within the primary package",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	element.Schema["template_parameters_file"] = &schema.Schema{
+		Description: "The path of the parameters file within the primary package",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	return actionSchema
+}
+
+func getDeleteAwsCloudFormationActionSchema() *schema.Schema {
+	actionSchema, element := getActionSchema()
+	addExecutionLocationSchema(element)
+	addWorkerPoolSchema(element)
+	addWorkerPoolVariableSchema(element)
+	addAwsCloudFormationStackSchema(element)
+
+	return actionSchema
+}
+
+func expandAwsCloudFormationStack(flattenedAction map[string]interface{}, action *deployments.DeploymentAction) {
+	if v, ok := flattenedAction["aws_account"]; ok && len(v.(*schema.Set).List()) > 0 {
+		addAwsAccountToActionResource(v.(*schema.Set).List()[0].(map[string]interface{}), action)
+	}
+
+	if v, ok := flattenedAction["stack_name"]; ok {
+		action.Properties["Octopus.Action.Aws.CloudFormationStackName"] = core.NewPropertyValue(v.(string), false)
+	}
+
+	if v, ok := flattenedAction["wait_for_completion"]; ok {
+		action.Properties["Octopus.Action.Aws.WaitForCompletion"] = core.NewPropertyValue(cases.Title(language.Und, cases.NoLower).String(strconv.FormatBool(v.(bool))), false)
+	}
+
+	if v, ok := flattenedAction["worker_pool_id"]; ok {
+		action.WorkerPool = v.(string)
+	}
+
+	if v, ok := flattenedAction["worker_pool_variable"]; ok {
+		action.WorkerPoolVariable = v.(string)
+	}
+}
+
+func expandDeployAwsCloudFormationAction(flattenedAction map[string]interface{}) *deployments.DeploymentAction {
+	if len(flattenedAction) == 0 {
+		return nil
+	}
+
+	action := expandAction(flattenedAction)
+	if action == nil {
+		return nil
+	}
+
+	action.ActionType = "Octopus.AwsRunCloudFormation"
+	expandAwsCloudFormationStack(flattenedAction, action)
+
+	if v, ok := flattenedAction["primary_package"]; ok && len(v.([]interface{})) > 0 {
+		action.Properties["Octopus.Action.Aws.TemplateSource"] = core.NewPropertyValue("Package", false)
+
+		if v, ok := flattenedAction["template_file"]; ok {
+			action.Properties["Octopus.Action.Aws.CloudFormationTemplate"] = core.NewPropertyValue(v.(string), false)
+		}
+
+		if v, ok := flattenedAction["template_parameters_file"]; ok {
+			action.Properties["Octopus.Action.Aws.CloudFormationTemplateParameters"] = core.NewPropertyValue(v.(string), false)
+		}
+	} else {
+		action.Properties["Octopus.Action.Aws.TemplateSource"] = core.NewPropertyValue("Inline", false)
+
+		if v, ok := flattenedAction["inline_template"]; ok {
+			action.Properties["Octopus.Action.Aws.CloudFormationTemplate"] = core.NewPropertyValue(v.(string), false)
+		}
+
+		parameters := []cloudFormationParameter{}
+		if v, ok := flattenedAction["parameters"]; ok {
+			for key, value := range v.(map[string]interface{}) {
+				parameters = append(parameters, cloudFormationParameter{ParameterKey: key, ParameterValue: value.(string)})
+			}
+		}
+		sort.Slice(parameters, func(i, j int) bool {
+			return parameters[i].ParameterKey < parameters[j].ParameterKey
+		})
+
+		j, _ := json.Marshal(parameters)
+		action.Properties["Octopus.Action.Aws.CloudFormationTemplateParameters"] = core.NewPropertyValue(string(j), false)
+		action.Properties["Octopus.Action.Aws.CloudFormationTemplateParametersRaw"] = core.NewPropertyValue(string(j), false)
+	}
+
+	if v, ok := flattenedAction["capabilities"]; ok {
+		capabilities := getSliceFromTerraformTypeList(v)
+		if capabilities == nil {
+			capabilities = []string{}
+		}
+
+		j, _ := json.Marshal(capabilities)
+		action.Properties["Octopus.Action.Aws.IamCapabilities"] = core.NewPropertyValue(string(j), false)
+	}
+
+	if v, ok := flattenedAction["disable_rollback"]; ok {
+		action.Properties["Octopus.Action.Aws.DisableRollback"] = core.NewPropertyValue(cases.Title(language.Und, cases.NoLower).String(strconv.FormatBool(v.(bool))), false)
+	}
+
+	if v, ok := flattenedAction["role_arn"]; ok {
+		if s := v.(string); len(s) > 0 {
+			action.Properties["Octopus.Action.Aws.CloudFormation.RoleArn"] = core.NewPropertyValue(s, false)
+		}
+	}
+
+	if v, ok := flattenedAction["tags"]; ok {
+		tags := []cloudFormationTag{}
+		for key, value := range v.(map[string]interface{}) {
+			tags = append(tags, cloudFormationTag{Key: key, Value: value.(string)})
+		}
+		sort.Slice(tags, func(i, j int) bool {
+			return tags[i].Key < tags[j].Key
+		})
+
+		j, _ := json.Marshal(tags)
+		action.Properties["Octopus.Action.Aws.CloudFormation.Tags"] = core.NewPropertyValue(string(j), false)
+	}
+
+	return action
+}
+
+func expandDeleteAwsCloudFormationAction(flattenedAction map[string]interface{}) *deployments.DeploymentAction {
+	if len(flattenedAction) == 0 {
+		return nil
+	}
+
+	action := expandAction(flattenedAction)
+	if action == nil {
+		return nil
+	}
+
+	action.ActionType = "Octopus.AwsDeleteCloudFormation"
+	expandAwsCloudFormationStack(flattenedAction, action)
+
+	return action
+}
+
+func flattenAwsCloudFormationStack(action *deployments.DeploymentAction) map[string]interface{} {
+	flattenedAction := flattenAction(action)
+
+	if len(action.WorkerPool) > 0 {
+		flattenedAction["worker_pool_id"] = action.WorkerPool
+	}
+
+	if len(action.WorkerPoolVariable) > 0 {
+		flattenedAction["worker_pool_variable"] = action.WorkerPoolVariable
+	}
+
+	flattenedAction["aws_account"] = flattenTerraformTemplateAwsAccount(action.Properties)
+
+	for k, v := range action.Properties {
+		switch k {
+		case "Octopus.Action.RunOnServer":
+			runOnServer, _ := strconv.ParseBool(v.Value)
+			flattenedAction["run_on_server"] = runOnServer
+		case "Octopus.Action.Aws.CloudFormationStackName":
+			flattenedAction["stack_name"] = v.Value
+		case "Octopus.Action.Aws.WaitForCompletion":
+			waitForCompletion, _ := strconv.ParseBool(v.Value)
+			flattenedAction["wait_for_completion"] = waitForCompletion
+		}
+	}
+
+	return flattenedAction
+}
+
+func flattenDeployAwsCloudFormationAction(action *deployments.DeploymentAction) map[string]interface{} {
+	if action == nil {
+		return nil
+	}
+
+	flattenedAction := flattenAwsCloudFormationStack(action)
+
+	isPackage := action.Properties["Octopus.Action.Aws.TemplateSource"].Value == "Package"
+
+	for k, v := range action.Properties {
+		switch k {
+		case "Octopus.Action.Aws.CloudFormationTemplate":
+			if isPackage {
+				flattenedAction["template_file"] = v.Value
+			} else {
+				flattenedAction["inline_template"] = v.Value
+			}
+		case "Octopus.Action.Aws.CloudFormationTemplateParameters":
+			if isPackage {
+				flattenedAction["template_parameters_file"] = v.Value
+			} else {
+				var parameters []cloudFormationParameter
+				json.Unmarshal([]byte(v.Value), &parameters)
+
+				flattenedParameters := map[string]string{}
+				for _, parameter := range parameters {
+					flattenedParameters[parameter.ParameterKey] = parameter.ParameterValue
+				}
+				flattenedAction["parameters"] = flattenedParameters
+			}
+		case "Octopus.Action.Aws.IamCapabilities":
+			var capabilities []string
+			json.Unmarshal([]byte(v.Value), &capabilities)
+			flattenedAction["capabilities"] = capabilities
+		case "Octopus.Action.Aws.DisableRollback":
+			disableRollback, _ := strconv.ParseBool(v.Value)
+			flattenedAction["disable_rollback"] = disableRollback
+		case "Octopus.Action.Aws.CloudFormation.RoleArn":
+			flattenedAction["role_arn"] = v.Value
+		case "Octopus.Action.Aws.CloudFormation.Tags":
+			var tags []cloudFormationTag
+			json.Unmarshal([]byte(v.Value), &tags)
+
+			flattenedTags := map[string]string{}
+			for _, tag := range tags {
+				flattenedTags[tag.Key] = tag.Value
+			}
+			flattenedAction["tags"] = flattenedTags
+		}
+	}
+
+	return flattenedAction
+}
+
+func flattenDeleteAwsCloudFormationAction(action *deployments.DeploymentAction) map[string]interface{} {
+	if action == nil {
+		return nil
+	}
+
+	return flattenAwsCloudFormationStack(action)
+}
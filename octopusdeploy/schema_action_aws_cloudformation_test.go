@@ -0,0 +1,54 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandDeployAwsCloudFormationAction(t *testing.T) {
+	action := expandDeployAwsCloudFormationAction(nil)
+	require.Nil(t, action)
+
+	awsAccountSchema := getDeployAwsCloudFormationActionSchema().Elem.(*schema.Resource).Schema["aws_account"]
+	awsAccount := schema.NewSet(schema.HashResource(awsAccountSchema.Elem.(*schema.Resource)), []interface{}{
+		map[string]interface{}{
+			"region":            "us-east-1",
+			"role":              schema.NewSet(schema.HashResource(awsAccountSchema.Elem.(*schema.Resource).Schema["role"].Elem.(*schema.Resource)), []interface{}{}),
+			"use_instance_role": false,
+			"variable":          "AWS Account",
+		},
+	})
+
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	action = expandDeployAwsCloudFormationAction(map[string]interface{}{
+		"aws_account":         awsAccount,
+		"capabilities":        []interface{}{"CAPABILITY_IAM", "CAPABILITY_NAMED_IAM"},
+		"inline_template":     "Resources: {}",
+		"name":                name,
+		"parameters":          map[string]interface{}{"Environment": "Test", "BucketName": "bucket"},
+		"role_arn":            "arn:aws:iam::123456789012:role/cloudformation",
+		"stack_name":          "my-stack",
+		"tags":                map[string]interface{}{"Owner": "Platform"},
+		"wait_for_completion": true,
+	})
+	require.NotNil(t, action)
+	require.Equal(t, "Octopus.AwsRunCloudFormation", action.ActionType)
+	require.Equal(t, "Inline", action.Properties["Octopus.Action.Aws.TemplateSource"].Value)
+	require.Equal(t, `[{"ParameterKey":"BucketName","ParameterValue":"bucket"},{"ParameterKey":"Environment","ParameterValue":"Test"}]`, action.Properties["Octopus.Action.Aws.CloudFormationTemplateParameters"].Value)
+	require.Equal(t, `["CAPABILITY_IAM","CAPABILITY_NAMED_IAM"]`, action.Properties["Octopus.Action.Aws.IamCapabilities"].Value)
+	require.Equal(t, `[{"key":"Owner","value":"Platform"}]`, action.Properties["Octopus.Action.Aws.CloudFormation.Tags"].Value)
+	require.Equal(t, "AWS Account", action.Properties["Octopus.Action.AwsAccount.Variable"].Value)
+	require.Equal(t, "us-east-1", action.Properties["Octopus.Action.Aws.Region"].Value)
+	require.Equal(t, "True", action.Properties["Octopus.Action.Aws.WaitForCompletion"].Value)
+
+	flattenedAction := flattenDeployAwsCloudFormationAction(action)
+	require.Equal(t, "my-stack", flattenedAction["stack_name"])
+	require.Equal(t, "Resources: {}", flattenedAction["inline_template"])
+	require.Equal(t, map[string]string{"Environment": "Test", "BucketName": "bucket"}, flattenedAction["parameters"])
+	require.Equal(t, []string{"CAPABILITY_IAM", "CAPABILITY_NAMED_IAM"}, flattenedAction["capabilities"])
+	require.Equal(t, map[string]string{"Owner": "Platform"}, flattenedAction["tags"])
+	require.Equal(t, "arn:aws:iam::123456789012:role/cloudformation", flattenedAction["role_arn"])
+}
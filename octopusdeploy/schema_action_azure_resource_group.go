@@ -0,0 +1,172 @@
+package octopusdeploy
+
+import (
+	"strconv"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func getAzureResourceGroupActionSchema() *schema.Schema {
+	actionSchema, element := getActionSchema()
+	addExecutionLocationSchema(element)
+	addWorkerPoolSchema(element)
+	addWorkerPoolVariableSchema(element)
+	addPrimaryPackageSchema(element, false)
+
+	element.Schema["account_id"] = &schema.Schema{
+		Description:      "The ID of the Azure account (or a variable binding to one) used to deploy the template",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+	}
+
+	element.Schema["deployment_mode"] = &schema.Schema{
+		Default:     "Incremental",
+		Description: "The resource group deployment mode, one of 'Incremental' or 'Complete'",
+		Optional:    true,
+		Type:        schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+			"Complete",
+			"Incremental",
+		}, false)),
+	}
+
+	element.Schema["inline_template"] = &schema.Schema{
+		Description: "The ARM template (JSON) used when the template is not sourced from the primary package",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	element.Schema["resource_group_name"] = &schema.Schema{
+		Description:      "The name of the resource group to deploy the template to",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+	}
+
+	element.Schema["template_file"] = &schema.Schema{
+		Description: "The path of the template within the primary package",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	element.Schema["template_parameters"] = &schema.Schema{
+		Description: "The parameter values (JSON) for the inline template",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	element.Schema["template_parameters_file"] = &schema.Schema{
+		Description: "The path of the parameters file within the primary package",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	return actionSchema
+}
+
+func expandAzureResourceGroupAction(flattenedAction map[string]interface{}) *deployments.DeploymentAction {
+	if len(flattenedAction) == 0 {
+		return nil
+	}
+
+	action := expandAction(flattenedAction)
+	if action == nil {
+		return nil
+	}
+
+	action.ActionType = "Octopus.AzureResourceGroup"
+
+	if v, ok := flattenedAction["account_id"]; ok {
+		action.Properties["Octopus.Action.Azure.AccountId"] = core.NewPropertyValue(v.(string), false)
+	}
+
+	if v, ok := flattenedAction["resource_group_name"]; ok {
+		action.Properties["Octopus.Action.Azure.ResourceGroupName"] = core.NewPropertyValue(v.(string), false)
+	}
+
+	if v, ok := flattenedAction["deployment_mode"]; ok {
+		action.Properties["Octopus.Action.Azure.ResourceGroupDeploymentMode"] = core.NewPropertyValue(v.(string), false)
+	}
+
+	if v, ok := flattenedAction["primary_package"]; ok && len(v.([]interface{})) > 0 {
+		action.Properties["Octopus.Action.Azure.TemplateSource"] = core.NewPropertyValue("Package", false)
+
+		if v, ok := flattenedAction["template_file"]; ok {
+			action.Properties["Octopus.Action.Azure.ResourceGroupTemplate"] = core.NewPropertyValue(v.(string), false)
+		}
+
+		if v, ok := flattenedAction["template_parameters_file"]; ok {
+			action.Properties["Octopus.Action.Azure.ResourceGroupTemplateParameters"] = core.NewPropertyValue(v.(string), false)
+		}
+	} else {
+		action.Properties["Octopus.Action.Azure.TemplateSource"] = core.NewPropertyValue("Inline", false)
+
+		if v, ok := flattenedAction["inline_template"]; ok {
+			action.Properties["Octopus.Action.Azure.ResourceGroupTemplate"] = core.NewPropertyValue(v.(string), false)
+		}
+
+		if v, ok := flattenedAction["template_parameters"]; ok {
+			action.Properties["Octopus.Action.Azure.ResourceGroupTemplateParameters"] = core.NewPropertyValue(v.(string), false)
+		}
+	}
+
+	if v, ok := flattenedAction["worker_pool_id"]; ok {
+		action.WorkerPool = v.(string)
+	}
+
+	if v, ok := flattenedAction["worker_pool_variable"]; ok {
+		action.WorkerPoolVariable = v.(string)
+	}
+
+	return action
+}
+
+func flattenAzureResourceGroupAction(action *deployments.DeploymentAction) map[string]interface{} {
+	if action == nil {
+		return nil
+	}
+
+	flattenedAction := flattenAction(action)
+
+	if len(action.WorkerPool) > 0 {
+		flattenedAction["worker_pool_id"] = action.WorkerPool
+	}
+
+	if len(action.WorkerPoolVariable) > 0 {
+		flattenedAction["worker_pool_variable"] = action.WorkerPoolVariable
+	}
+
+	isPackage := action.Properties["Octopus.Action.Azure.TemplateSource"].Value == "Package"
+
+	for k, v := range action.Properties {
+		switch k {
+		case "Octopus.Action.RunOnServer":
+			runOnServer, _ := strconv.ParseBool(v.Value)
+			flattenedAction["run_on_server"] = runOnServer
+		case "Octopus.Action.Azure.AccountId":
+			flattenedAction["account_id"] = v.Value
+		case "Octopus.Action.Azure.ResourceGroupName":
+			flattenedAction["resource_group_name"] = v.Value
+		case "Octopus.Action.Azure.ResourceGroupDeploymentMode":
+			flattenedAction["deployment_mode"] = v.Value
+		case "Octopus.Action.Azure.ResourceGroupTemplate":
+			if isPackage {
+				flattenedAction["template_file"] = v.Value
+			} else {
+				flattenedAction["inline_template"] = v.Value
+			}
+		case "Octopus.Action.Azure.ResourceGroupTemplateParameters":
+			if isPackage {
+				flattenedAction["template_parameters_file"] = v.Value
+			} else {
+				flattenedAction["template_parameters"] = v.Value
+			}
+		}
+	}
+
+	return flattenedAction
+}
@@ -0,0 +1,34 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandAzureResourceGroupAction(t *testing.T) {
+	action := expandAzureResourceGroupAction(nil)
+	require.Nil(t, action)
+
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	action = expandAzureResourceGroupAction(map[string]interface{}{
+		"account_id":          "Accounts-1",
+		"deployment_mode":     "Complete",
+		"inline_template":     `{"resources":[]}`,
+		"name":                name,
+		"resource_group_name": "my-resource-group",
+		"template_parameters": `{"location":{"value":"westus"}}`,
+	})
+	require.NotNil(t, action)
+	require.Equal(t, "Octopus.AzureResourceGroup", action.ActionType)
+	require.Equal(t, "Accounts-1", action.Properties["Octopus.Action.Azure.AccountId"].Value)
+	require.Equal(t, "Complete", action.Properties["Octopus.Action.Azure.ResourceGroupDeploymentMode"].Value)
+	require.Equal(t, "Inline", action.Properties["Octopus.Action.Azure.TemplateSource"].Value)
+
+	flattenedAction := flattenAzureResourceGroupAction(action)
+	require.Equal(t, "my-resource-group", flattenedAction["resource_group_name"])
+	require.Equal(t, `{"resources":[]}`, flattenedAction["inline_template"])
+	require.Equal(t, `{"location":{"value":"westus"}}`, flattenedAction["template_parameters"])
+	require.NotContains(t, flattenedAction, "template_file")
+}
@@ -0,0 +1,99 @@
+package octopusdeploy
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandDeployReleaseAction(flattenedAction map[string]interface{}) *deployments.DeploymentAction {
+	if len(flattenedAction) == 0 {
+		return nil
+	}
+
+	action := expandAction(flattenedAction)
+	if action == nil {
+		return nil
+	}
+
+	action.ActionType = "Octopus.DeployRelease"
+
+	projectID := flattenedAction["project_id"].(string)
+	action.Properties["Octopus.Action.DeployRelease.ProjectId"] = core.NewPropertyValue(projectID, false)
+
+	if v, ok := flattenedAction["channel_id"]; ok && len(v.(string)) > 0 {
+		action.Properties["Octopus.Action.DeployRelease.Channel"] = core.NewPropertyValue(v.(string), false)
+	}
+
+	if v, ok := flattenedAction["deployment_condition"]; ok {
+		action.Properties["Octopus.Action.DeployRelease.DeploymentCondition"] = core.NewPropertyValue(v.(string), false)
+	}
+
+	// the release of the child project is selected through a package reference to the built-in releases feed
+	action.Packages = append(action.Packages, &packages.PackageReference{
+		AcquisitionLocation: "NotAcquired",
+		FeedID:              "feeds-builtin-releases",
+		PackageID:           projectID,
+		Properties:          map[string]string{},
+	})
+
+	return action
+}
+
+func flattenDeployReleaseAction(action *deployments.DeploymentAction) map[string]interface{} {
+	if action == nil {
+		return nil
+	}
+
+	flattenedAction := flattenAction(action)
+	delete(flattenedAction, "primary_package")
+
+	if v, ok := action.Properties["Octopus.Action.DeployRelease.ProjectId"]; ok {
+		flattenedAction["project_id"] = v.Value
+	}
+
+	if v, ok := action.Properties["Octopus.Action.DeployRelease.Channel"]; ok {
+		flattenedAction["channel_id"] = v.Value
+	}
+
+	if v, ok := action.Properties["Octopus.Action.DeployRelease.DeploymentCondition"]; ok {
+		flattenedAction["deployment_condition"] = v.Value
+	}
+
+	return flattenedAction
+}
+
+func getDeployReleaseActionSchema() *schema.Schema {
+	actionSchema, element := getActionSchema()
+	delete(element.Schema, "package")
+
+	element.Schema["channel_id"] = &schema.Schema{
+		Description:      "The ID of the channel of the child project whose release will be deployed. The default channel of the child project is used when it is not set.",
+		Optional:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+	}
+
+	element.Schema["deployment_condition"] = &schema.Schema{
+		Default:     "Always",
+		Description: "When to deploy the release of the child project, one of 'Always', 'IfNotCurrentVersion' or 'IfNewer'",
+		Optional:    true,
+		Type:        schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+			"Always",
+			"IfNewer",
+			"IfNotCurrentVersion",
+		}, false)),
+	}
+
+	element.Schema["project_id"] = &schema.Schema{
+		Description:      "The ID of the child project whose release will be deployed",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+	}
+
+	return actionSchema
+}
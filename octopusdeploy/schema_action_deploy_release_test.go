@@ -0,0 +1,52 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandDeployReleaseAction(t *testing.T) {
+	action := expandDeployReleaseAction(nil)
+	require.Nil(t, action)
+
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	action = expandDeployReleaseAction(map[string]interface{}{
+		"deployment_condition": "IfNewer",
+		"name":                 name,
+		"project_id":           "Projects-123",
+	})
+	require.NotNil(t, action)
+	require.Equal(t, "Octopus.DeployRelease", action.ActionType)
+	require.Equal(t, "Projects-123", action.Properties["Octopus.Action.DeployRelease.ProjectId"].Value)
+	require.Equal(t, "IfNewer", action.Properties["Octopus.Action.DeployRelease.DeploymentCondition"].Value)
+	require.Len(t, action.Packages, 1)
+	require.Equal(t, "feeds-builtin-releases", action.Packages[0].FeedID)
+	require.Equal(t, "Projects-123", action.Packages[0].PackageID)
+
+	flattenedAction := flattenDeployReleaseAction(action)
+	require.Equal(t, name, flattenedAction["name"])
+	require.Equal(t, "Projects-123", flattenedAction["project_id"])
+	require.Equal(t, "IfNewer", flattenedAction["deployment_condition"])
+	require.NotContains(t, flattenedAction, "primary_package")
+}
+
+func TestDeployReleaseActionChannel(t *testing.T) {
+	action := expandDeployReleaseAction(map[string]interface{}{
+		"channel_id": "Channels-42",
+		"name":       "Deploy child",
+		"project_id": "Projects-123",
+	})
+	require.Equal(t, "Channels-42", action.Properties["Octopus.Action.DeployRelease.Channel"].Value)
+	require.Equal(t, "Channels-42", flattenDeployReleaseAction(action)["channel_id"])
+
+	// the default channel of the child project is used when no channel is given
+	action = expandDeployReleaseAction(map[string]interface{}{
+		"channel_id": "",
+		"name":       "Deploy child",
+		"project_id": "Projects-123",
+	})
+	require.NotContains(t, action.Properties, "Octopus.Action.DeployRelease.Channel")
+	require.NotContains(t, flattenDeployReleaseAction(action), "channel_id")
+}
@@ -0,0 +1,346 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+type iisBinding struct {
+	CertificateVariable string `json:"certificateVariable"`
+	Enabled             bool   `json:"enabled"`
+	Host                string `json:"host"`
+	IPAddress           string `json:"ipAddress"`
+	Port                string `json:"port"`
+	Protocol            string `json:"protocol"`
+	RequireSni          bool   `json:"requireSni"`
+	Thumbprint          string `json:"thumbprint"`
+}
+
+func getDeployToIisActionSchema() *schema.Schema {
+	actionSchema, element := getActionSchema()
+	addPrimaryPackageSchema(element, true)
+
+	element.Schema["application_pool_framework_version"] = &schema.Schema{
+		Default:     "v4.0",
+		Description: "The version of the .NET common language runtime loaded by the application pool, one of 'v2.0', 'v4.0' or 'No Managed Code'",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+	element.Schema["application_pool_identity"] = &schema.Schema{
+		Default:     "ApplicationPoolIdentity",
+		Description: "The identity the application pool runs as, one of 'ApplicationPoolIdentity', 'LocalService', 'LocalSystem', 'NetworkService' or 'SpecificUser'",
+		Optional:    true,
+		Type:        schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+			"ApplicationPoolIdentity",
+			"LocalService",
+			"LocalSystem",
+			"NetworkService",
+			"SpecificUser",
+		}, false)),
+	}
+	element.Schema["application_pool_name"] = &schema.Schema{
+		Description:      "The name of the application pool",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+	}
+	element.Schema["application_pool_password"] = &schema.Schema{
+		Description: "The password of the user the application pool runs as when 'application_pool_identity' is 'SpecificUser'",
+		Optional:    true,
+		Sensitive:   true,
+		Type:        schema.TypeString,
+	}
+	element.Schema["application_pool_username"] = &schema.Schema{
+		Description: "The user the application pool runs as when 'application_pool_identity' is 'SpecificUser'",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+	element.Schema["binding"] = &schema.Schema{
+		Description: "The bindings of the web site",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"certificate_variable": {
+					Description: "The name of the certificate variable used by HTTPS bindings",
+					Optional:    true,
+					Type:        schema.TypeString,
+				},
+				"enabled": {
+					Default:  true,
+					Optional: true,
+					Type:     schema.TypeBool,
+				},
+				"host": {
+					Description: "The host name of the binding",
+					Optional:    true,
+					Type:        schema.TypeString,
+				},
+				"ip_address": {
+					Default:     "*",
+					Description: "The IP address of the binding",
+					Optional:    true,
+					Type:        schema.TypeString,
+				},
+				"port": {
+					Default:     "80",
+					Description: "The port of the binding",
+					Optional:    true,
+					Type:        schema.TypeString,
+				},
+				"protocol": {
+					Default:     "http",
+					Description: "The protocol of the binding, one of 'http' or 'https'",
+					Optional:    true,
+					Type:        schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+						"http",
+						"https",
+					}, false)),
+				},
+				"require_sni": {
+					Default:     false,
+					Description: "Whether the binding requires Server Name Indication",
+					Optional:    true,
+					Type:        schema.TypeBool,
+				},
+				"thumbprint": {
+					Description: "The thumbprint of the certificate used by HTTPS bindings",
+					Optional:    true,
+					Type:        schema.TypeString,
+				},
+			},
+		},
+		Optional: true,
+		Type:     schema.TypeList,
+	}
+	element.Schema["deployment_type"] = &schema.Schema{
+		Default:     "webSite",
+		Description: "Whether to deploy an IIS web site ('webSite') or a web application within an existing web site ('webApplication')",
+		Optional:    true,
+		Type:        schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+			"webApplication",
+			"webSite",
+		}, false)),
+	}
+	element.Schema["enable_anonymous_authentication"] = &schema.Schema{
+		Default:  true,
+		Optional: true,
+		Type:     schema.TypeBool,
+	}
+	element.Schema["enable_basic_authentication"] = &schema.Schema{
+		Default:  false,
+		Optional: true,
+		Type:     schema.TypeBool,
+	}
+	element.Schema["enable_windows_authentication"] = &schema.Schema{
+		Default:  false,
+		Optional: true,
+		Type:     schema.TypeBool,
+	}
+	element.Schema["start_application_pool"] = &schema.Schema{
+		Default:  true,
+		Optional: true,
+		Type:     schema.TypeBool,
+	}
+	element.Schema["start_web_site"] = &schema.Schema{
+		Default:  true,
+		Optional: true,
+		Type:     schema.TypeBool,
+	}
+	element.Schema["virtual_path"] = &schema.Schema{
+		Description: "The virtual path of the web application when 'deployment_type' is 'webApplication'",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+	element.Schema["web_site_name"] = &schema.Schema{
+		Description:      "The name of the web site, or of the parent web site when 'deployment_type' is 'webApplication'",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+	}
+
+	return actionSchema
+}
+
+// getIisApplicationPoolPropertyPrefix returns the prefix of the application pool properties, which differ between web
+// sites and web applications
+func getIisApplicationPoolPropertyPrefix(deploymentType string) string {
+	if deploymentType == "webApplication" {
+		return "Octopus.Action.IISWebSite.WebApplication."
+	}
+	return "Octopus.Action.IISWebSite."
+}
+
+func expandDeployToIisAction(flattenedAction map[string]interface{}) *deployments.DeploymentAction {
+	if len(flattenedAction) == 0 {
+		return nil
+	}
+
+	action := expandAction(flattenedAction)
+	if action == nil {
+		return nil
+	}
+
+	action.ActionType = "Octopus.IIS"
+
+	if len(action.Properties["Octopus.Action.EnabledFeatures"].Value) == 0 {
+		action.Properties["Octopus.Action.EnabledFeatures"] = core.NewPropertyValue("Octopus.Features.IISWebSite", false)
+	} else if !strings.Contains(action.Properties["Octopus.Action.EnabledFeatures"].Value, "Octopus.Features.IISWebSite") {
+		actionPropertyValue := action.Properties["Octopus.Action.EnabledFeatures"].Value + ",Octopus.Features.IISWebSite"
+		action.Properties["Octopus.Action.EnabledFeatures"] = core.NewPropertyValue(actionPropertyValue, false)
+	}
+
+	deploymentType := flattenedAction["deployment_type"].(string)
+	action.Properties["Octopus.Action.IISWebSite.DeploymentType"] = core.NewPropertyValue(deploymentType, false)
+	action.Properties["Octopus.Action.IISWebSite.CreateOrUpdateWebSite"] = core.NewPropertyValue(cases.Title(language.Und, cases.NoLower).String(strconv.FormatBool(deploymentType == "webSite")), false)
+	action.Properties["Octopus.Action.IISWebSite.WebRootType"] = core.NewPropertyValue("packageRoot", false)
+
+	if deploymentType == "webApplication" {
+		action.Properties["Octopus.Action.IISWebSite.WebApplication.WebSiteName"] = core.NewPropertyValue(flattenedAction["web_site_name"].(string), false)
+
+		if v, ok := flattenedAction["virtual_path"]; ok {
+			action.Properties["Octopus.Action.IISWebSite.WebApplication.VirtualPath"] = core.NewPropertyValue(v.(string), false)
+		}
+	} else {
+		action.Properties["Octopus.Action.IISWebSite.WebSiteName"] = core.NewPropertyValue(flattenedAction["web_site_name"].(string), false)
+	}
+
+	prefix := getIisApplicationPoolPropertyPrefix(deploymentType)
+	action.Properties[prefix+"ApplicationPoolName"] = core.NewPropertyValue(flattenedAction["application_pool_name"].(string), false)
+
+	if v, ok := flattenedAction["application_pool_framework_version"]; ok {
+		action.Properties[prefix+"ApplicationPoolFrameworkVersion"] = core.NewPropertyValue(v.(string), false)
+	}
+
+	if v, ok := flattenedAction["application_pool_identity"]; ok {
+		action.Properties[prefix+"ApplicationPoolIdentityType"] = core.NewPropertyValue(v.(string), false)
+	}
+
+	if v, ok := flattenedAction["application_pool_username"]; ok {
+		if s := v.(string); len(s) > 0 {
+			action.Properties[prefix+"ApplicationPoolUsername"] = core.NewPropertyValue(s, false)
+		}
+	}
+
+	if v, ok := flattenedAction["application_pool_password"]; ok {
+		if s := v.(string); len(s) > 0 {
+			action.Properties[prefix+"ApplicationPoolPassword"] = core.NewPropertyValue(s, false)
+		}
+	}
+
+	if v, ok := flattenedAction["binding"]; ok {
+		bindings := []iisBinding{}
+		for _, tfBinding := range v.([]interface{}) {
+			flattenedBinding := tfBinding.(map[string]interface{})
+			bindings = append(bindings, iisBinding{
+				CertificateVariable: flattenedBinding["certificate_variable"].(string),
+				Enabled:             flattenedBinding["enabled"].(bool),
+				Host:                flattenedBinding["host"].(string),
+				IPAddress:           flattenedBinding["ip_address"].(string),
+				Port:                flattenedBinding["port"].(string),
+				Protocol:            flattenedBinding["protocol"].(string),
+				RequireSni:          flattenedBinding["require_sni"].(bool),
+				Thumbprint:          flattenedBinding["thumbprint"].(string),
+			})
+		}
+
+		j, _ := json.Marshal(bindings)
+		action.Properties["Octopus.Action.IISWebSite.Bindings"] = core.NewPropertyValue(string(j), false)
+	}
+
+	booleanProperties := map[string]string{
+		"enable_anonymous_authentication": "Octopus.Action.IISWebSite.EnableAnonymousAuthentication",
+		"enable_basic_authentication":     "Octopus.Action.IISWebSite.EnableBasicAuthentication",
+		"enable_windows_authentication":   "Octopus.Action.IISWebSite.EnableWindowsAuthentication",
+		"start_application_pool":          "Octopus.Action.IISWebSite.StartApplicationPool",
+		"start_web_site":                  "Octopus.Action.IISWebSite.StartWebSite",
+	}
+
+	for attribute, property := range booleanProperties {
+		if v, ok := flattenedAction[attribute]; ok {
+			action.Properties[property] = core.NewPropertyValue(cases.Title(language.Und, cases.NoLower).String(strconv.FormatBool(v.(bool))), false)
+		}
+	}
+
+	return action
+}
+
+func flattenDeployToIisAction(action *deployments.DeploymentAction) map[string]interface{} {
+	if action == nil {
+		return nil
+	}
+
+	flattenedAction := flattenAction(action)
+
+	deploymentType := "webSite"
+	if v, ok := action.Properties["Octopus.Action.IISWebSite.DeploymentType"]; ok && len(v.Value) > 0 {
+		deploymentType = v.Value
+	}
+	flattenedAction["deployment_type"] = deploymentType
+
+	prefix := getIisApplicationPoolPropertyPrefix(deploymentType)
+
+	for propertyName, propertyValue := range action.Properties {
+		switch propertyName {
+		case "Octopus.Action.IISWebSite.WebSiteName":
+			if deploymentType == "webSite" {
+				flattenedAction["web_site_name"] = propertyValue.Value
+			}
+		case "Octopus.Action.IISWebSite.WebApplication.WebSiteName":
+			if deploymentType == "webApplication" {
+				flattenedAction["web_site_name"] = propertyValue.Value
+			}
+		case "Octopus.Action.IISWebSite.WebApplication.VirtualPath":
+			flattenedAction["virtual_path"] = propertyValue.Value
+		case prefix + "ApplicationPoolName":
+			flattenedAction["application_pool_name"] = propertyValue.Value
+		case prefix + "ApplicationPoolFrameworkVersion":
+			flattenedAction["application_pool_framework_version"] = propertyValue.Value
+		case prefix + "ApplicationPoolIdentityType":
+			flattenedAction["application_pool_identity"] = propertyValue.Value
+		case prefix + "ApplicationPoolUsername":
+			flattenedAction["application_pool_username"] = propertyValue.Value
+		case prefix + "ApplicationPoolPassword":
+			flattenedAction["application_pool_password"] = propertyValue.Value
+		case "Octopus.Action.IISWebSite.Bindings":
+			var bindings []iisBinding
+			json.Unmarshal([]byte(propertyValue.Value), &bindings)
+
+			flattenedBindings := []interface{}{}
+			for _, binding := range bindings {
+				flattenedBindings = append(flattenedBindings, map[string]interface{}{
+					"certificate_variable": binding.CertificateVariable,
+					"enabled":              binding.Enabled,
+					"host":                 binding.Host,
+					"ip_address":           binding.IPAddress,
+					"port":                 binding.Port,
+					"protocol":             binding.Protocol,
+					"require_sni":          binding.RequireSni,
+					"thumbprint":           binding.Thumbprint,
+				})
+			}
+			flattenedAction["binding"] = flattenedBindings
+		case "Octopus.Action.IISWebSite.EnableAnonymousAuthentication":
+			flattenedAction["enable_anonymous_authentication"], _ = strconv.ParseBool(propertyValue.Value)
+		case "Octopus.Action.IISWebSite.EnableBasicAuthentication":
+			flattenedAction["enable_basic_authentication"], _ = strconv.ParseBool(propertyValue.Value)
+		case "Octopus.Action.IISWebSite.EnableWindowsAuthentication":
+			flattenedAction["enable_windows_authentication"], _ = strconv.ParseBool(propertyValue.Value)
+		case "Octopus.Action.IISWebSite.StartApplicationPool":
+			flattenedAction["start_application_pool"], _ = strconv.ParseBool(propertyValue.Value)
+		case "Octopus.Action.IISWebSite.StartWebSite":
+			flattenedAction["start_web_site"], _ = strconv.ParseBool(propertyValue.Value)
+		}
+	}
+
+	return flattenedAction
+}
@@ -0,0 +1,81 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandDeployToIisAction(t *testing.T) {
+	action := expandDeployToIisAction(nil)
+	require.Nil(t, action)
+
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	action = expandDeployToIisAction(map[string]interface{}{
+		"application_pool_framework_version": "v4.0",
+		"application_pool_identity":          "SpecificUser",
+		"application_pool_name":              "MyAppPool",
+		"application_pool_password":          "secret",
+		"application_pool_username":          "DOMAIN\\user",
+		"binding": []interface{}{
+			map[string]interface{}{
+				"certificate_variable": "",
+				"enabled":              true,
+				"host":                 "example.com",
+				"ip_address":           "*",
+				"port":                 "80",
+				"protocol":             "http",
+				"require_sni":          false,
+				"thumbprint":           "",
+			},
+		},
+		"deployment_type":                 "webSite",
+		"enable_anonymous_authentication": false,
+		"enable_windows_authentication":   true,
+		"name":                            name,
+		"web_site_name":                   "MySite",
+	})
+	require.NotNil(t, action)
+	require.Equal(t, "Octopus.IIS", action.ActionType)
+	require.Equal(t, "Octopus.Features.IISWebSite", action.Properties["Octopus.Action.EnabledFeatures"].Value)
+	require.Equal(t, "MySite", action.Properties["Octopus.Action.IISWebSite.WebSiteName"].Value)
+	require.Equal(t, "True", action.Properties["Octopus.Action.IISWebSite.CreateOrUpdateWebSite"].Value)
+	require.Equal(t, "MyAppPool", action.Properties["Octopus.Action.IISWebSite.ApplicationPoolName"].Value)
+	require.Equal(t, "secret", action.Properties["Octopus.Action.IISWebSite.ApplicationPoolPassword"].Value)
+	require.Equal(t, "False", action.Properties["Octopus.Action.IISWebSite.EnableAnonymousAuthentication"].Value)
+	require.Equal(t, "True", action.Properties["Octopus.Action.IISWebSite.EnableWindowsAuthentication"].Value)
+	require.JSONEq(t, `[{"certificateVariable":"","enabled":true,"host":"example.com","ipAddress":"*","port":"80","protocol":"http","requireSni":false,"thumbprint":""}]`, action.Properties["Octopus.Action.IISWebSite.Bindings"].Value)
+
+	flattenedAction := flattenDeployToIisAction(action)
+	require.Equal(t, "webSite", flattenedAction["deployment_type"])
+	require.Equal(t, "MySite", flattenedAction["web_site_name"])
+	require.Equal(t, "MyAppPool", flattenedAction["application_pool_name"])
+	require.Equal(t, "SpecificUser", flattenedAction["application_pool_identity"])
+	require.Equal(t, false, flattenedAction["enable_anonymous_authentication"])
+	require.Equal(t, true, flattenedAction["enable_windows_authentication"])
+	require.Len(t, flattenedAction["binding"], 1)
+	require.Equal(t, "secret", flattenedAction["application_pool_password"])
+}
+
+func TestExpandDeployToIisActionWebApplication(t *testing.T) {
+	action := expandDeployToIisAction(map[string]interface{}{
+		"application_pool_name": "MyAppPool",
+		"deployment_type":       "webApplication",
+		"name":                  acctest.RandStringFromCharSet(20, acctest.CharSetAlpha),
+		"virtual_path":          "/api",
+		"web_site_name":         "Default Web Site",
+	})
+	require.NotNil(t, action)
+	require.Equal(t, "False", action.Properties["Octopus.Action.IISWebSite.CreateOrUpdateWebSite"].Value)
+	require.Equal(t, "Default Web Site", action.Properties["Octopus.Action.IISWebSite.WebApplication.WebSiteName"].Value)
+	require.Equal(t, "/api", action.Properties["Octopus.Action.IISWebSite.WebApplication.VirtualPath"].Value)
+	require.Equal(t, "MyAppPool", action.Properties["Octopus.Action.IISWebSite.WebApplication.ApplicationPoolName"].Value)
+	require.NotContains(t, action.Properties, "Octopus.Action.IISWebSite.WebSiteName")
+
+	flattenedAction := flattenDeployToIisAction(action)
+	require.Equal(t, "webApplication", flattenedAction["deployment_type"])
+	require.Equal(t, "Default Web Site", flattenedAction["web_site_name"])
+	require.Equal(t, "/api", flattenedAction["virtual_path"])
+	require.Equal(t, "MyAppPool", flattenedAction["application_pool_name"])
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
@@ -77,9 +78,10 @@ func addDeployWindowsServiceSchema(element *schema.Resource) {
 		Type:        schema.TypeString,
 	}
 	element.Schema["executable_path"] = &schema.Schema{
-		Description: "The path to the executable relative to the package installation directory",
-		Required:    true,
-		Type:        schema.TypeString,
+		Description:      "The path to the executable relative to the package installation directory",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
 	}
 	element.Schema["service_account"] = &schema.Schema{
 		Description: "Which built-in account will the service run under. Can be LocalSystem, NT Authority\\NetworkService, NT Authority\\LocalService, _CUSTOM or an expression",
@@ -88,9 +90,10 @@ func addDeployWindowsServiceSchema(element *schema.Resource) {
 		Type:        schema.TypeString,
 	}
 	element.Schema["service_name"] = &schema.Schema{
-		Description: "The name of the service",
-		Required:    true,
-		Type:        schema.TypeString,
+		Description:      "The name of the service",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
 	}
 	element.Schema["start_mode"] = &schema.Schema{
 		Default:     "auto",
@@ -101,7 +104,15 @@ func addDeployWindowsServiceSchema(element *schema.Resource) {
 }
 
 func expandDeployWindowsServiceAction(flattenedAction map[string]interface{}) *deployments.DeploymentAction {
+	if len(flattenedAction) == 0 {
+		return nil
+	}
+
 	action := expandAction(flattenedAction)
+	if action == nil {
+		return nil
+	}
+
 	action.ActionType = "Octopus.WindowsService"
 
 	addWindowsServiceToActionResource(flattenedAction, action)
@@ -144,6 +155,10 @@ func flattenWindowsService(properties map[string]core.PropertyValue) []interface
 }
 
 func flattenDeployWindowsServiceAction(action *deployments.DeploymentAction) map[string]interface{} {
+	if action == nil {
+		return nil
+	}
+
 	flattenedAction := flattenAction(action)
 
 	for propertyName, propertyValue := range action.Properties {
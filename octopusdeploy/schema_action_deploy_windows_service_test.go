@@ -4,11 +4,41 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
 )
 
+func TestExpandDeployWindowsServiceAction(t *testing.T) {
+	action := expandDeployWindowsServiceAction(nil)
+	require.Nil(t, action)
+
+	action = expandDeployWindowsServiceAction(map[string]interface{}{
+		"custom_account_name":     "User",
+		"custom_account_password": "Password",
+		"dependencies":            "OtherService",
+		"executable_path":         "MyService.exe",
+		"name":                    "Test",
+		"service_account":         "_CUSTOM",
+		"service_name":            "MyService",
+		"start_mode":              "delayed-auto",
+	})
+	require.NotNil(t, action)
+	require.Equal(t, "Octopus.WindowsService", action.ActionType)
+	require.Equal(t, "Octopus.Features.WindowsService", action.Properties["Octopus.Action.EnabledFeatures"].Value)
+	require.Equal(t, "delayed-auto", action.Properties["Octopus.Action.WindowsService.StartMode"].Value)
+
+	flattenedAction := flattenDeployWindowsServiceAction(action)
+	require.Equal(t, "MyService", flattenedAction["service_name"])
+	require.Equal(t, "MyService.exe", flattenedAction["executable_path"])
+	require.Equal(t, "_CUSTOM", flattenedAction["service_account"])
+	require.Equal(t, "User", flattenedAction["custom_account_name"])
+	require.Equal(t, "Password", flattenedAction["custom_account_password"])
+	require.Equal(t, "OtherService", flattenedAction["dependencies"])
+
+	require.Nil(t, flattenDeployWindowsServiceAction(nil))
+}
+
 func TestAccOctopusDeployDeployWindowsServiceAction(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		CheckDestroy: resource.ComposeTestCheckFunc(
@@ -98,7 +128,7 @@ func testAccWindowsServiceFeature() string {
 
 func testAccCheckDeployWindowsServiceActionOrFeature(expectedActionType string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*client.Client)
+		client := getClient(testAccProvider.Meta())
 
 		process, err := getDeploymentProcess(s, client)
 		if err != nil {
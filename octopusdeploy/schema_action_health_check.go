@@ -0,0 +1,97 @@
+package octopusdeploy
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandHealthCheckAction(flattenedAction map[string]interface{}) *deployments.DeploymentAction {
+	if len(flattenedAction) == 0 {
+		return nil
+	}
+
+	action := expandAction(flattenedAction)
+	if action == nil {
+		return nil
+	}
+
+	action.ActionType = "Octopus.HealthCheck"
+
+	if v, ok := flattenedAction["health_check_type"]; ok {
+		action.Properties["Octopus.Action.HealthCheck.Type"] = core.NewPropertyValue(v.(string), false)
+	}
+
+	if v, ok := flattenedAction["error_handling"]; ok {
+		action.Properties["Octopus.Action.HealthCheck.ErrorHandling"] = core.NewPropertyValue(v.(string), false)
+	}
+
+	if v, ok := flattenedAction["include_machines_in_deployment"]; ok {
+		action.Properties["Octopus.Action.HealthCheck.IncludeMachinesInDeployment"] = core.NewPropertyValue(v.(string), false)
+	}
+
+	return action
+}
+
+func flattenHealthCheckAction(action *deployments.DeploymentAction) map[string]interface{} {
+	if action == nil {
+		return nil
+	}
+
+	flattenedAction := flattenAction(action)
+
+	if v, ok := action.Properties["Octopus.Action.HealthCheck.Type"]; ok {
+		flattenedAction["health_check_type"] = v.Value
+	}
+
+	if v, ok := action.Properties["Octopus.Action.HealthCheck.ErrorHandling"]; ok {
+		flattenedAction["error_handling"] = v.Value
+	}
+
+	if v, ok := action.Properties["Octopus.Action.HealthCheck.IncludeMachinesInDeployment"]; ok {
+		flattenedAction["include_machines_in_deployment"] = v.Value
+	}
+
+	return flattenedAction
+}
+
+func getHealthCheckActionSchema() *schema.Schema {
+	actionSchema, element := getActionSchema()
+	actionSchema.Description = "Checks the health of the deployment targets in the target roles of the step. The health check step has no timeout of its own; each check is bounded by the machine policy of the deployment target."
+
+	element.Schema["error_handling"] = &schema.Schema{
+		Default:     "TreatExceptionsAsErrors",
+		Description: "How to handle deployment targets that fail the health check, one of 'TreatExceptionsAsErrors' (fail the deployment) or 'TreatExceptionsAsWarnings' (skip deployment targets that are unavailable)",
+		Optional:    true,
+		Type:        schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+			"TreatExceptionsAsErrors",
+			"TreatExceptionsAsWarnings",
+		}, false)),
+	}
+
+	element.Schema["health_check_type"] = &schema.Schema{
+		Default:     "FullHealthCheck",
+		Description: "The type of health check to perform, one of 'FullHealthCheck' or 'ConnectionTest'",
+		Optional:    true,
+		Type:        schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+			"ConnectionTest",
+			"FullHealthCheck",
+		}, false)),
+	}
+
+	element.Schema["include_machines_in_deployment"] = &schema.Schema{
+		Default:     "DoNotAlterMachines",
+		Description: "Whether deployment targets that become available during the deployment are included in the remaining steps, one of 'DoNotAlterMachines' or 'IncludeCheckedMachines'",
+		Optional:    true,
+		Type:        schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+			"DoNotAlterMachines",
+			"IncludeCheckedMachines",
+		}, false)),
+	}
+
+	return actionSchema
+}
@@ -0,0 +1,117 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandHealthCheckAction(t *testing.T) {
+	action := expandHealthCheckAction(nil)
+	require.Nil(t, action)
+
+	action = expandHealthCheckAction(map[string]interface{}{})
+	require.Nil(t, action)
+
+	action = expandHealthCheckAction(map[string]interface{}{
+		"error_handling":                 "TreatExceptionsAsWarnings",
+		"health_check_type":              "ConnectionTest",
+		"include_machines_in_deployment": "IncludeCheckedMachines",
+		"name":                           acctest.RandStringFromCharSet(20, acctest.CharSetAlpha),
+	})
+	require.NotNil(t, action)
+	require.Equal(t, "Octopus.HealthCheck", action.ActionType)
+	require.Equal(t, "TreatExceptionsAsWarnings", action.Properties["Octopus.Action.HealthCheck.ErrorHandling"].Value)
+	require.Equal(t, "ConnectionTest", action.Properties["Octopus.Action.HealthCheck.Type"].Value)
+	require.Equal(t, "IncludeCheckedMachines", action.Properties["Octopus.Action.HealthCheck.IncludeMachinesInDeployment"].Value)
+}
+
+func TestFlattenHealthCheckAction(t *testing.T) {
+	require.Nil(t, flattenHealthCheckAction(nil))
+
+	action := expandHealthCheckAction(map[string]interface{}{
+		"error_handling":                 "TreatExceptionsAsWarnings",
+		"health_check_type":              "ConnectionTest",
+		"include_machines_in_deployment": "IncludeCheckedMachines",
+		"name":                           "Check targets",
+	})
+
+	flattenedAction := flattenHealthCheckAction(action)
+	require.Equal(t, "Check targets", flattenedAction["name"])
+	require.Equal(t, "TreatExceptionsAsWarnings", flattenedAction["error_handling"])
+	require.Equal(t, "ConnectionTest", flattenedAction["health_check_type"])
+	require.Equal(t, "IncludeCheckedMachines", flattenedAction["include_machines_in_deployment"])
+
+	// every flattened attribute is part of the schema of the action
+	element := getHealthCheckActionSchema().Elem.(*schema.Resource)
+	for key := range flattenedAction {
+		require.Contains(t, element.Schema, key)
+	}
+}
+
+func TestAccOctopusDeployHealthCheckAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccProjectCheckDestroy,
+			testAccProjectGroupCheckDestroy,
+			testAccLifecycleCheckDestroy,
+		),
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHealthCheckAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHealthCheckAction(),
+				),
+			},
+		},
+	})
+}
+
+func testAccHealthCheckAction() string {
+	return testAccBuildTestAction(`
+		health_check_action {
+			name                           = "Test"
+			error_handling                 = "TreatExceptionsAsWarnings"
+			health_check_type              = "ConnectionTest"
+			include_machines_in_deployment = "IncludeCheckedMachines"
+		}
+	`)
+}
+
+func testAccCheckHealthCheckAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := getClient(testAccProvider.Meta())
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.HealthCheck" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Octopus.Action.HealthCheck.Type"].Value != "ConnectionTest" {
+			return fmt.Errorf("Type is incorrect: %s", action.Properties["Octopus.Action.HealthCheck.Type"].Value)
+		}
+
+		if action.Properties["Octopus.Action.HealthCheck.ErrorHandling"].Value != "TreatExceptionsAsWarnings" {
+			return fmt.Errorf("ErrorHandling is incorrect: %s", action.Properties["Octopus.Action.HealthCheck.ErrorHandling"].Value)
+		}
+
+		if action.Properties["Octopus.Action.HealthCheck.IncludeMachinesInDeployment"].Value != "IncludeCheckedMachines" {
+			return fmt.Errorf("IncludeMachinesInDeployment is incorrect: %s", action.Properties["Octopus.Action.HealthCheck.IncludeMachinesInDeployment"].Value)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,142 @@
+package octopusdeploy
+
+import (
+	"strconv"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+func getKustomizeActionSchema() *schema.Schema {
+	actionSchema, element := getActionSchema()
+	actionSchema.Description = "Applies a Kustomize overlay from the files of its primary package. Kustomize steps that take their files from a Git repository are not supported: the Octopus client that the provider uses does not model the Git dependencies of actions, so they would be removed whenever the process is updated."
+	addExecutionLocationSchema(element)
+	addWorkerPoolSchema(element)
+	addWorkerPoolVariableSchema(element)
+	addPrimaryPackageSchema(element, true)
+
+	element.Schema["kubernetes_object_status_check_enabled"] = &schema.Schema{
+		Default:     true,
+		Description: "Whether to wait for the applied Kubernetes resources to become ready",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+
+	element.Schema["overlay_path"] = &schema.Schema{
+		Description:      "The path, relative to the root of the package, of the directory containing the kustomization file to apply",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+	}
+
+	element.Schema["server_side_apply_enabled"] = &schema.Schema{
+		Default:     true,
+		Description: "Whether to use server-side apply",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+
+	element.Schema["server_side_apply_force_conflicts"] = &schema.Schema{
+		Default:     true,
+		Description: "Whether to force conflicts when using server-side apply",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+
+	element.Schema["variable_substitution_in_files"] = &schema.Schema{
+		Description: "A newline-separated list of file names to substitute variables in, relative to the package contents. Extended wildcard syntax is supported.",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	return actionSchema
+}
+
+func expandKustomizeAction(flattenedAction map[string]interface{}) *deployments.DeploymentAction {
+	if len(flattenedAction) == 0 {
+		return nil
+	}
+
+	action := expandAction(flattenedAction)
+	if action == nil {
+		return nil
+	}
+
+	action.ActionType = "Octopus.Kubernetes.Kustomize"
+	action.Properties["Octopus.Action.Script.ScriptSource"] = core.NewPropertyValue("Package", false)
+
+	if v, ok := flattenedAction["overlay_path"]; ok {
+		action.Properties["Octopus.Action.Kubernetes.Kustomize.OverlayPath"] = core.NewPropertyValue(v.(string), false)
+	}
+
+	if v, ok := flattenedAction["kubernetes_object_status_check_enabled"]; ok {
+		action.Properties["Octopus.Action.Kubernetes.ResourceStatusCheck"] = core.NewPropertyValue(cases.Title(language.Und, cases.NoLower).String(strconv.FormatBool(v.(bool))), false)
+	}
+
+	if v, ok := flattenedAction["server_side_apply_enabled"]; ok {
+		action.Properties["Octopus.Action.Kubernetes.ServerSideApply.Enabled"] = core.NewPropertyValue(cases.Title(language.Und, cases.NoLower).String(strconv.FormatBool(v.(bool))), false)
+	}
+
+	if v, ok := flattenedAction["server_side_apply_force_conflicts"]; ok {
+		action.Properties["Octopus.Action.Kubernetes.ServerSideApply.ForceConflicts"] = core.NewPropertyValue(cases.Title(language.Und, cases.NoLower).String(strconv.FormatBool(v.(bool))), false)
+	}
+
+	if v, ok := flattenedAction["variable_substitution_in_files"]; ok {
+		if s := v.(string); len(s) > 0 {
+			action.Properties["Octopus.Action.SubstituteInFiles.TargetFiles"] = core.NewPropertyValue(s, false)
+		}
+	}
+
+	if v, ok := flattenedAction["worker_pool_id"]; ok {
+		action.WorkerPool = v.(string)
+	}
+
+	if v, ok := flattenedAction["worker_pool_variable"]; ok {
+		action.WorkerPoolVariable = v.(string)
+	}
+
+	return action
+}
+
+func flattenKustomizeAction(action *deployments.DeploymentAction) map[string]interface{} {
+	if action == nil {
+		return nil
+	}
+
+	flattenedAction := flattenAction(action)
+
+	if len(action.WorkerPool) > 0 {
+		flattenedAction["worker_pool_id"] = action.WorkerPool
+	}
+
+	if len(action.WorkerPoolVariable) > 0 {
+		flattenedAction["worker_pool_variable"] = action.WorkerPoolVariable
+	}
+
+	for k, v := range action.Properties {
+		switch k {
+		case "Octopus.Action.RunOnServer":
+			runOnServer, _ := strconv.ParseBool(v.Value)
+			flattenedAction["run_on_server"] = runOnServer
+		case "Octopus.Action.Kubernetes.Kustomize.OverlayPath":
+			flattenedAction["overlay_path"] = v.Value
+		case "Octopus.Action.Kubernetes.ResourceStatusCheck":
+			statusCheck, _ := strconv.ParseBool(v.Value)
+			flattenedAction["kubernetes_object_status_check_enabled"] = statusCheck
+		case "Octopus.Action.Kubernetes.ServerSideApply.Enabled":
+			serverSideApply, _ := strconv.ParseBool(v.Value)
+			flattenedAction["server_side_apply_enabled"] = serverSideApply
+		case "Octopus.Action.Kubernetes.ServerSideApply.ForceConflicts":
+			forceConflicts, _ := strconv.ParseBool(v.Value)
+			flattenedAction["server_side_apply_force_conflicts"] = forceConflicts
+		case "Octopus.Action.SubstituteInFiles.TargetFiles":
+			flattenedAction["variable_substitution_in_files"] = v.Value
+		}
+	}
+
+	return flattenedAction
+}
@@ -0,0 +1,75 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOctopusDeployKustomizeAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccProjectCheckDestroy,
+			testAccProjectGroupCheckDestroy,
+			testAccLifecycleCheckDestroy,
+		),
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKustomizeAction(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKustomizeAction(),
+				),
+			},
+		},
+	})
+}
+
+func testAccKustomizeAction() string {
+	return testAccBuildTestAction(`
+		kustomize_action {
+			name                           = "Test"
+			overlay_path                   = "overlays/#{Octopus.Environment.Name}"
+			run_on_server                  = true
+			variable_substitution_in_files = "**/*.env"
+
+			primary_package {
+				package_id = "MyManifests"
+			}
+		}
+	`)
+}
+
+func testAccCheckKustomizeAction() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := getClient(testAccProvider.Meta())
+
+		process, err := getDeploymentProcess(s, client)
+		if err != nil {
+			return err
+		}
+
+		action := process.Steps[0].Actions[0]
+
+		if action.ActionType != "Octopus.Kubernetes.Kustomize" {
+			return fmt.Errorf("Action type is incorrect: %s", action.ActionType)
+		}
+
+		if action.Properties["Octopus.Action.Kubernetes.Kustomize.OverlayPath"].Value != "overlays/#{Octopus.Environment.Name}" {
+			return fmt.Errorf("OverlayPath is incorrect: %s", action.Properties["Octopus.Action.Kubernetes.Kustomize.OverlayPath"].Value)
+		}
+
+		if action.Properties["Octopus.Action.SubstituteInFiles.TargetFiles"].Value != "**/*.env" {
+			return fmt.Errorf("TargetFiles is incorrect: %s", action.Properties["Octopus.Action.SubstituteInFiles.TargetFiles"].Value)
+		}
+
+		if len(action.Packages) == 0 {
+			return fmt.Errorf("No package")
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,179 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+)
+
+// knownActionProperties are the properties of the actions whose property names are all known, by namespace, so that
+// a misspelled property within one of these namespaces can be reported.
+var knownActionProperties = map[string][]string{
+	"Octopus.Action.DeployRelease.": {"DeploymentCondition", "ProjectId", "Variables"},
+	"Octopus.Action.HealthCheck.":   {"ErrorHandling", "IncludeMachinesInDeployment", "Type"},
+	"Octopus.Action.Manual.":        {"BlockConcurrentDeployments", "Instructions", "ResponsibleTeamIds"},
+	"Octopus.Action.Script.":        {"ScriptBody", "ScriptFileName", "ScriptParameters", "ScriptSource", "Syntax"},
+}
+
+// packageActionTypes are the action types that deploy or transfer a package, and so require a primary package.
+var packageActionTypes = map[string]bool{
+	"Octopus.IIS":             true,
+	"Octopus.TentaclePackage": true,
+	"Octopus.TransferPackage": true,
+	"Octopus.WindowsService":  true,
+}
+
+// requiredActionProperties are the properties that each action type requires, with the exception of the script
+// properties of script actions, which depend on the source of the script.
+var requiredActionProperties = map[string][]string{
+	"Octopus.DeployRelease":   {"Octopus.Action.DeployRelease.ProjectId"},
+	"Octopus.HealthCheck":     {"Octopus.Action.HealthCheck.ErrorHandling", "Octopus.Action.HealthCheck.Type"},
+	"Octopus.Manual":          {"Octopus.Action.Manual.Instructions"},
+	"Octopus.TransferPackage": {"Octopus.Action.Package.TransferPath"},
+}
+
+// scriptActionTypes are the action types that run a script, either inline or from a package.
+var scriptActionTypes = map[string]bool{
+	"Octopus.KubernetesRunScript": true,
+	"Octopus.Script":              true,
+}
+
+// warnIfUnknownActionProperties warns of properties that belong to the namespace of a well-known action but are not
+// one of its properties, which Octopus would otherwise ignore.
+func warnIfUnknownActionProperties(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	keys := make([]string, 0, len(v.(map[string]interface{})))
+	for key := range v.(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for namespace, properties := range knownActionProperties {
+			if !strings.HasPrefix(key, namespace) || slices.Contains(properties, strings.TrimPrefix(key, namespace)) {
+				continue
+			}
+
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       fmt.Sprintf("\"%s\" is not a known property", key),
+				Detail:        fmt.Sprintf("Octopus ignores this property. The known properties are %s%s.", namespace, strings.Join(properties, ", "+namespace)),
+				AttributePath: append(path, cty.IndexStep{Key: cty.StringVal(key)}),
+			})
+		}
+	}
+
+	return diags
+}
+
+// validateActionProperties ensures that the generic actions of a process set the properties that their action type
+// requires, so that a step that Octopus cannot run is reported when planning. Actions based on a step template are
+// not checked, as the template supplies their properties.
+func validateActionProperties(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() {
+		return nil
+	}
+
+	return validateActionPropertiesConfig(rawConfig)
+}
+
+func validateActionPropertiesConfig(config cty.Value) error {
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() {
+		return nil
+	}
+
+	for _, key := range []string{"action", "step"} {
+		if !config.Type().HasAttribute(key) {
+			continue
+		}
+
+		blocks := config.GetAttr(key)
+		if blocks.IsNull() || !blocks.IsKnown() {
+			continue
+		}
+
+		for _, block := range blocks.AsValueSlice() {
+			if key == "action" {
+				if err := validateActionConfig(block); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := validateActionPropertiesConfig(block); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateActionConfig(action cty.Value) error {
+	if action.IsNull() || !action.IsKnown() {
+		return nil
+	}
+
+	actionType := action.GetAttr("action_type")
+	properties := action.GetAttr("properties")
+	if actionType.IsNull() || !actionType.IsKnown() || !properties.IsWhollyKnown() {
+		return nil
+	}
+
+	if actionTemplates := action.GetAttr("action_template"); !actionTemplates.IsNull() && (!actionTemplates.IsKnown() || actionTemplates.LengthInt() > 0) {
+		return nil
+	}
+
+	name := action.GetAttr("name")
+	if name.IsNull() || !name.IsKnown() {
+		name = cty.StringVal("")
+	}
+
+	propertyValues := map[string]string{}
+	if !properties.IsNull() {
+		for key, value := range properties.AsValueMap() {
+			if !value.IsNull() {
+				propertyValues[key] = value.AsString()
+			}
+		}
+	}
+
+	// packages may also be given by properties in configurations written before primary_package was added
+	hasPrimaryPackage := len(propertyValues["Octopus.Action.Package.PackageId"]) > 0
+	if primaryPackages := action.GetAttr("primary_package"); !primaryPackages.IsKnown() || (!primaryPackages.IsNull() && primaryPackages.LengthInt() > 0) {
+		hasPrimaryPackage = true
+	}
+
+	required := append([]string{}, requiredActionProperties[actionType.AsString()]...)
+	if scriptActionTypes[actionType.AsString()] {
+		if propertyValues["Octopus.Action.Script.ScriptSource"] == "Package" {
+			required = append(required, "Octopus.Action.Script.ScriptFileName")
+
+			if !hasPrimaryPackage {
+				return fmt.Errorf("action %q runs a script from a package, and so requires a primary_package", name.AsString())
+			}
+		} else {
+			required = append(required, "Octopus.Action.Script.ScriptBody")
+		}
+	}
+
+	if packageActionTypes[actionType.AsString()] && !hasPrimaryPackage {
+		return fmt.Errorf("action %q of type %s requires a primary_package", name.AsString(), actionType.AsString())
+	}
+
+	for _, property := range required {
+		if len(strings.TrimSpace(propertyValues[property])) == 0 {
+			return fmt.Errorf("action %q of type %s requires the property %s", name.AsString(), actionType.AsString(), property)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateActionConfig(t *testing.T) {
+	packageType := cty.Object(map[string]cty.Type{"package_id": cty.String})
+	templateType := cty.Object(map[string]cty.Type{"id": cty.String})
+
+	action := func(actionType string, properties map[string]string, primaryPackage bool) cty.Value {
+		propertyValues := map[string]cty.Value{}
+		for key, value := range properties {
+			propertyValues[key] = cty.StringVal(value)
+		}
+
+		propertiesValue := cty.NullVal(cty.Map(cty.String))
+		if len(propertyValues) > 0 {
+			propertiesValue = cty.MapVal(propertyValues)
+		}
+
+		primaryPackageValue := cty.NullVal(cty.List(packageType))
+		if primaryPackage {
+			primaryPackageValue = cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"package_id": cty.StringVal("web")})})
+		}
+
+		return cty.ObjectVal(map[string]cty.Value{
+			"action_template": cty.NullVal(cty.Set(templateType)),
+			"action_type":     cty.StringVal(actionType),
+			"name":            cty.StringVal("Deploy"),
+			"primary_package": primaryPackageValue,
+			"properties":      propertiesValue,
+		})
+	}
+
+	require.NoError(t, validateActionConfig(action("Octopus.Script", map[string]string{"Octopus.Action.Script.ScriptBody": "echo hello"}, false)))
+	require.Error(t, validateActionConfig(action("Octopus.Script", map[string]string{"Octopus.Action.Script.ScriptSource": "Inline"}, false)))
+	require.NoError(t, validateActionConfig(action("Octopus.Script", map[string]string{"Octopus.Action.Script.ScriptSource": "Package", "Octopus.Action.Script.ScriptFileName": "deploy.sh"}, true)))
+	require.Error(t, validateActionConfig(action("Octopus.Script", map[string]string{"Octopus.Action.Script.ScriptSource": "Package", "Octopus.Action.Script.ScriptFileName": "deploy.sh"}, false)))
+	require.Error(t, validateActionConfig(action("Octopus.Script", map[string]string{"Octopus.Action.Script.ScriptSource": "Package"}, true)))
+	require.NoError(t, validateActionConfig(action("Octopus.TentaclePackage", nil, true)))
+	require.NoError(t, validateActionConfig(action("Octopus.TentaclePackage", map[string]string{"Octopus.Action.Package.PackageId": "#{PackageName}"}, false)))
+	require.Error(t, validateActionConfig(action("Octopus.TentaclePackage", nil, false)))
+	require.Error(t, validateActionConfig(action("Octopus.TransferPackage", nil, true)))
+	require.Error(t, validateActionConfig(action("Octopus.Manual", nil, false)))
+	require.NoError(t, validateActionConfig(action("Octopus.Manual", map[string]string{"Octopus.Action.Manual.Instructions": "Approve"}, false)))
+	require.NoError(t, validateActionConfig(action("Octopus.AwsRunCloudFormation", nil, false)))
+
+	// actions based on a step template are not checked
+	templated := action("Octopus.Script", nil, false).AsValueMap()
+	templated["action_template"] = cty.SetVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("ActionTemplates-1")})})
+	require.NoError(t, validateActionConfig(cty.ObjectVal(templated)))
+
+	// properties that are not yet known are not checked
+	unknown := action("Octopus.Script", nil, false).AsValueMap()
+	unknown["properties"] = cty.UnknownVal(cty.Map(cty.String))
+	require.NoError(t, validateActionConfig(cty.ObjectVal(unknown)))
+}
+
+func TestWarnIfUnknownActionProperties(t *testing.T) {
+	diags := warnIfUnknownActionProperties(map[string]interface{}{
+		"Octopus.Action.Package.PackageId": "web",
+		"Octopus.Action.Script.ScirptBody": "echo hello",
+		"Octopus.Action.Script.ScriptBody": "echo hello",
+	}, cty.Path{})
+
+	require.Len(t, diags, 1)
+	require.Contains(t, diags[0].Summary, "Octopus.Action.Script.ScirptBody")
+}
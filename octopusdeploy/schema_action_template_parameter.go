@@ -1,6 +1,9 @@
 package octopusdeploy
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actiontemplates"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -38,7 +41,9 @@ func flattenActionTemplateParameters(actionTemplateParameters []actiontemplates.
 	flattenedActionTemplateParameters := make([]interface{}, 0)
 	for _, actionTemplateParameter := range actionTemplateParameters {
 		a := make(map[string]interface{})
-		a["default_value"] = actionTemplateParameter.DefaultValue.Value
+		if actionTemplateParameter.DefaultValue != nil {
+			a["default_value"] = actionTemplateParameter.DefaultValue.Value
+		}
 		a["display_settings"] = actionTemplateParameter.DisplaySettings
 		a["help_text"] = actionTemplateParameter.HelpText
 		a["id"] = actionTemplateParameter.ID
@@ -57,7 +62,7 @@ func getActionTemplateParameterSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 		},
 		"display_settings": {
-			Description: "The display settings for the parameter.",
+			Description: "The display settings for the parameter, such as `Octopus.ControlType` (one of `SingleLineText`, `MultiLineText`, `Select`, `Checkbox` or `Sensitive`) and, for `Select` parameters, `Octopus.SelectOptions`.",
 			Optional:    true,
 			Type:        schema.TypeMap,
 		},
@@ -80,3 +85,31 @@ func getActionTemplateParameterSchema() map[string]*schema.Schema {
 		},
 	}
 }
+
+// validateActionTemplateParameterNames ensures that the names of template parameters are unique, as each name is the
+// variable that a tenant provides a value for.
+func validateActionTemplateParameterNames(actionTemplateParameters []interface{}) error {
+	names := map[string]bool{}
+	for _, actionTemplateParameter := range actionTemplateParameters {
+		actionTemplateParameterMap, ok := actionTemplateParameter.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := actionTemplateParameterMap["name"].(string)
+		if len(name) == 0 {
+			continue
+		}
+
+		if names[name] {
+			return fmt.Errorf("the template name '%s' is used more than once", name)
+		}
+		names[name] = true
+	}
+
+	return nil
+}
+
+func validateProjectTemplates(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return validateActionTemplateParameterNames(d.Get("template").([]interface{}))
+}
@@ -0,0 +1,47 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actiontemplates"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenActionTemplateParameters(t *testing.T) {
+	actionTemplateParameter := actiontemplates.NewActionTemplateParameter()
+	actionTemplateParameter.Name = "Tenant.Database.Name"
+
+	flattenedActionTemplateParameters := flattenActionTemplateParameters([]actiontemplates.ActionTemplateParameter{*actionTemplateParameter})
+	require.Len(t, flattenedActionTemplateParameters, 1)
+	require.Equal(t, "Tenant.Database.Name", flattenedActionTemplateParameters[0].(map[string]interface{})["name"])
+	require.NotContains(t, flattenedActionTemplateParameters[0], "default_value")
+
+	expandedActionTemplateParameters := expandActionTemplateParameters([]interface{}{
+		map[string]interface{}{
+			"default_value":    "orders",
+			"display_settings": map[string]interface{}{"Octopus.ControlType": "SingleLineText"},
+			"help_text":        "",
+			"id":               "",
+			"label":            "Database",
+			"name":             "Tenant.Database.Name",
+		},
+	})
+	require.Len(t, expandedActionTemplateParameters, 1)
+	require.Equal(t, "orders", expandedActionTemplateParameters[0].DefaultValue.Value)
+	require.Equal(t, "SingleLineText", expandedActionTemplateParameters[0].DisplaySettings["Octopus.ControlType"])
+}
+
+func TestValidateActionTemplateParameterNames(t *testing.T) {
+	require.NoError(t, validateActionTemplateParameterNames(nil))
+	require.NoError(t, validateActionTemplateParameterNames([]interface{}{
+		map[string]interface{}{"name": "First"},
+		map[string]interface{}{"name": "Second"},
+		map[string]interface{}{"name": ""},
+		map[string]interface{}{"name": ""},
+		nil,
+	}))
+	require.Error(t, validateActionTemplateParameterNames([]interface{}{
+		map[string]interface{}{"name": "First"},
+		map[string]interface{}{"name": "First"},
+	}))
+}
@@ -0,0 +1,55 @@
+package octopusdeploy
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func getTransferPackageActionSchema() *schema.Schema {
+	actionSchema, element := getActionSchema()
+	addPrimaryPackageSchema(element, true)
+
+	element.Schema["transfer_path"] = &schema.Schema{
+		Description:      "The directory on the deployment target the package will be copied to",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+	}
+
+	return actionSchema
+}
+
+func expandTransferPackageAction(flattenedAction map[string]interface{}) *deployments.DeploymentAction {
+	if len(flattenedAction) == 0 {
+		return nil
+	}
+
+	action := expandAction(flattenedAction)
+	if action == nil {
+		return nil
+	}
+
+	action.ActionType = "Octopus.TransferPackage"
+
+	if v, ok := flattenedAction["transfer_path"]; ok {
+		action.Properties["Octopus.Action.Package.TransferPath"] = core.NewPropertyValue(v.(string), false)
+	}
+
+	return action
+}
+
+func flattenTransferPackageAction(action *deployments.DeploymentAction) map[string]interface{} {
+	if action == nil {
+		return nil
+	}
+
+	flattenedAction := flattenAction(action)
+
+	if v, ok := action.Properties["Octopus.Action.Package.TransferPath"]; ok {
+		flattenedAction["transfer_path"] = v.Value
+	}
+
+	return flattenedAction
+}
@@ -0,0 +1,35 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandTransferPackageAction(t *testing.T) {
+	action := expandTransferPackageAction(nil)
+	require.Nil(t, action)
+
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	action = expandTransferPackageAction(map[string]interface{}{
+		"name": name,
+		"primary_package": []interface{}{
+			map[string]interface{}{
+				"acquisition_location": "Server",
+				"feed_id":              "feeds-builtin",
+				"package_id":           "MyPackage",
+			},
+		},
+		"transfer_path": "C:\\Drop\\#{Octopus.Environment.Name}",
+	})
+	require.NotNil(t, action)
+	require.Equal(t, "Octopus.TransferPackage", action.ActionType)
+	require.Equal(t, "C:\\Drop\\#{Octopus.Environment.Name}", action.Properties["Octopus.Action.Package.TransferPath"].Value)
+	require.Len(t, action.Packages, 1)
+
+	flattenedAction := flattenTransferPackageAction(action)
+	require.Equal(t, name, flattenedAction["name"])
+	require.Equal(t, "C:\\Drop\\#{Octopus.Environment.Name}", flattenedAction["transfer_path"])
+	require.Contains(t, flattenedAction, "primary_package")
+}
@@ -13,7 +13,7 @@ import (
 func expandAmazonWebServicesAccount(d *schema.ResourceData) *accounts.AmazonWebServicesAccount {
 	name := d.Get("name").(string)
 	accessKey := d.Get("access_key").(string)
-	secretKey := core.NewSensitiveValue(d.Get("secret_key").(string))
+	secretKey := core.NewSensitiveValue(getSensitiveString(d, "secret_key"))
 
 	account, _ := accounts.NewAmazonWebServicesAccount(name, accessKey, secretKey)
 	account.ID = d.Id()
@@ -46,7 +46,7 @@ func expandAmazonWebServicesAccount(d *schema.ResourceData) *accounts.AmazonWebS
 }
 
 func getAmazonWebServicesAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	accountSchema := map[string]*schema.Schema{
 		"access_key": {
 			Description: "The access key associated with this AWS account.",
 			Required:    true,
@@ -70,6 +70,10 @@ func getAmazonWebServicesAccountSchema() map[string]*schema.Schema {
 		"tenants":                           getTenantsSchema(),
 		"tenant_tags":                       getTenantTagsSchema(),
 	}
+
+	addSecretVersions(accountSchema, "secret_key")
+
+	return accountSchema
 }
 
 func setAmazonWebServicesAccount(ctx context.Context, d *schema.ResourceData, account *accounts.AmazonWebServicesAccount) error {
@@ -91,5 +95,7 @@ func setAmazonWebServicesAccount(ctx context.Context, d *schema.ResourceData, ac
 		return fmt.Errorf("error setting tenant_tags: %s", err)
 	}
 
+	clearVersionedSecrets(d, "secret_key")
+
 	return nil
 }
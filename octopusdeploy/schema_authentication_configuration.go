@@ -0,0 +1,206 @@
+package octopusdeploy
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/configuration"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandActiveDirectoryAuthentication(d *schema.ResourceData) *configuration.ActiveDirectoryAuthentication {
+	return &configuration.ActiveDirectoryAuthentication{
+		ActiveDirectoryContainer:               d.Get("active_directory_container").(string),
+		AllowAutoUserCreation:                  d.Get("allow_auto_user_creation").(bool),
+		AllowFormsAuthenticationForDomainUsers: d.Get("allow_forms_authentication_for_domain_users").(bool),
+		AreSecurityGroupsDisabled:              d.Get("are_security_groups_disabled").(bool),
+		AuthenticationScheme:                   d.Get("authentication_scheme").(string),
+		IsEnabled:                              d.Get("is_enabled").(bool),
+	}
+}
+
+func expandEntraIDAuthentication(d *schema.ResourceData) *configuration.EntraIDAuthentication {
+	return &configuration.EntraIDAuthentication{
+		OpenIDConnectAuthentication: expandOpenIDConnectAuthentication(d),
+		RoleClaimType:               d.Get("role_claim_type").(string),
+	}
+}
+
+func expandGoogleAppsAuthentication(d *schema.ResourceData) *configuration.GoogleAppsAuthentication {
+	return &configuration.GoogleAppsAuthentication{
+		HostedDomain:                d.Get("hosted_domain").(string),
+		OpenIDConnectAuthentication: expandOpenIDConnectAuthentication(d),
+	}
+}
+
+func expandOktaAuthentication(d *schema.ResourceData) *configuration.OktaAuthentication {
+	return &configuration.OktaAuthentication{
+		OpenIDConnectAuthentication: expandOpenIDConnectAuthentication(d),
+		RoleClaimType:               d.Get("role_claim_type").(string),
+		UsernameClaimType:           d.Get("username_claim_type").(string),
+	}
+}
+
+func expandOpenIDConnectAuthentication(d *schema.ResourceData) configuration.OpenIDConnectAuthentication {
+	openIDConnectAuthentication := configuration.OpenIDConnectAuthentication{
+		AllowAutoUserCreation: d.Get("allow_auto_user_creation").(bool),
+		ClientID:              d.Get("client_id").(string),
+		ClientSecret:          expandKeptSensitiveValue(d, "client_secret"),
+		IsEnabled:             d.Get("is_enabled").(bool),
+	}
+
+	if v, ok := d.GetOk("issuer"); ok {
+		openIDConnectAuthentication.Issuer = v.(string)
+	}
+
+	return openIDConnectAuthentication
+}
+
+func getAuthenticationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"allow_auto_user_creation": {
+			Default:     true,
+			Description: "Whether users signing in for the first time are created in Octopus automatically.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"id": getIDSchema(),
+		"is_enabled": {
+			Default:     true,
+			Description: "Whether users can sign in with this authentication provider.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+	}
+}
+
+func getActiveDirectoryAuthenticationSchema() map[string]*schema.Schema {
+	activeDirectoryAuthenticationSchema := getAuthenticationSchema()
+	activeDirectoryAuthenticationSchema["active_directory_container"] = &schema.Schema{
+		Description: "The container, such as an organizational unit, that users and groups are looked up in. The whole domain is searched when omitted.",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+	activeDirectoryAuthenticationSchema["allow_forms_authentication_for_domain_users"] = &schema.Schema{
+		Default:     true,
+		Description: "Whether domain users can sign in with their user name and password as well as with integrated authentication.",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+	activeDirectoryAuthenticationSchema["are_security_groups_disabled"] = &schema.Schema{
+		Default:     false,
+		Description: "Whether the Active Directory security groups of users are ignored when working out their team membership.",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+	activeDirectoryAuthenticationSchema["authentication_scheme"] = &schema.Schema{
+		Default:          "Ntlm",
+		Description:      "The scheme used for integrated authentication, one of `IntegratedWindowsAuthentication`, `Negotiate` or `Ntlm`.",
+		Optional:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"IntegratedWindowsAuthentication", "Negotiate", "Ntlm"}, false)),
+	}
+
+	return activeDirectoryAuthenticationSchema
+}
+
+func getEntraIDAuthenticationSchema() map[string]*schema.Schema {
+	entraIDAuthenticationSchema := getOpenIDConnectAuthenticationSchema("The issuer of the tokens, e.g. `https://login.microsoftonline.com/<tenant-id>`.")
+	entraIDAuthenticationSchema["role_claim_type"] = getRoleClaimTypeSchema()
+
+	return entraIDAuthenticationSchema
+}
+
+func getGoogleAppsAuthenticationSchema() map[string]*schema.Schema {
+	googleAppsAuthenticationSchema := getOpenIDConnectAuthenticationSchema("The issuer of the tokens. Defaults to `https://accounts.google.com`.")
+	googleAppsAuthenticationSchema["hosted_domain"] = &schema.Schema{
+		Description:      "The Google Workspace domain that users must belong to, e.g. `example.com`.",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+	}
+
+	return googleAppsAuthenticationSchema
+}
+
+func getOktaAuthenticationSchema() map[string]*schema.Schema {
+	oktaAuthenticationSchema := getOpenIDConnectAuthenticationSchema("The issuer of the tokens, i.e. the URL of the Okta authorization server, e.g. `https://example.okta.com`.")
+	oktaAuthenticationSchema["role_claim_type"] = getRoleClaimTypeSchema()
+	oktaAuthenticationSchema["username_claim_type"] = &schema.Schema{
+		Computed:    true,
+		Description: "The type of the claim that holds the user name of users.",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	return oktaAuthenticationSchema
+}
+
+func getOpenIDConnectAuthenticationSchema(issuerDescription string) map[string]*schema.Schema {
+	openIDConnectAuthenticationSchema := getAuthenticationSchema()
+	openIDConnectAuthenticationSchema["client_id"] = &schema.Schema{
+		Description:      "The client ID of the application registered with the authentication provider.",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+	}
+	openIDConnectAuthenticationSchema["client_secret"] = &schema.Schema{
+		Description: "The client secret of the application registered with the authentication provider. A secret that is already held by Octopus is kept when omitted.",
+		Optional:    true,
+		Sensitive:   true,
+		Type:        schema.TypeString,
+	}
+	openIDConnectAuthenticationSchema["issuer"] = &schema.Schema{
+		Computed:    true,
+		Description: issuerDescription,
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	addSecretVersions(openIDConnectAuthenticationSchema, "client_secret")
+
+	return openIDConnectAuthenticationSchema
+}
+
+func getRoleClaimTypeSchema() *schema.Schema {
+	return &schema.Schema{
+		Computed:    true,
+		Description: "The type of the claim that holds the roles of users, which are mapped to Octopus teams with external security groups.",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+}
+
+func setActiveDirectoryAuthentication(d *schema.ResourceData, activeDirectoryAuthentication *configuration.ActiveDirectoryAuthentication) {
+	d.Set("active_directory_container", activeDirectoryAuthentication.ActiveDirectoryContainer)
+	d.Set("allow_auto_user_creation", activeDirectoryAuthentication.AllowAutoUserCreation)
+	d.Set("allow_forms_authentication_for_domain_users", activeDirectoryAuthentication.AllowFormsAuthenticationForDomainUsers)
+	d.Set("are_security_groups_disabled", activeDirectoryAuthentication.AreSecurityGroupsDisabled)
+	d.Set("authentication_scheme", activeDirectoryAuthentication.AuthenticationScheme)
+	d.Set("is_enabled", activeDirectoryAuthentication.IsEnabled)
+}
+
+func setEntraIDAuthentication(d *schema.ResourceData, entraIDAuthentication *configuration.EntraIDAuthentication) {
+	setOpenIDConnectAuthentication(d, &entraIDAuthentication.OpenIDConnectAuthentication)
+	d.Set("role_claim_type", entraIDAuthentication.RoleClaimType)
+}
+
+func setGoogleAppsAuthentication(d *schema.ResourceData, googleAppsAuthentication *configuration.GoogleAppsAuthentication) {
+	setOpenIDConnectAuthentication(d, &googleAppsAuthentication.OpenIDConnectAuthentication)
+	d.Set("hosted_domain", googleAppsAuthentication.HostedDomain)
+}
+
+func setOktaAuthentication(d *schema.ResourceData, oktaAuthentication *configuration.OktaAuthentication) {
+	setOpenIDConnectAuthentication(d, &oktaAuthentication.OpenIDConnectAuthentication)
+	d.Set("role_claim_type", oktaAuthentication.RoleClaimType)
+	d.Set("username_claim_type", oktaAuthentication.UsernameClaimType)
+}
+
+// setOpenIDConnectAuthentication refreshes the state from the settings held by Octopus. The client secret is never
+// returned by Octopus, so it is left as configured unless its version is set.
+func setOpenIDConnectAuthentication(d *schema.ResourceData, openIDConnectAuthentication *configuration.OpenIDConnectAuthentication) {
+	d.Set("allow_auto_user_creation", openIDConnectAuthentication.AllowAutoUserCreation)
+	d.Set("client_id", openIDConnectAuthentication.ClientID)
+	d.Set("is_enabled", openIDConnectAuthentication.IsEnabled)
+	d.Set("issuer", openIDConnectAuthentication.Issuer)
+
+	clearVersionedSecrets(d, "client_secret")
+}
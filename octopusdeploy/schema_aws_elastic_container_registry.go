@@ -13,7 +13,7 @@ import (
 func expandAwsElasticContainerRegistry(d *schema.ResourceData) (*feeds.AwsElasticContainerRegistry, error) {
 	accessKey := d.Get("access_key").(string)
 	name := d.Get("name").(string)
-	secretKey := core.NewSensitiveValue(d.Get("secret_key").(string))
+	secretKey := core.NewSensitiveValue(getSensitiveString(d, "secret_key"))
 	region := d.Get("region").(string)
 
 	feed, err := feeds.NewAwsElasticContainerRegistry(name, accessKey, secretKey, region)
@@ -35,7 +35,7 @@ func expandAwsElasticContainerRegistry(d *schema.ResourceData) (*feeds.AwsElasti
 }
 
 func getAwsElasticContainerRegistrySchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	feedSchema := map[string]*schema.Schema{
 		"access_key": {
 			Description: "The AWS access key to use when authenticating against Amazon Web Services.",
 			Required:    true,
@@ -77,6 +77,10 @@ func getAwsElasticContainerRegistrySchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 		},
 	}
+
+	addSecretVersions(feedSchema, "secret_key")
+
+	return feedSchema
 }
 
 func setAwsElasticContainerRegistry(ctx context.Context, d *schema.ResourceData, feed *feeds.AwsElasticContainerRegistry) error {
@@ -91,5 +95,7 @@ func setAwsElasticContainerRegistry(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(feed.GetID())
 
+	clearVersionedSecrets(d, "secret_key")
+
 	return nil
 }
@@ -62,7 +62,7 @@ func flattenAzureCloudServiceDeploymentTarget(deploymentTarget *machines.Deploym
 
 func getAzureCloudServiceDeploymentTargetDataSchema() map[string]*schema.Schema {
 	dataSchema := getAzureCloudServiceDeploymentTargetSchema()
-	setDataSchema(&dataSchema)
+	setDeploymentTargetDataSchema(&dataSchema)
 
 	deploymentTargetDataSchema := getDeploymentTargetDataSchema()
 
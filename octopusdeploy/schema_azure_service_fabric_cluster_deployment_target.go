@@ -77,7 +77,7 @@ func flattenAzureServiceFabricClusterDeploymentTarget(deploymentTarget *machines
 
 func getAzureServiceFabricClusterDeploymentTargetDataSchema() map[string]*schema.Schema {
 	dataSchema := getAzureServiceFabricClusterDeploymentTargetSchema()
-	setDataSchema(&dataSchema)
+	setDeploymentTargetDataSchema(&dataSchema)
 
 	deploymentTargetDataSchema := getDeploymentTargetDataSchema()
 
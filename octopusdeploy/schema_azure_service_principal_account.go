@@ -12,7 +12,7 @@ import (
 
 func expandAzureServicePrincipalAccount(d *schema.ResourceData) *accounts.AzureServicePrincipalAccount {
 	name := d.Get("name").(string)
-	password := d.Get("password").(string)
+	password := getSensitiveString(d, "password")
 	secretKey := core.NewSensitiveValue(password)
 
 	applicationID, _ := uuid.Parse(d.Get("application_id").(string))
@@ -62,7 +62,7 @@ func expandAzureServicePrincipalAccount(d *schema.ResourceData) *accounts.AzureS
 }
 
 func getAzureServicePrincipalAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	accountSchema := map[string]*schema.Schema{
 		"application_id":                    getApplicationIDSchema(true),
 		"authentication_endpoint":           getAuthenticationEndpointSchema(false),
 		"azure_environment":                 getAzureEnvironmentSchema(),
@@ -79,6 +79,10 @@ func getAzureServicePrincipalAccountSchema() map[string]*schema.Schema {
 		"tenant_id":                         getTenantIDSchema(true),
 		"tenant_tags":                       getTenantTagsSchema(),
 	}
+
+	addSecretVersions(accountSchema, "password")
+
+	return accountSchema
 }
 
 func setAzureServicePrincipalAccount(ctx context.Context, d *schema.ResourceData, account *accounts.AzureServicePrincipalAccount) error {
@@ -106,5 +110,7 @@ func setAzureServicePrincipalAccount(ctx context.Context, d *schema.ResourceData
 		return fmt.Errorf("error setting tenant_tags: %s", err)
 	}
 
+	clearVersionedSecrets(d, "password")
+
 	return nil
 }
@@ -21,8 +21,8 @@ func expandAzureSubscriptionAccount(d *schema.ResourceData) *accounts.AzureSubsc
 		account.AzureEnvironment = v.(string)
 	}
 
-	if v, ok := d.GetOk("certificate"); ok {
-		account.CertificateBytes = core.NewSensitiveValue(v.(string))
+	if v := getSensitiveString(d, "certificate"); len(v) > 0 {
+		account.CertificateBytes = core.NewSensitiveValue(v)
 	}
 
 	if v, ok := d.GetOk("certificate_thumbprint"); ok {
@@ -74,7 +74,7 @@ func expandAzureSubscriptionAccount(d *schema.ResourceData) *accounts.AzureSubsc
 }
 
 func getAzureSubscriptionAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	accountSchema := map[string]*schema.Schema{
 		"azure_environment": getAzureEnvironmentSchema(),
 		"certificate": {
 			Computed:  true,
@@ -108,6 +108,10 @@ func getAzureSubscriptionAccountSchema() map[string]*schema.Schema {
 		"tenants":                           getTenantsSchema(),
 		"tenant_tags":                       getTenantTagsSchema(),
 	}
+
+	addSecretVersions(accountSchema, "certificate")
+
+	return accountSchema
 }
 
 func setAzureSubscriptionAccount(ctx context.Context, d *schema.ResourceData, account *accounts.AzureSubscriptionAccount) error {
@@ -135,5 +139,7 @@ func setAzureSubscriptionAccount(ctx context.Context, d *schema.ResourceData, ac
 
 	d.SetId(account.GetID())
 
+	clearVersionedSecrets(d, "certificate")
+
 	return nil
 }
@@ -47,7 +47,7 @@ func flattenAzureWebAppDeploymentTarget(deploymentTarget *machines.DeploymentTar
 
 func getAzureWebAppDeploymentTargetDataSchema() map[string]*schema.Schema {
 	dataSchema := getAzureWebAppDeploymentTargetSchema()
-	setDataSchema(&dataSchema)
+	setDeploymentTargetDataSchema(&dataSchema)
 
 	deploymentTargetDataSchema := getDeploymentTargetDataSchema()
 
@@ -0,0 +1,191 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/buildinformation"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/issuetrackers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func flattenBuildInformationCommits(commits []*issuetrackers.CommitDetails) []interface{} {
+	flattenedCommits := []interface{}{}
+	for _, commit := range commits {
+		if commit == nil {
+			continue
+		}
+
+		flattenedCommits = append(flattenedCommits, map[string]interface{}{
+			"comment":  commit.Comment,
+			"id":       commit.ID,
+			"link_url": commit.LinkURL,
+		})
+	}
+
+	return flattenedCommits
+}
+
+func flattenBuildInformationWorkItems(workItems []*core.WorkItemLink) []interface{} {
+	flattenedWorkItems := []interface{}{}
+	for _, workItem := range workItems {
+		if workItem == nil {
+			continue
+		}
+
+		flattenedWorkItems = append(flattenedWorkItems, map[string]interface{}{
+			"description": workItem.Description,
+			"id":          workItem.ID,
+			"link_url":    workItem.LinkURL,
+			"source":      workItem.Source,
+		})
+	}
+
+	return flattenedWorkItems
+}
+
+func getBuildInformationDataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"branch": {
+			Computed:    true,
+			Description: "The branch that the package was built from.",
+			Type:        schema.TypeString,
+		},
+		"build_environment": {
+			Computed:    true,
+			Description: "The build server that built the package (e.g. `GitHub Actions`).",
+			Type:        schema.TypeString,
+		},
+		"build_number": {
+			Computed:    true,
+			Description: "The number of the build that built the package.",
+			Type:        schema.TypeString,
+		},
+		"build_url": {
+			Computed:    true,
+			Description: "The URL of the build that built the package.",
+			Type:        schema.TypeString,
+		},
+		"commit": {
+			Computed:    true,
+			Description: "The commits that are included in the build.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"comment": {
+						Computed:    true,
+						Description: "The message of the commit.",
+						Type:        schema.TypeString,
+					},
+					"id": {
+						Computed:    true,
+						Description: "The ID of the commit.",
+						Type:        schema.TypeString,
+					},
+					"link_url": {
+						Computed:    true,
+						Description: "The URL of the commit.",
+						Type:        schema.TypeString,
+					},
+				},
+			},
+			Type: schema.TypeList,
+		},
+		"created": {
+			Computed:    true,
+			Description: "The time that the build information was created, in RFC 3339 format.",
+			Type:        schema.TypeString,
+		},
+		"id": getDataSchemaID(),
+		"issue_tracker_name": {
+			Computed:    true,
+			Description: "The name of the issue tracker of the work items.",
+			Type:        schema.TypeString,
+		},
+		"package_id": {
+			Description: "The ID of the package of the build information.",
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"space_id": getQuerySpaceID(),
+		"vcs_commit_number": {
+			Computed:    true,
+			Description: "The commit that the package was built from.",
+			Type:        schema.TypeString,
+		},
+		"vcs_commit_url": {
+			Computed:    true,
+			Description: "The URL of the commit that the package was built from.",
+			Type:        schema.TypeString,
+		},
+		"vcs_root": {
+			Computed:    true,
+			Description: "The URL of the repository that the package was built from.",
+			Type:        schema.TypeString,
+		},
+		"vcs_type": {
+			Computed:    true,
+			Description: "The type of version control of the repository (e.g. `Git`).",
+			Type:        schema.TypeString,
+		},
+		"version": {
+			Description: "The version of the package of the build information.",
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"work_item": {
+			Computed:    true,
+			Description: "The work items that are linked to the build.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"description": {
+						Computed:    true,
+						Description: "The description of the work item.",
+						Type:        schema.TypeString,
+					},
+					"id": {
+						Computed:    true,
+						Description: "The ID of the work item.",
+						Type:        schema.TypeString,
+					},
+					"link_url": {
+						Computed:    true,
+						Description: "The URL of the work item.",
+						Type:        schema.TypeString,
+					},
+					"source": {
+						Computed:    true,
+						Description: "The issue tracker of the work item.",
+						Type:        schema.TypeString,
+					},
+				},
+			},
+			Type: schema.TypeList,
+		},
+	}
+}
+
+func setBuildInformation(d *schema.ResourceData, buildInformation *buildinformation.BuildInformation) error {
+	d.Set("branch", buildInformation.Branch)
+	d.Set("build_environment", buildInformation.BuildEnvironment)
+	d.Set("build_number", buildInformation.BuildNumber)
+	d.Set("build_url", buildInformation.BuildURL)
+	d.Set("created", buildInformation.Created.Format(time.RFC3339))
+	d.Set("issue_tracker_name", buildInformation.IssueTrackerName)
+	d.Set("package_id", buildInformation.PackageID)
+	d.Set("vcs_commit_number", buildInformation.VcsCommitNumber)
+	d.Set("vcs_commit_url", buildInformation.VcsCommitURL)
+	d.Set("vcs_root", buildInformation.VcsRoot)
+	d.Set("vcs_type", buildInformation.VcsType)
+	d.Set("version", buildInformation.Version)
+
+	if err := d.Set("commit", flattenBuildInformationCommits(buildInformation.Commits)); err != nil {
+		return fmt.Errorf("error setting commit: %s", err)
+	}
+
+	if err := d.Set("work_item", flattenBuildInformationWorkItems(buildInformation.WorkItems)); err != nil {
+		return fmt.Errorf("error setting work_item: %s", err)
+	}
+
+	return nil
+}
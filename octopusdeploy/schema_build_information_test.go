@@ -0,0 +1,47 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/buildinformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetBuildInformation(t *testing.T) {
+	// build information as it is returned by Octopus
+	var buildInformation buildinformation.BuildInformation
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"Id": "BuildInformation-1",
+		"PackageId": "Website",
+		"Version": "1.2.3",
+		"Branch": "main",
+		"BuildEnvironment": "GitHub Actions",
+		"BuildNumber": "42",
+		"BuildUrl": "https://github.com/example/website/actions/runs/42",
+		"VcsType": "Git",
+		"VcsRoot": "https://github.com/example/website",
+		"VcsCommitNumber": "8f3c2d1",
+		"Commits": [
+			{"Id": "8f3c2d1", "Comment": "Fix the login page", "LinkUrl": "https://github.com/example/website/commit/8f3c2d1"}
+		],
+		"WorkItems": [
+			{"Id": "123", "Description": "The login page is broken", "Source": "GitHub", "LinkUrl": "https://github.com/example/website/issues/123"}
+		],
+		"Created": "2023-05-01T10:00:00Z"
+	}`), &buildInformation))
+
+	d := schema.TestResourceDataRaw(t, getBuildInformationDataSchema(), map[string]interface{}{})
+	require.NoError(t, setBuildInformation(d, &buildInformation))
+
+	require.Equal(t, "main", d.Get("branch"))
+	require.Equal(t, "42", d.Get("build_number"))
+	require.Equal(t, "2023-05-01T10:00:00Z", d.Get("created"))
+	require.Equal(t, "Website", d.Get("package_id"))
+	require.Equal(t, "1.2.3", d.Get("version"))
+	require.Equal(t, "8f3c2d1", d.Get("commit.0.id"))
+	require.Equal(t, "Fix the login page", d.Get("commit.0.comment"))
+	require.Equal(t, "123", d.Get("work_item.0.id"))
+	require.Equal(t, "GitHub", d.Get("work_item.0.source"))
+}
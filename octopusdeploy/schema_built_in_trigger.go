@@ -0,0 +1,62 @@
+package octopusdeploy
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandBuiltInTrigger(d *schema.ResourceData) *projects.ReleaseCreationStrategy {
+	return &projects.ReleaseCreationStrategy{
+		ChannelID:              d.Get("channel_id").(string),
+		ReleaseCreationPackage: expandDeploymentActionPackage(d.Get("release_creation_package")),
+	}
+}
+
+func getBuiltInTriggerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"channel_id": {
+			Description:      "The ID of the channel in which releases are created.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"id": getIDSchema(),
+		"project_id": {
+			Description:      "The ID of the project to attach the trigger.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"release_creation_package": {
+			Description: "The package whose pushes to the built-in feed create a release.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"deployment_action": {
+						Description:      "The name of the deployment action that references the package.",
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+					},
+					"package_reference": {
+						Description: "The name of the package reference within the deployment action. Empty for the primary package.",
+						Optional:    true,
+						Type:        schema.TypeString,
+					},
+				},
+			},
+			MaxItems: 1,
+			MinItems: 1,
+			Required: true,
+			Type:     schema.TypeList,
+		},
+		"space_id": getSpaceIDInputSchema(),
+	}
+}
+
+func setBuiltInTrigger(d *schema.ResourceData, project *projects.Project) error {
+	d.Set("channel_id", project.ReleaseCreationStrategy.ChannelID)
+	d.Set("project_id", project.GetID())
+	return d.Set("release_creation_package", flattenDeploymentActionPackage(project.ReleaseCreationStrategy.ReleaseCreationPackage))
+}
@@ -0,0 +1,37 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltInTrigger(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getBuiltInTriggerSchema(), map[string]interface{}{
+		"channel_id": "Channels-1",
+		"project_id": "Projects-1",
+		"release_creation_package": []interface{}{map[string]interface{}{
+			"deployment_action": "Deploy web app",
+		}},
+	})
+
+	releaseCreationStrategy := expandBuiltInTrigger(d)
+	require.Equal(t, "Channels-1", releaseCreationStrategy.ChannelID)
+	require.NotNil(t, releaseCreationStrategy.ReleaseCreationPackage)
+	require.Equal(t, "Deploy web app", releaseCreationStrategy.ReleaseCreationPackage.DeploymentAction)
+	require.Empty(t, releaseCreationStrategy.ReleaseCreationPackage.PackageReference)
+	require.Empty(t, releaseCreationStrategy.ReleaseCreationPackageStepID)
+
+	project := projects.NewProject("Project", "Lifecycles-1", "ProjectGroups-1")
+	project.ID = "Projects-1"
+	project.AutoCreateRelease = true
+	project.ReleaseCreationStrategy = releaseCreationStrategy
+
+	state := schema.TestResourceDataRaw(t, getBuiltInTriggerSchema(), map[string]interface{}{})
+	require.NoError(t, setBuiltInTrigger(state, project))
+	require.Equal(t, "Channels-1", state.Get("channel_id"))
+	require.Equal(t, "Projects-1", state.Get("project_id"))
+	require.Equal(t, "Deploy web app", state.Get("release_creation_package.0.deployment_action"))
+}
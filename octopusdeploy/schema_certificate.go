@@ -12,8 +12,8 @@ import (
 
 func expandCertificate(d *schema.ResourceData) *certificates.CertificateResource {
 	name := d.Get("name").(string)
-	certificateData := core.NewSensitiveValue(d.Get("certificate_data").(string))
-	password := core.NewSensitiveValue(d.Get("password").(string))
+	certificateData := core.NewSensitiveValue(getSensitiveString(d, "certificate_data"))
+	password := core.NewSensitiveValue(getSensitiveString(d, "password"))
 
 	certificate := certificates.NewCertificateResource(name, certificateData, password)
 	certificate.ID = d.Id()
@@ -94,6 +94,10 @@ func expandCertificate(d *schema.ResourceData) *certificates.CertificateResource
 		certificate.SubjectOrganization = v.(string)
 	}
 
+	if v, ok := d.GetOk("space_id"); ok {
+		certificate.SpaceID = v.(string)
+	}
+
 	if v, ok := d.GetOk("tenanted_deployment_participation"); ok {
 		certificate.TenantedDeploymentMode = core.TenantedDeploymentMode(v.(string))
 	}
@@ -159,6 +163,8 @@ func flattenCertificate(certificate *certificates.CertificateResource) map[strin
 func getCertificateDataSchema() map[string]*schema.Schema {
 	dataSchema := getCertificateSchema()
 	setDataSchema(&dataSchema)
+	delete(dataSchema, "certificate_data_version")
+	delete(dataSchema, "password_version")
 
 	return map[string]*schema.Schema{
 		"archived": getQueryArchived(),
@@ -176,17 +182,19 @@ func getCertificateDataSchema() map[string]*schema.Schema {
 		"partial_name": getQueryPartialName(),
 		"search":       getQuerySearch(),
 		"skip":         getQuerySkip(),
-		"take":         getQueryTake(),
+		"space_id":     getQuerySpaceID(),
+		"take":         getQueryTakeAll(),
 		"tenant":       getQueryTenant(),
 	}
 }
 
 func getCertificateSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	certificateSchema := map[string]*schema.Schema{
 		"archived": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The date and time at which the certificate was archived, if it has been archived.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"certificate_data": {
 			Description:      "The encoded data of the certificate.",
@@ -211,92 +219,113 @@ func getCertificateSchema() map[string]*schema.Schema {
 			Type:        schema.TypeBool,
 		},
 		"issuer_common_name": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The common name of the issuer of the certificate.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"issuer_distinguished_name": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The distinguished name of the issuer of the certificate.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"issuer_organization": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The organization of the issuer of the certificate.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"name": getNameSchema(true),
 		"not_after": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The date and time after which the certificate is no longer valid.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"not_before": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The date and time before which the certificate is not valid.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"notes": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "Notes associated with the certificate.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"password": getPasswordSchema(true),
 		"replaced_by": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the certificate that replaced this certificate, if it has been replaced.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"self_signed": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeBool,
+			Computed:    true,
+			Description: "Indicates if the certificate is self-signed.",
+			Optional:    true,
+			Type:        schema.TypeBool,
 		},
 		"serial_number": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The serial number of the certificate.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"signature_algorithm_name": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The name of the algorithm used to sign the certificate.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
+		"space_id": getSpaceIDSchema(),
 		"subject_alternative_names": {
-			Computed: true,
-			Elem:     &schema.Schema{Type: schema.TypeString},
-			Optional: true,
-			Type:     schema.TypeList,
+			Computed:    true,
+			Description: "The subject alternative names of the certificate.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
 		},
 		"subject_common_name": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The common name of the subject of the certificate.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"subject_distinguished_name": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The distinguished name of the subject of the certificate.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"subject_organization": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The organization of the subject of the certificate.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"tenanted_deployment_participation": getTenantedDeploymentSchema(),
 		"tenants":                           getTenantsSchema(),
 		"tenant_tags":                       getTenantTagsSchema(),
 		"thumbprint": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The SHA1 thumbprint of the certificate.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"version": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeInt,
+			Computed:    true,
+			Description: "The X.509 version of the certificate.",
+			Optional:    true,
+			Type:        schema.TypeInt,
 		},
 	}
+
+	addSecretVersions(certificateSchema, "certificate_data", "password")
+
+	return certificateSchema
 }
 
 func setCertificate(ctx context.Context, d *schema.ResourceData, certificate *certificates.CertificateResource) error {
@@ -314,6 +343,7 @@ func setCertificate(ctx context.Context, d *schema.ResourceData, certificate *ce
 	d.Set("replaced_by", certificate.ReplacedBy)
 	d.Set("serial_number", certificate.SerialNumber)
 	d.Set("signature_algorithm_name", certificate.SignatureAlgorithmName)
+	d.Set("space_id", certificate.SpaceID)
 	d.Set("subject_common_name", certificate.SubjectCommonName)
 	d.Set("subject_distinguished_name", certificate.SubjectDistinguishedName)
 	d.Set("subject_organization", certificate.SubjectOrganization)
@@ -338,5 +368,7 @@ func setCertificate(ctx context.Context, d *schema.ResourceData, certificate *ce
 		return fmt.Errorf("error setting tenant_tags: %s", err)
 	}
 
+	clearVersionedSecrets(d, "certificate_data", "password")
+
 	return nil
 }
@@ -78,8 +78,10 @@ func getChannelDataSchema() map[string]*schema.Schema {
 		},
 		"ids":          getQueryIDs(),
 		"partial_name": getQueryPartialName(),
+		"project_id":   getQueryProjectID(),
 		"skip":         getQuerySkip(),
-		"take":         getQueryTake(),
+		"space_id":     getQuerySpaceID(),
+		"take":         getQueryTakeAll(),
 	}
 }
 
@@ -31,18 +31,21 @@ func flattenChannelRules(channelRules []channels.ChannelRule) []map[string]inter
 func getChannelRuleSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"action_package": {
-			Elem:     &schema.Resource{Schema: getDeploymentActionPackageSchema()},
-			Required: true,
-			Type:     schema.TypeList,
+			Description: "The package references of deployment actions that the rule applies to.",
+			Elem:        &schema.Resource{Schema: getDeploymentActionPackageSchema()},
+			Required:    true,
+			Type:        schema.TypeList,
 		},
 		"id": getIDSchema(),
 		"tag": {
-			Optional: true,
-			Type:     schema.TypeString,
+			Description: "A regular expression that the pre-release tag of package versions must match.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"version_range": {
-			Optional: true,
-			Type:     schema.TypeString,
+			Description: "The range of package versions allowed by the rule, in NuGet or Maven version range syntax.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 	}
 }
@@ -32,7 +32,7 @@ func flattenCloudRegionDeploymentTarget(deploymentTarget *machines.DeploymentTar
 
 func getCloudRegionDeploymentTargetDataSchema() map[string]*schema.Schema {
 	dataSchema := getCloudRegionDeploymentTargetSchema()
-	setDataSchema(&dataSchema)
+	setDeploymentTargetDataSchema(&dataSchema)
 
 	deploymentTargetDataSchema := getDeploymentTargetDataSchema()
 
@@ -0,0 +1,298 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/releases"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandDeployment(d *schema.ResourceData) *deployments.Deployment {
+	deployment := deployments.NewDeployment(d.Get("environment_id").(string), d.Get("release_id").(string))
+	deployment.ID = d.Id()
+	deployment.Comments = d.Get("comments").(string)
+	deployment.ExcludedMachineIDs = getSliceFromTerraformTypeList(d.Get("excluded_machine_ids"))
+	deployment.ForcePackageRedeployment = d.Get("force_package_redeployment").(bool)
+	deployment.SkipActions = getSliceFromTerraformTypeList(d.Get("skip_actions"))
+	deployment.SpecificMachineIDs = getSliceFromTerraformTypeList(d.Get("specific_machine_ids"))
+	deployment.TenantID = d.Get("tenant_id").(string)
+	deployment.UseGuidedFailure = d.Get("use_guided_failure").(bool)
+
+	if v, ok := d.GetOk("form_values"); ok {
+		deployment.FormValues = map[string]string{}
+		for key, value := range v.(map[string]interface{}) {
+			deployment.FormValues[key] = value.(string)
+		}
+	}
+
+	return deployment
+}
+
+func flattenDeploymentData(deployment *deployments.Deployment, release *releases.Release, task *tasks.Task) map[string]interface{} {
+	if deployment == nil {
+		return nil
+	}
+
+	flattenedDeployment := map[string]interface{}{
+		"channel_id":     deployment.ChannelID,
+		"environment_id": deployment.EnvironmentID,
+		"id":             deployment.GetID(),
+		"name":           deployment.Name,
+		"project_id":     deployment.ProjectID,
+		"release_id":     deployment.ReleaseID,
+		"space_id":       deployment.SpaceID,
+		"task_id":        deployment.TaskID,
+		"tenant_id":      deployment.TenantID,
+	}
+
+	if deployment.Created != nil {
+		flattenedDeployment["created"] = deployment.Created.Format(time.RFC3339)
+	}
+
+	if release != nil {
+		flattenedDeployment["release_version"] = release.Version
+	}
+
+	if task != nil {
+		flattenedDeployment["task_state"] = task.State
+
+		if task.CompletedTime != nil {
+			flattenedDeployment["completed_time"] = task.CompletedTime.Format(time.RFC3339)
+		}
+	}
+
+	return flattenedDeployment
+}
+
+func getDeploymentDataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"deployments": {
+			Computed:    true,
+			Description: "A list of deployments that match the filter(s), with the most recent deployment first.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"channel_id": {
+						Computed:    true,
+						Description: "The ID of the channel of the release.",
+						Type:        schema.TypeString,
+					},
+					"completed_time": {
+						Computed:    true,
+						Description: "The time that the task of the deployment completed, in RFC 3339 format. Empty while the deployment is queued or executing.",
+						Type:        schema.TypeString,
+					},
+					"created": {
+						Computed:    true,
+						Description: "The time that the deployment was created, in RFC 3339 format.",
+						Type:        schema.TypeString,
+					},
+					"environment_id": {
+						Computed:    true,
+						Description: "The ID of the environment of the deployment.",
+						Type:        schema.TypeString,
+					},
+					"id": {
+						Computed:    true,
+						Description: "The ID of the deployment.",
+						Type:        schema.TypeString,
+					},
+					"name": {
+						Computed:    true,
+						Description: "The name of the deployment.",
+						Type:        schema.TypeString,
+					},
+					"project_id": {
+						Computed:    true,
+						Description: "The ID of the project of the deployment.",
+						Type:        schema.TypeString,
+					},
+					"release_id": {
+						Computed:    true,
+						Description: "The ID of the release of the deployment.",
+						Type:        schema.TypeString,
+					},
+					"release_version": {
+						Computed:    true,
+						Description: "The version of the release of the deployment.",
+						Type:        schema.TypeString,
+					},
+					"space_id": {
+						Computed:    true,
+						Description: "The space ID associated with this deployment.",
+						Type:        schema.TypeString,
+					},
+					"task_id": {
+						Computed:    true,
+						Description: "The ID of the server task of the deployment.",
+						Type:        schema.TypeString,
+					},
+					"task_state": {
+						Computed:    true,
+						Description: "The state of the server task of the deployment (e.g. `Executing` or `Success`).",
+						Type:        schema.TypeString,
+					},
+					"tenant_id": {
+						Computed:    true,
+						Description: "The ID of the tenant of the deployment.",
+						Type:        schema.TypeString,
+					},
+				},
+			},
+			Type: schema.TypeList,
+		},
+		"environments": getQueryEnvironments(),
+		"id":           getDataSchemaID(),
+		"ids":          getQueryIDs(),
+		"projects":     getQueryProjects(),
+		"skip":         getQuerySkip(),
+		"space_id":     getQuerySpaceID(),
+		"take":         getQueryTake(),
+		"task_state":   getQueryTaskState(),
+		"tenants":      getQueryTenants(),
+	}
+}
+
+func getDeploymentSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"channel_id": {
+			Computed:    true,
+			Description: "The ID of the channel of the release that was deployed.",
+			Type:        schema.TypeString,
+		},
+		"comments": {
+			Description: "Comments recorded against the deployment.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"environment_id": {
+			Description:      "The ID of the environment to deploy the release to.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"excluded_machine_ids": {
+			Description: "The IDs of the deployment targets to exclude from the deployment.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"force_package_redeployment": {
+			Default:     false,
+			Description: "Whether packages are deployed again even if they are already installed on the deployment targets.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"form_values": {
+			Description: "The values of the prompted variables of the deployment, keyed by the ID of the control of each variable.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			ForceNew:    true,
+			Optional:    true,
+			Sensitive:   true,
+			Type:        schema.TypeMap,
+		},
+		"id": getIDSchema(),
+		"project_id": {
+			Computed:    true,
+			Description: "The ID of the project of the release that was deployed.",
+			Type:        schema.TypeString,
+		},
+		"release_id": {
+			Description:      "The ID of the release to deploy.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"skip_actions": {
+			Description: "The IDs of the deployment actions to skip.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"space_id": {
+			Computed:    true,
+			Description: "The space ID associated with this deployment.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"specific_machine_ids": {
+			Description: "The IDs of the deployment targets to deploy to. All of the deployment targets of the environment are deployed to if none are given.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"task_error_message": {
+			Computed:    true,
+			Description: "The error message of the task of the deployment, if it did not succeed.",
+			Type:        schema.TypeString,
+		},
+		"task_id": {
+			Computed:    true,
+			Description: "The ID of the task that runs the deployment.",
+			Type:        schema.TypeString,
+		},
+		"task_state": {
+			Computed:    true,
+			Description: "The state of the task that runs the deployment (e.g. `Success` or `Failed`).",
+			Type:        schema.TypeString,
+		},
+		"tenant_id": {
+			Description: "The ID of the tenant to deploy the release for. Required when the project is tenanted.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"use_guided_failure": {
+			Default:     false,
+			Description: "Whether guided failure mode is used, pausing the deployment for intervention when a step fails.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+	}
+}
+
+func setDeployment(d *schema.ResourceData, deployment *deployments.Deployment) error {
+	d.Set("channel_id", deployment.ChannelID)
+	d.Set("comments", deployment.Comments)
+	d.Set("environment_id", deployment.EnvironmentID)
+	d.Set("force_package_redeployment", deployment.ForcePackageRedeployment)
+	d.Set("project_id", deployment.ProjectID)
+	d.Set("release_id", deployment.ReleaseID)
+	d.Set("space_id", deployment.SpaceID)
+	d.Set("task_id", deployment.TaskID)
+	d.Set("tenant_id", deployment.TenantID)
+	d.Set("use_guided_failure", deployment.UseGuidedFailure)
+
+	if err := d.Set("excluded_machine_ids", deployment.ExcludedMachineIDs); err != nil {
+		return fmt.Errorf("error setting excluded_machine_ids: %s", err)
+	}
+
+	if err := d.Set("skip_actions", deployment.SkipActions); err != nil {
+		return fmt.Errorf("error setting skip_actions: %s", err)
+	}
+
+	if err := d.Set("specific_machine_ids", deployment.SpecificMachineIDs); err != nil {
+		return fmt.Errorf("error setting specific_machine_ids: %s", err)
+	}
+
+	// form values are not returned by Octopus, so the configured values are kept in state
+
+	return nil
+}
+
+func setDeploymentTask(d *schema.ResourceData, task *tasks.Task) {
+	d.Set("task_error_message", task.ErrorMessage)
+	d.Set("task_state", task.State)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func flattenDeploymentAction(action *deployments.DeploymentAction) map[string]interface{} {
@@ -57,7 +58,7 @@ func flattenAction(action *deployments.DeploymentAction) map[string]interface{}
 		flattenedAction["condition"] = action.Condition
 	}
 
-	if action.Container != nil {
+	if !isEmptyContainer(action.Container) {
 		flattenedAction["container"] = flattenContainer(action.Container)
 	}
 
@@ -75,6 +76,8 @@ func flattenAction(action *deployments.DeploymentAction) map[string]interface{}
 
 	if len(action.Name) > 0 {
 		flattenedAction["name"] = action.Name
+		flattenedAction["output_variable_prefix"] = getActionOutputVariablePrefix(action.Name)
+		flattenedAction["slug"] = slugify(action.Name)
 	}
 
 	if len(action.Notes) > 0 {
@@ -118,11 +121,6 @@ func flattenAction(action *deployments.DeploymentAction) map[string]interface{}
 				continue
 			}
 
-			if v, ok := packageReference.Properties["Extract"]; ok {
-				extractDuringDeployment, _ := strconv.ParseBool(v)
-				flattenedPackageReference["extract_during_deployment"] = extractDuringDeployment
-			}
-
 			flattenedPackageReferences = append(flattenedPackageReferences, flattenedPackageReference)
 		}
 		flattenedAction["package"] = flattenedPackageReferences
@@ -131,6 +129,12 @@ func flattenAction(action *deployments.DeploymentAction) map[string]interface{}
 	return flattenedAction
 }
 
+// getActionOutputVariablePrefix returns the prefix of the output variables written by the named action, e.g.
+// Octopus.Action[Deploy Web App].Output.
+func getActionOutputVariablePrefix(name string) string {
+	return fmt.Sprintf("Octopus.Action[%s].Output.", name)
+}
+
 func getDeploymentActionSchema() *schema.Schema {
 	actionSchema, element := getActionSchema()
 	addActionTypeSchema(element)
@@ -176,11 +180,13 @@ func getActionSchema() (*schema.Schema, *schema.Resource) {
 				Type:     schema.TypeBool,
 			},
 			"channels": {
-				Computed:    true,
-				Description: "The channels associated with this deployment action.",
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Optional:    true,
-				Type:        schema.TypeList,
+				Description: "The IDs of the channels this deployment action is limited to. The action runs for releases in any channel when omitted.",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+				},
+				Optional: true,
+				Type:     schema.TypeSet,
 			},
 			"condition": {
 				Computed:    true,
@@ -188,26 +194,20 @@ func getActionSchema() (*schema.Schema, *schema.Resource) {
 				Optional:    true,
 				Type:        schema.TypeString,
 			},
-			"container": {
-				Computed:    true,
-				Description: "The deployment action container associated with this deployment action.",
-				Elem:        &schema.Resource{Schema: getDeploymentActionContainerSchema()},
-				Optional:    true,
-				Type:        schema.TypeList,
-			},
+			"container": getActionContainerSchema(),
 			"environments": {
 				Computed:    true,
 				Description: "The environments within which this deployment action will run.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Optional:    true,
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 			},
 			"excluded_environments": {
 				Computed:    true,
 				Description: "The environments that this step will be skipped in",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Optional:    true,
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 			},
 			"features": {
 				Computed:    true,
@@ -235,14 +235,25 @@ func getActionSchema() (*schema.Schema, *schema.Resource) {
 				Optional:    true,
 				Type:        schema.TypeString,
 			},
+			"output_variable_prefix": {
+				Computed:    true,
+				Description: "The prefix of the output variables written by this deployment action, e.g. `Octopus.Action[Name].Output.`.",
+				Type:        schema.TypeString,
+			},
 			"package": getPackageSchema(false),
 			"properties": {
 				Computed:         true,
-				Description:      "The properties associated with this deployment action.",
+				Description:      "The properties associated with this deployment action. Properties whose values are JSON objects or arrays are compared by their content, so their formatting and key order do not produce a diff.",
+				DiffSuppressFunc: suppressEquivalentJSONPropertyDiff,
 				Elem:             &schema.Schema{Type: schema.TypeString},
 				Optional:         true,
 				Type:             schema.TypeMap,
-				ValidateDiagFunc: warnIfIncludesRunOnServer(),
+				ValidateDiagFunc: warnIfInvalidProperties(),
+			},
+			"slug": {
+				Computed:    true,
+				Description: "The slug of this deployment action, derived from its name.",
+				Type:        schema.TypeString,
 			},
 			"sort_order": {
 				Description: "Order used by terraform to ensure correct ordering of actions. This property must be either omitted from all actions, or provided on all actions",
@@ -264,6 +275,13 @@ func getActionSchema() (*schema.Schema, *schema.Resource) {
 	return actionSchema, element
 }
 
+func warnIfInvalidProperties() schema.SchemaValidateDiagFunc {
+	includesRunOnServer := warnIfIncludesRunOnServer()
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		return append(includesRunOnServer(v, path), warnIfUnknownActionProperties(v, path)...)
+	}
+}
+
 func warnIfIncludesRunOnServer() schema.SchemaValidateDiagFunc {
 	return func(v interface{}, path cty.Path) diag.Diagnostics {
 		var diags diag.Diagnostics
@@ -310,6 +328,20 @@ func warnIfActionTypeExists() schema.SchemaValidateDiagFunc {
 
 		var actionType string
 		switch value {
+		case "Octopus.AwsDeleteCloudFormation":
+			actionType = "delete_aws_cloudformation_action"
+		case "Octopus.AwsRunCloudFormation":
+			actionType = "deploy_aws_cloudformation_action"
+		case "Octopus.AzureResourceGroup":
+			actionType = "azure_resource_group_action"
+		case "Octopus.DeployRelease":
+			actionType = "deploy_release_action"
+		case "Octopus.HealthCheck":
+			actionType = "health_check_action"
+		case "Octopus.IIS":
+			actionType = "deploy_to_iis_action"
+		case "Octopus.Kubernetes.Kustomize":
+			actionType = "kustomize_action"
 		case "Octopus.KubernetesDeploySecret":
 			actionType = "deploy_kubernetes_secret_action"
 		case "Octopus.KubernetesRunScript":
@@ -322,6 +354,8 @@ func warnIfActionTypeExists() schema.SchemaValidateDiagFunc {
 			actionType = "deploy_package_action"
 		case "Octopus.TerraformApply":
 			actionType = "apply_terraform_template_action"
+		case "Octopus.TransferPackage":
+			actionType = "transfer_package_action"
 		case "Octopus.WindowsService":
 			actionType = "deploy_windows_service_action"
 		}
@@ -341,11 +375,12 @@ func warnIfActionTypeExists() schema.SchemaValidateDiagFunc {
 
 func addPropertiesSchema(element *schema.Resource, deprecated string) {
 	element.Schema["properties"] = &schema.Schema{
-		Computed:    true,
-		Description: "The properties associated with this deployment action.",
-		Elem:        &schema.Schema{Type: schema.TypeString},
-		Optional:    true,
-		Type:        schema.TypeMap,
+		Computed:         true,
+		Description:      "The properties associated with this deployment action. Properties whose values are JSON objects or arrays are compared by their content, so their formatting and key order do not produce a diff.",
+		DiffSuppressFunc: suppressEquivalentJSONPropertyDiff,
+		Elem:             &schema.Schema{Type: schema.TypeString},
+		Optional:         true,
+		Type:             schema.TypeMap,
 	}
 
 	if len(deprecated) > 0 {
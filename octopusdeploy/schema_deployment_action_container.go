@@ -3,6 +3,7 @@ package octopusdeploy
 import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func expandContainer(values interface{}) *deployments.DeploymentActionContainer {
@@ -44,6 +45,37 @@ func flattenContainer(deploymentActionContainer *deployments.DeploymentActionCon
 	}}
 }
 
+// isEmptyContainer reports whether the container carries no feed or image, which is how Octopus represents an action
+// that does not run inside an execution container
+func isEmptyContainer(deploymentActionContainer *deployments.DeploymentActionContainer) bool {
+	return deploymentActionContainer == nil || (len(deploymentActionContainer.FeedID) == 0 && len(deploymentActionContainer.Image) == 0)
+}
+
+func getActionContainerSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The execution container that this action runs inside when run on a worker.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"feed_id": {
+					Description:      "The ID of the container registry feed that the image is pulled from.",
+					Required:         true,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+				},
+				"image": {
+					Description:      "The name and tag of the container image, e.g. octopusdeploy/worker-tools:ubuntu.22.04.",
+					Required:         true,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+				},
+			},
+		},
+		MaxItems: 1,
+		Optional: true,
+		Type:     schema.TypeList,
+	}
+}
+
 func getDeploymentActionContainerSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"feed_id": {
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/require"
 )
 
@@ -39,3 +40,33 @@ func TestExpandDeploymentActionContainer(t *testing.T) {
 
 	require.Equal(t, expected, actual)
 }
+
+func TestFlattenActionOmitsEmptyContainer(t *testing.T) {
+	action := deployments.NewDeploymentAction("Test", "Octopus.Script")
+	action.Container = &deployments.DeploymentActionContainer{}
+
+	flattenedAction := flattenAction(action)
+	require.NotContains(t, flattenedAction, "container")
+
+	action.Container = &deployments.DeploymentActionContainer{
+		FeedID: "feeds-123",
+		Image:  "octopusdeploy/worker-tools:ubuntu.22.04",
+	}
+
+	flattenedAction = flattenAction(action)
+	require.Equal(t, []interface{}{map[string]interface{}{
+		"feed_id": "feeds-123",
+		"image":   "octopusdeploy/worker-tools:ubuntu.22.04",
+	}}, flattenedAction["container"])
+}
+
+func TestActionContainerSchema(t *testing.T) {
+	containerSchema := getActionContainerSchema()
+	require.Equal(t, 1, containerSchema.MaxItems)
+	require.False(t, containerSchema.Computed)
+
+	element := containerSchema.Elem.(*schema.Resource)
+	require.True(t, element.Schema["feed_id"].Required)
+	require.True(t, element.Schema["image"].Required)
+	require.True(t, element.Schema["image"].ValidateDiagFunc(" ", nil).HasError())
+}
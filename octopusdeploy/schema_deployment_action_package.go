@@ -69,12 +69,14 @@ func flattenDeploymentActionPackages(deploymentActionPackages []packages.Deploym
 func getDeploymentActionPackageSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"deployment_action": {
-			Optional: true,
-			Type:     schema.TypeString,
+			Description: "The name of the deployment action that references the package.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"package_reference": {
-			Optional: true,
-			Type:     schema.TypeString,
+			Description: "The name of the package reference within the deployment action. Empty for the primary package.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 	}
 }
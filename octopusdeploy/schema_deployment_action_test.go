@@ -44,6 +44,8 @@ func TestFlattenDeploymentAction(t *testing.T) {
 		"is_disabled":                        false,
 		"is_required":                        false,
 		"name":                               name,
+		"output_variable_prefix":             getActionOutputVariablePrefix(name),
+		"slug":                               slugify(name),
 	}
 	require.Equal(t, expected, actual)
 
@@ -56,6 +58,8 @@ func TestFlattenDeploymentAction(t *testing.T) {
 		"is_disabled":                        true,
 		"is_required":                        true,
 		"name":                               name,
+		"output_variable_prefix":             getActionOutputVariablePrefix(name),
+		"slug":                               slugify(name),
 	}
 	require.Equal(t, expected, actual)
 
@@ -69,6 +73,35 @@ func TestFlattenDeploymentAction(t *testing.T) {
 		"is_disabled":                        true,
 		"is_required":                        true,
 		"name":                               name,
+		"output_variable_prefix":             getActionOutputVariablePrefix(name),
+		"slug":                               slugify(name),
 	}
 	require.Equal(t, expected, actual)
 }
+
+func TestFlattenActionOutputVariablePrefix(t *testing.T) {
+	action := deployments.NewDeploymentAction("Deploy Web App", "Octopus.Script")
+
+	flattenedAction := flattenAction(action)
+	require.Equal(t, "Octopus.Action[Deploy Web App].Output.", flattenedAction["output_variable_prefix"])
+	require.Equal(t, "deploy-web-app", flattenedAction["slug"])
+}
+
+func TestExpandActionChannels(t *testing.T) {
+	action := expandAction(map[string]interface{}{
+		"channels": []interface{}{"Channels-1", "Channels-2"},
+		"name":     "Test",
+	})
+	require.Equal(t, []string{"Channels-1", "Channels-2"}, action.Channels)
+	require.Equal(t, []string{"Channels-1", "Channels-2"}, flattenAction(action)["channels"])
+
+	action = expandAction(map[string]interface{}{
+		"channels": []interface{}{},
+		"name":     "Test",
+	})
+	require.Empty(t, action.Channels)
+	require.NotContains(t, flattenAction(action), "channels")
+
+	_, element := getActionSchema()
+	require.False(t, element.Schema["channels"].Computed)
+}
@@ -6,11 +6,12 @@ import (
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func expandDeploymentProcess(ctx context.Context, d *schema.ResourceData, client *client.Client) *deployments.DeploymentProcess {
+func expandDeploymentProcess(ctx context.Context, d *schema.ResourceData, m interface{}, client *client.Client) *deployments.DeploymentProcess {
 	projectID := d.Get("project_id").(string)
 	deploymentProcess := deployments.NewDeploymentProcess(projectID)
 	deploymentProcess.ID = d.Id()
@@ -18,7 +19,7 @@ func expandDeploymentProcess(ctx context.Context, d *schema.ResourceData, client
 	if v, ok := d.GetOk("branch"); ok {
 		deploymentProcess.Branch = v.(string)
 	} else {
-		project, err := client.Projects.GetByID(projectID)
+		project, err := getProject(m, client, projectID)
 		if err != nil {
 			return nil
 		}
@@ -51,6 +52,212 @@ func expandDeploymentProcess(ctx context.Context, d *schema.ResourceData, client
 	return deploymentProcess
 }
 
+func flattenDeploymentProcessDataActions(deploymentActions []*deployments.DeploymentAction) []interface{} {
+	flattenedActions := []interface{}{}
+	for _, deploymentAction := range deploymentActions {
+		if deploymentAction == nil {
+			continue
+		}
+
+		flattenedActions = append(flattenedActions, map[string]interface{}{
+			"action_type":  deploymentAction.ActionType,
+			"channels":     deploymentAction.Channels,
+			"environments": deploymentAction.Environments,
+			"id":           deploymentAction.GetID(),
+			"is_disabled":  deploymentAction.IsDisabled,
+			"name":         deploymentAction.Name,
+			"package":      flattenDeploymentProcessDataPackages(deploymentAction.Packages),
+			"slug":         slugify(deploymentAction.Name),
+		})
+	}
+
+	return flattenedActions
+}
+
+func flattenDeploymentProcessDataPackages(packageReferences []*packages.PackageReference) []interface{} {
+	flattenedPackages := []interface{}{}
+	for _, packageReference := range packageReferences {
+		if packageReference == nil {
+			continue
+		}
+
+		flattenedPackages = append(flattenedPackages, map[string]interface{}{
+			"feed_id":    packageReference.FeedID,
+			"id":         packageReference.ID,
+			"name":       packageReference.Name,
+			"package_id": packageReference.PackageID,
+		})
+	}
+
+	return flattenedPackages
+}
+
+func flattenDeploymentProcessDataSteps(deploymentSteps []*deployments.DeploymentStep) []interface{} {
+	flattenedSteps := []interface{}{}
+	for _, deploymentStep := range deploymentSteps {
+		if deploymentStep == nil {
+			continue
+		}
+
+		flattenedSteps = append(flattenedSteps, map[string]interface{}{
+			"action":        flattenDeploymentProcessDataActions(deploymentStep.Actions),
+			"condition":     string(deploymentStep.Condition),
+			"id":            deploymentStep.GetID(),
+			"name":          deploymentStep.Name,
+			"slug":          slugify(deploymentStep.Name),
+			"start_trigger": string(deploymentStep.StartTrigger),
+		})
+	}
+
+	return flattenedSteps
+}
+
+func getDeploymentProcessDataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"branch": {
+			Description: "The branch to read the deployment process from. Only applies to projects that are stored in version control, and defaults to the default branch of the project.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"deployment_process_id": {
+			Computed:    true,
+			Description: "The ID of the deployment process.",
+			Type:        schema.TypeString,
+		},
+		"id": getDataSchemaID(),
+		"last_snapshot_id": {
+			Computed:    true,
+			Description: "The ID of the last snapshot of the deployment process.",
+			Type:        schema.TypeString,
+		},
+		"project_id": {
+			Description: "The ID of the project of the deployment process.",
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"space_id": {
+			Computed:    true,
+			Description: "The space ID associated with this deployment process. Defaults to the space of the provider.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"step": {
+			Computed:    true,
+			Description: "The steps of the deployment process, in the order that they run.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"action": {
+						Computed:    true,
+						Description: "The actions of the step.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"action_type": {
+									Computed:    true,
+									Description: "The type of the action (e.g. `Octopus.Script`).",
+									Type:        schema.TypeString,
+								},
+								"channels": {
+									Computed:    true,
+									Description: "The IDs of the channels that the action is limited to.",
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Type:        schema.TypeList,
+								},
+								"environments": {
+									Computed:    true,
+									Description: "The IDs of the environments that the action is limited to.",
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Type:        schema.TypeList,
+								},
+								"id": {
+									Computed:    true,
+									Description: "The ID of the action.",
+									Type:        schema.TypeString,
+								},
+								"is_disabled": {
+									Computed:    true,
+									Description: "Whether the action is disabled.",
+									Type:        schema.TypeBool,
+								},
+								"name": {
+									Computed:    true,
+									Description: "The name of the action.",
+									Type:        schema.TypeString,
+								},
+								"package": {
+									Computed:    true,
+									Description: "The packages referenced by the action. The primary package of the action has an empty name.",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"feed_id": {
+												Computed:    true,
+												Description: "The ID of the feed of the package.",
+												Type:        schema.TypeString,
+											},
+											"id": {
+												Computed:    true,
+												Description: "The ID of the package reference.",
+												Type:        schema.TypeString,
+											},
+											"name": {
+												Computed:    true,
+												Description: "The name of the package reference.",
+												Type:        schema.TypeString,
+											},
+											"package_id": {
+												Computed:    true,
+												Description: "The ID of the package in its feed.",
+												Type:        schema.TypeString,
+											},
+										},
+									},
+									Type: schema.TypeList,
+								},
+								"slug": {
+									Computed:    true,
+									Description: "The slug of the action, derived from its name.",
+									Type:        schema.TypeString,
+								},
+							},
+						},
+						Type: schema.TypeList,
+					},
+					"condition": {
+						Computed:    true,
+						Description: "The condition under which the step runs.",
+						Type:        schema.TypeString,
+					},
+					"id": {
+						Computed:    true,
+						Description: "The ID of the step.",
+						Type:        schema.TypeString,
+					},
+					"name": {
+						Computed:    true,
+						Description: "The name of the step.",
+						Type:        schema.TypeString,
+					},
+					"slug": {
+						Computed:    true,
+						Description: "The slug of the step, derived from its name.",
+						Type:        schema.TypeString,
+					},
+					"start_trigger": {
+						Computed:    true,
+						Description: "Whether the step starts after the previous step or together with it.",
+						Type:        schema.TypeString,
+					},
+				},
+			},
+			Type: schema.TypeList,
+		},
+		"version": {
+			Computed:    true,
+			Description: "The version number of the deployment process.",
+			Type:        schema.TypeInt,
+		},
+	}
+}
+
 func setDeploymentProcess(ctx context.Context, d *schema.ResourceData, deploymentProcess *deployments.DeploymentProcess) error {
 	d.Set("branch", deploymentProcess.Branch)
 	d.Set("last_snapshot_id", deploymentProcess.LastSnapshotID)
@@ -64,3 +271,16 @@ func setDeploymentProcess(ctx context.Context, d *schema.ResourceData, deploymen
 
 	return nil
 }
+
+func setDeploymentProcessData(d *schema.ResourceData, deploymentProcess *deployments.DeploymentProcess) error {
+	d.Set("deployment_process_id", deploymentProcess.GetID())
+	d.Set("last_snapshot_id", deploymentProcess.LastSnapshotID)
+	d.Set("space_id", deploymentProcess.SpaceID)
+	d.Set("version", deploymentProcess.Version)
+
+	if err := d.Set("step", flattenDeploymentProcessDataSteps(deploymentProcess.Steps)); err != nil {
+		return fmt.Errorf("error setting step: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetDeploymentProcessData(t *testing.T) {
+	deploymentAction := deployments.NewDeploymentAction("Deploy Web App", "Octopus.TentaclePackage")
+	deploymentAction.ID = "00000000-0000-0000-0000-000000000002"
+	deploymentAction.Packages = []*packages.PackageReference{
+		{FeedID: "Feeds-1", PackageID: "WebApp"},
+		{FeedID: "Feeds-1", Name: "config", PackageID: "WebApp.Config"},
+	}
+
+	deploymentStep := deployments.NewDeploymentStep("Deploy Web App")
+	deploymentStep.ID = "00000000-0000-0000-0000-000000000001"
+	deploymentStep.Actions = []*deployments.DeploymentAction{deploymentAction}
+
+	deploymentProcess := deployments.NewDeploymentProcess("Projects-1")
+	deploymentProcess.ID = "deploymentprocess-Projects-1"
+	deploymentProcess.SpaceID = "Spaces-1"
+	deploymentProcess.Steps = []*deployments.DeploymentStep{deploymentStep}
+	deploymentProcess.Version = 4
+
+	d := schema.TestResourceDataRaw(t, getDeploymentProcessDataSchema(), map[string]interface{}{
+		"project_id": "Projects-1",
+	})
+	require.NoError(t, setDeploymentProcessData(d, deploymentProcess))
+
+	require.Equal(t, "deploymentprocess-Projects-1", d.Get("deployment_process_id"))
+	require.Equal(t, 4, d.Get("version"))
+	require.Equal(t, "deploy-web-app", d.Get("step.0.slug"))
+	require.Equal(t, "StartAfterPrevious", d.Get("step.0.start_trigger"))
+	require.Equal(t, "deploy-web-app", d.Get("step.0.action.0.slug"))
+	require.Equal(t, "Octopus.TentaclePackage", d.Get("step.0.action.0.action_type"))
+	require.Equal(t, 2, d.Get("step.0.action.0.package.#"))
+	require.Equal(t, "", d.Get("step.0.action.0.package.0.name"))
+	require.Equal(t, "WebApp.Config", d.Get("step.0.action.0.package.1.package_id"))
+}
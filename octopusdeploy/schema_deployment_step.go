@@ -68,10 +68,18 @@ func expandDeploymentStep(ctx context.Context, flattenedStep map[string]interfac
 	step_expansion("manual_intervention_action", expandManualInterventionAction)
 	step_expansion("apply_terraform_template_action", expandApplyTerraformTemplateAction)
 	step_expansion("deploy_package_action", expandDeployPackageAction)
+	step_expansion("deploy_release_action", expandDeployReleaseAction)
+	step_expansion("deploy_to_iis_action", expandDeployToIisAction)
 	step_expansion("deploy_windows_service_action", expandDeployWindowsServiceAction)
 	step_expansion("run_script_action", expandRunScriptAction)
 	step_expansion("run_kubectl_script_action", expandRunKubectlScriptAction)
 	step_expansion("deploy_kubernetes_secret_action", expandDeployKubernetesSecretAction)
+	step_expansion("deploy_aws_cloudformation_action", expandDeployAwsCloudFormationAction)
+	step_expansion("delete_aws_cloudformation_action", expandDeleteAwsCloudFormationAction)
+	step_expansion("health_check_action", expandHealthCheckAction)
+	step_expansion("azure_resource_group_action", expandAzureResourceGroupAction)
+	step_expansion("kustomize_action", expandKustomizeAction)
+	step_expansion("transfer_package_action", expandTransferPackageAction)
 
 	// Now that we have extracted all the steps off each of the properties into a single array, sort the array by the sort_order if provided
 	if len(sort_order) > 0 {
@@ -113,6 +121,7 @@ func flattenDeploymentSteps(deploymentSteps []*deployments.DeploymentStep) []map
 		flattenedDeploymentStep["name"] = deploymentStep.Name
 		flattenedDeploymentStep["package_requirement"] = deploymentStep.PackageRequirement
 		flattenedDeploymentStep["properties"] = flattenProperties(deploymentStep.Properties)
+		flattenedDeploymentStep["slug"] = slugify(deploymentStep.Name)
 		flattenedDeploymentStep["start_trigger"] = deploymentStep.StartTrigger
 
 		for propertyName, propertyValue := range deploymentStep.Properties {
@@ -139,6 +148,20 @@ func flattenDeploymentSteps(deploymentSteps []*deployments.DeploymentStep) []map
 
 		for i := range deploymentStep.Actions {
 			switch deploymentStep.Actions[i].ActionType {
+			case "Octopus.AwsDeleteCloudFormation":
+				flatten_action_func("delete_aws_cloudformation_action", i, flattenDeleteAwsCloudFormationAction)
+			case "Octopus.AwsRunCloudFormation":
+				flatten_action_func("deploy_aws_cloudformation_action", i, flattenDeployAwsCloudFormationAction)
+			case "Octopus.AzureResourceGroup":
+				flatten_action_func("azure_resource_group_action", i, flattenAzureResourceGroupAction)
+			case "Octopus.DeployRelease":
+				flatten_action_func("deploy_release_action", i, flattenDeployReleaseAction)
+			case "Octopus.HealthCheck":
+				flatten_action_func("health_check_action", i, flattenHealthCheckAction)
+			case "Octopus.IIS":
+				flatten_action_func("deploy_to_iis_action", i, flattenDeployToIisAction)
+			case "Octopus.Kubernetes.Kustomize":
+				flatten_action_func("kustomize_action", i, flattenKustomizeAction)
 			case "Octopus.KubernetesDeploySecret":
 				flatten_action_func("deploy_kubernetes_secret_action", i, flattenDeployKubernetesSecretAction)
 			case "Octopus.KubernetesRunScript":
@@ -151,6 +174,8 @@ func flattenDeploymentSteps(deploymentSteps []*deployments.DeploymentStep) []map
 				flatten_action_func("deploy_package_action", i, flattenDeployPackageAction)
 			case "Octopus.TerraformApply":
 				flatten_action_func("apply_terraform_template_action", i, flattenApplyTerraformTemplateAction)
+			case "Octopus.TransferPackage":
+				flatten_action_func("transfer_package_action", i, flattenTransferPackageAction)
 			case "Octopus.WindowsService":
 				flatten_action_func("deploy_windows_service_action", i, flattenDeployWindowsServiceAction)
 			default:
@@ -171,6 +196,7 @@ func getDeploymentStepSchema() *schema.Schema {
 			Schema: map[string]*schema.Schema{
 				"action":                          getDeploymentActionSchema(),
 				"apply_terraform_template_action": getApplyTerraformTemplateActionSchema(),
+				"azure_resource_group_action":     getAzureResourceGroupActionSchema(),
 				"condition": {
 					Default:     "Success",
 					Description: "When to run the step, one of 'Success', 'Failure', 'Always' or 'Variable'",
@@ -189,12 +215,18 @@ func getDeploymentStepSchema() *schema.Schema {
 					Optional:    true,
 					Type:        schema.TypeString,
 				},
-				"deploy_kubernetes_secret_action": getDeployKubernetesSecretActionSchema(),
-				"deploy_package_action":           getDeployPackageActionSchema(),
-				"deploy_windows_service_action":   getDeployWindowsServiceActionSchema(),
-				"id":                              getIDSchema(),
-				"manual_intervention_action":      getManualInterventionActionSchema(),
-				"name":                            getNameSchema(true),
+				"delete_aws_cloudformation_action": getDeleteAwsCloudFormationActionSchema(),
+				"deploy_aws_cloudformation_action": getDeployAwsCloudFormationActionSchema(),
+				"deploy_kubernetes_secret_action":  getDeployKubernetesSecretActionSchema(),
+				"deploy_package_action":            getDeployPackageActionSchema(),
+				"deploy_release_action":            getDeployReleaseActionSchema(),
+				"deploy_to_iis_action":             getDeployToIisActionSchema(),
+				"deploy_windows_service_action":    getDeployWindowsServiceActionSchema(),
+				"health_check_action":              getHealthCheckActionSchema(),
+				"id":                               getIDSchema(),
+				"kustomize_action":                 getKustomizeActionSchema(),
+				"manual_intervention_action":       getManualInterventionActionSchema(),
+				"name":                             getNameSchema(true),
 				"package_requirement": {
 					Default:     "LetOctopusDecide",
 					Description: "Whether to run this step before or after package acquisition (if possible)",
@@ -213,6 +245,11 @@ func getDeploymentStepSchema() *schema.Schema {
 				},
 				"run_kubectl_script_action": getRunKubectlScriptSchema(),
 				"run_script_action":         getRunScriptActionSchema(),
+				"slug": {
+					Computed:    true,
+					Description: "The slug of this step, derived from its name.",
+					Type:        schema.TypeString,
+				},
 				"start_trigger": {
 					Default:     "StartAfterPrevious",
 					Description: "Whether to run this step after the previous step ('StartAfterPrevious') or at the same time as the previous step ('StartWithPrevious')",
@@ -230,6 +267,7 @@ func getDeploymentStepSchema() *schema.Schema {
 					Optional:    true,
 					Type:        schema.TypeList,
 				},
+				"transfer_package_action": getTransferPackageActionSchema(),
 				"window_size": {
 					Description: "The maximum number of targets to deploy to simultaneously",
 					Optional:    true,
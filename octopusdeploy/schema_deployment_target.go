@@ -7,6 +7,7 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func expandDeploymentTarget(d *schema.ResourceData) *machines.DeploymentTarget {
@@ -77,7 +78,7 @@ func flattenDeploymentTarget(deploymentTarget *machines.DeploymentTarget) map[st
 
 func getDeploymentTargetDataSchema() map[string]*schema.Schema {
 	dataSchema := getDeploymentTargetSchema()
-	setDataSchema(&dataSchema)
+	setDeploymentTargetDataSchema(&dataSchema)
 
 	return map[string]*schema.Schema{
 		"communication_styles": getQueryCommunicationStyles(),
@@ -98,13 +99,21 @@ func getDeploymentTargetDataSchema() map[string]*schema.Schema {
 		"roles":           getQueryRoles(),
 		"shell_names":     getQueryShellNames(),
 		"skip":            getQuerySkip(),
-		"take":            getQueryTake(),
+		"space_id":        getQuerySpaceID(),
+		"take":            getQueryTakeAll(),
 		"tenants":         getQueryTenants(),
 		"tenant_tags":     getQueryTenantTags(),
 		"thumbprint":      getQueryThumbprint(),
 	}
 }
 
+// setDeploymentTargetDataSchema converts the schema of a deployment target resource into the schema of the deployment
+// targets returned by a data source. Attributes that only control how the resource is applied are removed.
+func setDeploymentTargetDataSchema(dataSchema *map[string]*schema.Schema) {
+	setDataSchema(dataSchema)
+	delete(*dataSchema, "wait_for_healthy")
+}
+
 func getDeploymentTargetSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"endpoint": {
@@ -119,7 +128,7 @@ func getDeploymentTargetSchema() map[string]*schema.Schema {
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			MinItems:    1,
 			Required:    true,
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 		},
 		"has_latest_calamari": {
 			Computed: true,
@@ -151,7 +160,7 @@ func getDeploymentTargetSchema() map[string]*schema.Schema {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 			MinItems: 1,
 			Required: true,
-			Type:     schema.TypeList,
+			Type:     schema.TypeSet,
 		},
 		"shell_name": {
 			Computed: true,
@@ -166,9 +175,9 @@ func getDeploymentTargetSchema() map[string]*schema.Schema {
 		"space_id":                          getSpaceIDSchema(),
 		"status":                            getStatusSchema(),
 		"status_summary":                    getStatusSummarySchema(),
-		"tenanted_deployment_participation": getTenantedDeploymentSchema(),
-		"tenants":                           getTenantsSchema(),
-		"tenant_tags":                       getTenantTagsSchema(),
+		"tenanted_deployment_participation": getDeploymentTargetTenantedDeploymentSchema(),
+		"tenants":                           getDeploymentTargetTenantsSchema(),
+		"tenant_tags":                       getDeploymentTargetTenantTagsSchema(),
 		"thumbprint": {
 			Computed: true,
 			Optional: true,
@@ -179,7 +188,63 @@ func getDeploymentTargetSchema() map[string]*schema.Schema {
 			Optional: true,
 			Type:     schema.TypeString,
 		},
+		"wait_for_healthy": getWaitForHealthySchema(),
+	}
+}
+
+// getDeploymentTargetTenantedDeploymentSchema returns the schema of the tenanted deployment mode of deployment targets.
+// Unlike other tenanted resources, targets default to untenanted so that a target is returned to untenanted deployments
+// when the attribute is removed.
+func getDeploymentTargetTenantedDeploymentSchema() *schema.Schema {
+	return &schema.Schema{
+		Default:     string(core.TenantedDeploymentModeUntenanted),
+		Description: "Whether the deployment target is included in tenanted deployments, untenanted deployments, or both. Valid modes are `Untenanted`, `TenantedOrUntenanted`, or `Tenanted`.",
+		Optional:    true,
+		Type:        schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+			string(core.TenantedDeploymentModeUntenanted),
+			string(core.TenantedDeploymentModeTenantedOrUntenanted),
+			string(core.TenantedDeploymentModeTenanted),
+		}, false)),
+	}
+}
+
+func getDeploymentTargetTenantsSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The IDs of the tenants that the deployment target is used for in tenanted deployments.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Type:        schema.TypeSet,
+	}
+}
+
+func getDeploymentTargetTenantTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The canonical names of the tenant tags, such as `Region/West`, of the tenants that the deployment target is used for in tenanted deployments.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Type:        schema.TypeSet,
+	}
+}
+
+// validateDeploymentTargetTenants rejects tenants and tenant tags on deployment targets that only take part in
+// untenanted deployments, which Octopus would otherwise ignore.
+func validateDeploymentTargetTenants(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("tenanted_deployment_participation").(string) != string(core.TenantedDeploymentModeUntenanted) {
+		return nil
+	}
+
+	for _, key := range []string{"tenants", "tenant_tags"} {
+		if !d.NewValueKnown(key) {
+			continue
+		}
+
+		if v, ok := d.GetOk(key); ok && v.(*schema.Set).Len() > 0 {
+			return fmt.Errorf("%s requires tenanted_deployment_participation to be `Tenanted` or `TenantedOrUntenanted`", key)
+		}
 	}
+
+	return nil
 }
 
 func setDeploymentTarget(ctx context.Context, d *schema.ResourceData, deploymentTarget *machines.DeploymentTarget) error {
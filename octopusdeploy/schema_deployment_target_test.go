@@ -0,0 +1,56 @@
+package octopusdeploy
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentTargetTenantSchemas(t *testing.T) {
+	expected := getDeploymentTargetSchema()
+
+	for name, resource := range Provider().ResourcesMap {
+		if !strings.HasSuffix(name, "_deployment_target") {
+			continue
+		}
+
+		for _, key := range []string{"tenanted_deployment_participation", "tenants", "tenant_tags"} {
+			require.Contains(t, resource.Schema, key, name)
+			require.Equal(t, expected[key].Type, resource.Schema[key].Type, name)
+			require.Equal(t, expected[key].Computed, resource.Schema[key].Computed, name)
+			require.Equal(t, expected[key].Default, resource.Schema[key].Default, name)
+			require.Equal(t, expected[key].Description, resource.Schema[key].Description, name)
+		}
+
+		require.NotNil(t, resource.CustomizeDiff, name)
+	}
+}
+
+func TestValidateDeploymentTargetTenants(t *testing.T) {
+	resource := resourceCloudRegionDeploymentTarget()
+	config := map[string]interface{}{
+		"environments": []interface{}{"Environments-1"},
+		"name":         "Cloud",
+		"roles":        []interface{}{"web"},
+		"tenant_tags":  []interface{}{"Region/West"},
+	}
+
+	// targets are untenanted unless configured otherwise, so tenant tags are rejected
+	_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	require.ErrorContains(t, err, "tenant_tags requires tenanted_deployment_participation")
+
+	config["tenanted_deployment_participation"] = "Tenanted"
+	diff, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+	require.Equal(t, "Tenanted", diff.Attributes["tenanted_deployment_participation"].New)
+
+	// targets without tenants default to untenanted deployments
+	delete(config, "tenant_tags")
+	delete(config, "tenanted_deployment_participation")
+	diff, err = resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+	require.Equal(t, "Untenanted", diff.Attributes["tenanted_deployment_participation"].New)
+}
@@ -0,0 +1,87 @@
+package octopusdeploy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/releases"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandDeployment(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getDeploymentSchema(), map[string]interface{}{
+		"environment_id":       "Environments-1",
+		"release_id":           "Releases-1",
+		"tenant_id":            "Tenants-1",
+		"specific_machine_ids": []interface{}{"Machines-1", "Machines-2"},
+		"form_values": map[string]interface{}{
+			"control-1": "approved",
+		},
+	})
+
+	deployment := expandDeployment(d)
+	require.Equal(t, "Environments-1", deployment.EnvironmentID)
+	require.Equal(t, "Releases-1", deployment.ReleaseID)
+	require.Equal(t, "Tenants-1", deployment.TenantID)
+	require.Equal(t, []string{"Machines-1", "Machines-2"}, deployment.SpecificMachineIDs)
+	require.Equal(t, map[string]string{"control-1": "approved"}, deployment.FormValues)
+	require.False(t, deployment.UseGuidedFailure)
+
+	deployment.ID = "Deployments-1"
+	deployment.ProjectID = "Projects-1"
+	deployment.SpaceID = "Spaces-1"
+	deployment.TaskID = "ServerTasks-1"
+
+	task := tasks.NewTask()
+	task.State = "Failed"
+	task.ErrorMessage = "The deployment failed"
+
+	state := schema.TestResourceDataRaw(t, getDeploymentSchema(), map[string]interface{}{})
+	require.NoError(t, setDeployment(state, deployment))
+	setDeploymentTask(state, task)
+	require.Equal(t, "Projects-1", state.Get("project_id"))
+	require.Equal(t, "ServerTasks-1", state.Get("task_id"))
+	require.Equal(t, "Failed", state.Get("task_state"))
+	require.Equal(t, "The deployment failed", state.Get("task_error_message"))
+	require.Equal(t, []interface{}{"Machines-1", "Machines-2"}, state.Get("specific_machine_ids"))
+}
+
+func TestFlattenDeploymentData(t *testing.T) {
+	created := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	completed := created.Add(5 * time.Minute)
+
+	deployment := deployments.NewDeployment("Environments-1", "Releases-1")
+	deployment.ID = "Deployments-1"
+	deployment.Created = &created
+	deployment.ProjectID = "Projects-1"
+	deployment.TaskID = "ServerTasks-1"
+
+	release := releases.NewRelease("Channels-1", "Projects-1", "1.2.3")
+	release.ID = "Releases-1"
+
+	task := tasks.NewTask()
+	task.ID = "ServerTasks-1"
+	task.CompletedTime = &completed
+	task.State = "Success"
+
+	flattenedDeployment := flattenDeploymentData(deployment, release, task)
+	require.Equal(t, "Deployments-1", flattenedDeployment["id"])
+	require.Equal(t, "Environments-1", flattenedDeployment["environment_id"])
+	require.Equal(t, "1.2.3", flattenedDeployment["release_version"])
+	require.Equal(t, "Success", flattenedDeployment["task_state"])
+	require.Equal(t, "2023-05-01T10:00:00Z", flattenedDeployment["created"])
+	require.Equal(t, "2023-05-01T10:05:00Z", flattenedDeployment["completed_time"])
+
+	// a deployment that is still executing has no completed time
+	task.CompletedTime = nil
+	task.State = "Executing"
+
+	flattenedDeployment = flattenDeploymentData(deployment, release, task)
+	require.Equal(t, "Executing", flattenedDeployment["task_state"])
+	require.NotContains(t, flattenedDeployment, "completed_time")
+
+	require.Nil(t, flattenDeploymentData(nil, nil, nil))
+}
@@ -40,8 +40,8 @@ func expandDockerContainerRegistry(d *schema.ResourceData) (*feeds.DockerContain
 		feed.PackageAcquisitionLocationOptions = getSliceFromTerraformTypeList(v)
 	}
 
-	if v, ok := d.GetOk("password"); ok {
-		feed.Password = core.NewSensitiveValue(v.(string))
+	if v := getSensitiveString(d, "password"); len(v) > 0 {
+		feed.Password = core.NewSensitiveValue(v)
 	}
 
 	if v, ok := d.GetOk("space_id"); ok {
@@ -56,7 +56,7 @@ func expandDockerContainerRegistry(d *schema.ResourceData) (*feeds.DockerContain
 }
 
 func getDockerContainerRegistrySchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	feedSchema := map[string]*schema.Schema{
 		"api_version": {
 			Optional: true,
 			Type:     schema.TypeString,
@@ -88,6 +88,10 @@ func getDockerContainerRegistrySchema() map[string]*schema.Schema {
 		"space_id": getSpaceIDSchema(),
 		"username": getUsernameSchema(false),
 	}
+
+	addSecretVersions(feedSchema, "password")
+
+	return feedSchema
 }
 
 func setDockerContainerRegistry(ctx context.Context, d *schema.ResourceData, feed *feeds.DockerContainerRegistry) error {
@@ -104,5 +108,7 @@ func setDockerContainerRegistry(ctx context.Context, d *schema.ResourceData, fee
 
 	d.SetId(feed.GetID())
 
+	clearVersionedSecrets(d, "password")
+
 	return nil
 }
@@ -26,15 +26,21 @@ func expandEnvironment(d *schema.ResourceData) *environments.Environment {
 	}
 
 	if v, ok := d.GetOk("jira_extension_settings"); ok {
-		environment.ExtensionSettings = append(environment.ExtensionSettings, env.ExpandJiraExtensionSettings(v))
+		if jiraExtensionSettings := env.ExpandJiraExtensionSettings(v); jiraExtensionSettings != nil {
+			environment.ExtensionSettings = append(environment.ExtensionSettings, jiraExtensionSettings)
+		}
 	}
 
 	if v, ok := d.GetOk("jira_service_management_extension_settings"); ok {
-		environment.ExtensionSettings = append(environment.ExtensionSettings, env.ExpandJiraServiceManagementExtensionSettings(v))
+		if jiraServiceManagementExtensionSettings := env.ExpandJiraServiceManagementExtensionSettings(v); jiraServiceManagementExtensionSettings != nil {
+			environment.ExtensionSettings = append(environment.ExtensionSettings, jiraServiceManagementExtensionSettings)
+		}
 	}
 
 	if v, ok := d.GetOk("servicenow_extension_settings"); ok {
-		environment.ExtensionSettings = append(environment.ExtensionSettings, env.ExpandServiceNowExtensionSettings(v))
+		if serviceNowExtensionSettings := env.ExpandServiceNowExtensionSettings(v); serviceNowExtensionSettings != nil {
+			environment.ExtensionSettings = append(environment.ExtensionSettings, serviceNowExtensionSettings)
+		}
 	}
 
 	if v, ok := d.GetOk("slug"); ok {
@@ -111,20 +117,22 @@ func getEnvironmentDataSchema() map[string]*schema.Schema {
 		"name":         getQueryName(),
 		"partial_name": getQueryPartialName(),
 		"skip":         getQuerySkip(),
-		"take":         getQueryTake(),
+		"space_id":     getQuerySpaceID(),
+		"take":         getQueryTakeAll(),
 	}
 }
 
 func getEnvironmentSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"allow_dynamic_infrastructure": {
-			Optional: true,
-			Type:     schema.TypeBool,
+			Description: "Whether deployment targets can be created in this environment during a deployment, such as by a step that provisions cloud infrastructure.",
+			Optional:    true,
+			Type:        schema.TypeBool,
 		},
 		"description": getDescriptionSchema("environment"),
 		"id":          getIDSchema(),
 		"jira_extension_settings": {
-			Description: "Provides extension settings for the Jira integration for this environment.",
+			Description: "Provides extension settings for the Jira integration for this environment, mapping it to a Jira environment type for deployment tracking.",
 			Elem:        &schema.Resource{Schema: env.GetJiraExtensionSettingsSchema()},
 			MaxItems:    1,
 			Optional:    true,
@@ -146,8 +154,9 @@ func getEnvironmentSchema() map[string]*schema.Schema {
 			Type:        schema.TypeList,
 		},
 		"slug": {
-			Computed: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The unique slug of this environment, generated by Octopus from its name.",
+			Type:        schema.TypeString,
 		},
 		"sort_order": {
 			Computed:    true,
@@ -163,8 +172,9 @@ func getEnvironmentSchema() map[string]*schema.Schema {
 			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
 		},
 		"use_guided_failure": {
-			Optional: true,
-			Type:     schema.TypeBool,
+			Description: "Whether guided failure mode is used by default for deployments to this environment, pausing a deployment for intervention when a step fails. Projects and runbooks whose guided failure mode is `EnvironmentDefault` use this setting.",
+			Optional:    true,
+			Type:        schema.TypeBool,
 		},
 	}
 }
@@ -173,10 +183,8 @@ func setEnvironment(ctx context.Context, d *schema.ResourceData, environment *en
 	d.Set("allow_dynamic_infrastructure", environment.AllowDynamicInfrastructure)
 	d.Set("description", environment.Description)
 
-	if len(environment.ExtensionSettings) != 0 {
-		if err := env.SetExtensionSettings(d, environment.ExtensionSettings); err != nil {
-			return fmt.Errorf("error setting extension settings: %s", err)
-		}
+	if err := env.SetExtensionSettings(d, environment.ExtensionSettings); err != nil {
+		return fmt.Errorf("error setting extension settings: %s", err)
 	}
 
 	d.Set("name", environment.Name)
@@ -0,0 +1,65 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/environments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandEnvironmentExtensionSettings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getEnvironmentSchema(), map[string]interface{}{
+		"allow_dynamic_infrastructure": true,
+		"jira_extension_settings": []interface{}{
+			map[string]interface{}{"environment_type": "production"},
+		},
+		"jira_service_management_extension_settings": []interface{}{
+			map[string]interface{}{"is_enabled": true},
+		},
+		"name": "Production",
+		"servicenow_extension_settings": []interface{}{
+			map[string]interface{}{"is_enabled": false},
+		},
+		"use_guided_failure": true,
+	})
+
+	environment := expandEnvironment(d)
+	require.True(t, environment.AllowDynamicInfrastructure)
+	require.True(t, environment.UseGuidedFailure)
+	require.Len(t, environment.ExtensionSettings, 3)
+
+	jiraExtensionSettings, ok := environment.ExtensionSettings[0].(*environments.JiraExtensionSettings)
+	require.True(t, ok)
+	require.Equal(t, "production", jiraExtensionSettings.JiraEnvironmentType)
+
+	jiraServiceManagementExtensionSettings, ok := environment.ExtensionSettings[1].(*environments.JiraServiceManagementExtensionSettings)
+	require.True(t, ok)
+	require.True(t, jiraServiceManagementExtensionSettings.IsChangeControlled())
+
+	serviceNowExtensionSettings, ok := environment.ExtensionSettings[2].(*environments.ServiceNowExtensionSettings)
+	require.True(t, ok)
+	require.False(t, serviceNowExtensionSettings.IsChangeControlled())
+}
+
+func TestSetEnvironmentExtensionSettings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getEnvironmentSchema(), map[string]interface{}{})
+	environment := environments.NewEnvironment("Production")
+	environment.ID = "Environments-1"
+
+	environment.ExtensionSettings = append(environment.ExtensionSettings,
+		environments.NewJiraExtensionSettings("staging"),
+		environments.NewServiceNowExtensionSettings(true),
+	)
+	require.NoError(t, setEnvironment(context.Background(), d, environment))
+	require.Equal(t, "staging", d.Get("jira_extension_settings.0.environment_type"))
+	require.True(t, d.Get("servicenow_extension_settings.0.is_enabled").(bool))
+	require.Empty(t, d.Get("jira_service_management_extension_settings"))
+
+	// settings removed in Octopus are removed from state
+	environment.ExtensionSettings = nil
+	require.NoError(t, setEnvironment(context.Background(), d, environment))
+	require.Empty(t, d.Get("jira_extension_settings"))
+	require.Empty(t, d.Get("servicenow_extension_settings"))
+}
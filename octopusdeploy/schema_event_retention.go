@@ -0,0 +1,30 @@
+package octopusdeploy
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/configuration"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandEventRetention(d *schema.ResourceData) *configuration.EventRetention {
+	return &configuration.EventRetention{
+		EventRetentionDays: d.Get("retention_days").(int),
+	}
+}
+
+func getEventRetentionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": getIDSchema(),
+		"retention_days": {
+			Description:      "The number of days that audit events are kept in the database. Older events are moved to the event archive, where they are kept as files until they are deleted from the archive.",
+			Required:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+	}
+}
+
+func setEventRetention(d *schema.ResourceData, eventRetention *configuration.EventRetention) {
+	d.Set("retention_days", eventRetention.EventRetentionDays)
+	d.SetId(configuration.EventRetentionID)
+}
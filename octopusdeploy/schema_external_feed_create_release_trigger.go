@@ -0,0 +1,148 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	trg "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/triggers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// feedFilterType is the filter type of triggers that fire when new versions of packages are available in an external feed
+const feedFilterType = "FeedFilter"
+
+func expandExternalFeedCreateReleaseTrigger(d *schema.ResourceData, project *projects.Project) *trg.ReleaseCreationTrigger {
+	trigger := trg.NewReleaseCreationTrigger(d.Get("name").(string), project.GetID(), project.SpaceID, d.Get("channel_id").(string), feedFilterType)
+	trigger.Description = d.Get("description").(string)
+	trigger.ID = d.Id()
+	trigger.IsDisabled = d.Get("is_disabled").(bool)
+
+	for _, v := range d.Get("package").([]interface{}) {
+		packageMap := v.(map[string]interface{})
+		trigger.Filter.Packages = append(trigger.Filter.Packages, trg.DeploymentActionSlugPackage{
+			DeploymentActionSlug: packageMap["deployment_action_slug"].(string),
+			PackageReference:     packageMap["package_reference"].(string),
+		})
+	}
+
+	// the primary package of an action is identified by an empty package reference
+	for _, v := range d.Get("primary_package").([]interface{}) {
+		packageMap := v.(map[string]interface{})
+		trigger.Filter.Packages = append(trigger.Filter.Packages, trg.DeploymentActionSlugPackage{
+			DeploymentActionSlug: packageMap["deployment_action_slug"].(string),
+		})
+	}
+
+	return trigger
+}
+
+func getExternalFeedCreateReleaseTriggerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"channel_id": {
+			Description:      "The ID of the channel in which releases are created.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"description": getDescriptionSchema("external feed trigger"),
+		"id":          getIDSchema(),
+		"is_disabled": {
+			Default:     false,
+			Description: "Whether the trigger is disabled.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"name": getNameSchema(true),
+		"package": {
+			AtLeastOneOf: []string{"package", "primary_package"},
+			Description:  "A named package reference of a deployment action that is monitored for new versions.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"deployment_action_slug": {
+						Description:      "The slug of the deployment action that references the package.",
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+					},
+					"package_reference": {
+						Description:      "The name of the package reference of the deployment action.",
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+					},
+				},
+			},
+			Optional: true,
+			Type:     schema.TypeList,
+		},
+		"primary_package": {
+			AtLeastOneOf: []string{"package", "primary_package"},
+			Description:  "The primary package of a deployment action that is monitored for new versions.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"deployment_action_slug": {
+						Description:      "The slug of the deployment action whose primary package is monitored.",
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+					},
+				},
+			},
+			Optional: true,
+			Type:     schema.TypeList,
+		},
+		"project_id": {
+			Description:      "The ID of the project to attach the trigger.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"space_id": {
+			Computed:    true,
+			Description: "The space ID associated with this trigger.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func setExternalFeedCreateReleaseTrigger(d *schema.ResourceData, trigger *trg.ReleaseCreationTrigger) error {
+	if trigger.Filter.FilterType != feedFilterType {
+		return fmt.Errorf("the trigger %s is not an external feed trigger (%s)", trigger.GetID(), trigger.Filter.FilterType)
+	}
+
+	d.Set("channel_id", trigger.Action.ChannelID)
+	d.Set("description", trigger.Description)
+	d.Set("is_disabled", trigger.IsDisabled)
+	d.Set("name", trigger.Name)
+	d.Set("project_id", trigger.ProjectID)
+	d.Set("space_id", trigger.SpaceID)
+
+	packages := []interface{}{}
+	primaryPackages := []interface{}{}
+	for _, p := range trigger.Filter.Packages {
+		if len(p.PackageReference) == 0 {
+			primaryPackages = append(primaryPackages, map[string]interface{}{
+				"deployment_action_slug": p.DeploymentActionSlug,
+			})
+			continue
+		}
+
+		packages = append(packages, map[string]interface{}{
+			"deployment_action_slug": p.DeploymentActionSlug,
+			"package_reference":      p.PackageReference,
+		})
+	}
+
+	if err := d.Set("package", packages); err != nil {
+		return fmt.Errorf("error setting package: %s", err)
+	}
+
+	if err := d.Set("primary_package", primaryPackages); err != nil {
+		return fmt.Errorf("error setting primary_package: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	trg "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/triggers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalFeedCreateReleaseTrigger(t *testing.T) {
+	project := projects.NewProject("Project", "Lifecycles-1", "ProjectGroups-1")
+	project.ID = "Projects-1"
+	project.SpaceID = "Spaces-1"
+
+	d := schema.TestResourceDataRaw(t, getExternalFeedCreateReleaseTriggerSchema(), map[string]interface{}{
+		"channel_id": "Channels-1",
+		"name":       "New container image",
+		"project_id": "Projects-1",
+		"package": []interface{}{map[string]interface{}{
+			"deployment_action_slug": "deploy-container",
+			"package_reference":      "sidecar",
+		}},
+		"primary_package": []interface{}{map[string]interface{}{
+			"deployment_action_slug": "deploy-container",
+		}},
+	})
+
+	trigger := expandExternalFeedCreateReleaseTrigger(d, project)
+
+	b, err := json.Marshal(trigger)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"Action": {"ActionType": "CreateRelease", "ChannelId": "Channels-1"},
+		"Filter": {
+			"FilterType": "FeedFilter",
+			"Packages": [
+				{"DeploymentActionSlug": "deploy-container", "PackageReference": "sidecar"},
+				{"DeploymentActionSlug": "deploy-container"}
+			]
+		},
+		"IsDisabled": false,
+		"Name": "New container image",
+		"ProjectId": "Projects-1",
+		"SpaceId": "Spaces-1"
+	}`, string(b))
+
+	var readTrigger trg.ReleaseCreationTrigger
+	require.NoError(t, json.Unmarshal(b, &readTrigger))
+
+	state := schema.TestResourceDataRaw(t, getExternalFeedCreateReleaseTriggerSchema(), map[string]interface{}{})
+	require.NoError(t, setExternalFeedCreateReleaseTrigger(state, &readTrigger))
+	require.Equal(t, "Channels-1", state.Get("channel_id"))
+	require.Equal(t, "sidecar", state.Get("package.0.package_reference"))
+	require.Len(t, state.Get("package").([]interface{}), 1)
+	require.Equal(t, "deploy-container", state.Get("primary_package.0.deployment_action_slug"))
+	require.Equal(t, "Spaces-1", state.Get("space_id"))
+
+	readTrigger.Filter.FilterType = "GitFilter"
+	require.Error(t, setExternalFeedCreateReleaseTrigger(state, &readTrigger))
+}
@@ -3,6 +3,7 @@ package octopusdeploy
 import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func expandExternalSecurityGroups(externalSecurityGroups []interface{}) []core.NamedReferenceItem {
@@ -53,15 +54,21 @@ func flattenExternalSecurityGroups(externalSecurityGroups []core.NamedReferenceI
 func getExternalSecurityGroupsSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"display_id_and_name": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeBool,
+			Default:     false,
+			Description: "Whether the ID of the group is shown alongside its display name.",
+			Optional:    true,
+			Type:        schema.TypeBool,
 		},
 		"display_name": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Description: "The name of the group as shown in Octopus.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"id": {
+			Description:      "The ID of the group in the directory, such as the security identifier (SID) of an Active Directory group or the object ID of a Microsoft Entra ID group.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
 		},
-		"id": getIDSchema(),
 	}
 }
@@ -0,0 +1,107 @@
+package octopusdeploy
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/configuration"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// featuresConfigurationID is the ID of the features configuration, of which there is exactly one per Octopus instance.
+const featuresConfigurationID = "FeaturesConfiguration"
+
+// featuresConfigurationProperties maps the attributes of the features configuration to the names of their properties
+// in the API.
+var featuresConfigurationProperties = map[string]string{
+	"help_sidebar_support_link":             "HelpSidebarSupportLink",
+	"is_built_in_worker_enabled":            "IsBuiltInWorkerEnabled",
+	"is_community_action_templates_enabled": "IsCommunityActionTemplatesEnabled",
+	"is_help_sidebar_enabled":               "IsHelpSidebarEnabled",
+}
+
+// expandFeaturesConfiguration applies the configured features to the features currently held by Octopus. Features that
+// are not configured are sent back unchanged. The configuration tells a feature that is turned off apart from one that
+// is omitted.
+func expandFeaturesConfiguration(d *schema.ResourceData, config cty.Value, featuresConfiguration configuration.FeaturesConfiguration) configuration.FeaturesConfiguration {
+	expandedFeaturesConfiguration := configuration.FeaturesConfiguration{}
+	for property, value := range featuresConfiguration {
+		expandedFeaturesConfiguration[property] = value
+	}
+
+	for key, property := range featuresConfigurationProperties {
+		if isConfigured(config, key) {
+			expandedFeaturesConfiguration[property] = d.Get(key)
+		}
+	}
+
+	for property, value := range d.Get("features").(map[string]interface{}) {
+		expandedFeaturesConfiguration[property] = value
+	}
+
+	return expandedFeaturesConfiguration
+}
+
+func getFeaturesConfigurationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"features": {
+			Description: "Other server features, such as experimental features, keyed by the name of their property in the features configuration API. Features that are not listed are left unchanged.",
+			Elem:        &schema.Schema{Type: schema.TypeBool},
+			Optional:    true,
+			Type:        schema.TypeMap,
+		},
+		"help_sidebar_support_link": {
+			Computed:    true,
+			Description: "The link to the support of your organization that is shown in the help sidebar.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"id": getIDSchema(),
+		"is_built_in_worker_enabled": {
+			Computed:    true,
+			Description: "Whether steps can run on the built-in worker of the Octopus Server.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"is_community_action_templates_enabled": {
+			Computed:    true,
+			Description: "Whether community step templates can be browsed and installed from the community library.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"is_help_sidebar_enabled": {
+			Computed:    true,
+			Description: "Whether the help sidebar is shown in the Octopus portal.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+	}
+}
+
+// isConfigured reports whether an attribute is set in the configuration, which tells an attribute that is set to its
+// zero value apart from one that is omitted.
+func isConfigured(config cty.Value, key string) bool {
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(key) {
+		return false
+	}
+
+	return !config.GetAttr(key).IsNull()
+}
+
+// setFeaturesConfiguration refreshes the state from the features held by Octopus. Only the other features that are
+// configured are kept in state.
+func setFeaturesConfiguration(d *schema.ResourceData, featuresConfiguration configuration.FeaturesConfiguration) {
+	for key, property := range featuresConfigurationProperties {
+		if value, ok := featuresConfiguration[property]; ok {
+			d.Set(key, value)
+		}
+	}
+
+	features := map[string]interface{}{}
+	for property := range d.Get("features").(map[string]interface{}) {
+		if value, ok := featuresConfiguration[property].(bool); ok {
+			features[property] = value
+		}
+	}
+	d.Set("features", features)
+
+	d.SetId(featuresConfigurationID)
+}
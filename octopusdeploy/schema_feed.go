@@ -47,7 +47,8 @@ func getFeedDataSchema() map[string]*schema.Schema {
 		"name":         getQueryName(),
 		"partial_name": getQueryPartialName(),
 		"skip":         getQuerySkip(),
-		"take":         getQueryTake(),
+		"space_id":     getQuerySpaceID(),
+		"take":         getQueryTakeAll(),
 	}
 }
 
@@ -12,7 +12,7 @@ import (
 
 func expandGoogleCloudPlatformAccount(d *schema.ResourceData) *accounts.GoogleCloudPlatformAccount {
 	name := d.Get("name").(string)
-	jsonKey := core.NewSensitiveValue(d.Get("json_key").(string))
+	jsonKey := core.NewSensitiveValue(getSensitiveString(d, "json_key"))
 
 	account, _ := accounts.NewGoogleCloudPlatformAccount(name, jsonKey)
 	account.ID = d.Id()
@@ -45,7 +45,7 @@ func expandGoogleCloudPlatformAccount(d *schema.ResourceData) *accounts.GoogleCl
 }
 
 func getGoogleCloudPlatformAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	accountSchema := map[string]*schema.Schema{
 		"description": {
 			Description: "A user-friendly description of this GCP account.",
 			Optional:    true,
@@ -69,6 +69,10 @@ func getGoogleCloudPlatformAccountSchema() map[string]*schema.Schema {
 		"tenants":                           getTenantsSchema(),
 		"tenant_tags":                       getTenantTagsSchema(),
 	}
+
+	addSecretVersions(accountSchema, "json_key")
+
+	return accountSchema
 }
 
 func setGoogleCloudPlatformAccount(ctx context.Context, d *schema.ResourceData, account *accounts.GoogleCloudPlatformAccount) error {
@@ -89,5 +93,7 @@ func setGoogleCloudPlatformAccount(ctx context.Context, d *schema.ResourceData,
 		return fmt.Errorf("error setting tenant_tags: %s", err)
 	}
 
+	clearVersionedSecrets(d, "json_key")
+
 	return nil
 }
@@ -10,7 +10,7 @@ import (
 )
 
 func expandGitCredential(d *schema.ResourceData) *credentials.Resource {
-	password := core.NewSensitiveValue(d.Get("password").(string))
+	password := core.NewSensitiveValue(getSensitiveString(d, "password"))
 	name := d.Get("name").(string)
 	username := d.Get("username").(string)
 
@@ -46,6 +46,7 @@ func flattenGitCredential(credential *credentials.Resource) map[string]interface
 func getGitCredentialDataSchema() map[string]*schema.Schema {
 	dataSchema := getGitCredentialSchema()
 	setDataSchema(&dataSchema)
+	delete(dataSchema, "password_version")
 
 	return map[string]*schema.Schema{
 		"git_credentials": {
@@ -55,14 +56,15 @@ func getGitCredentialDataSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Type:        schema.TypeList,
 		},
-		"name": getQueryName(),
-		"skip": getQuerySkip(),
-		"take": getQueryTake(),
+		"name":     getQueryName(),
+		"skip":     getQuerySkip(),
+		"space_id": getQuerySpaceID(),
+		"take":     getQueryTakeAll(),
 	}
 }
 
 func getGitCredentialSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	gitCredentialSchema := map[string]*schema.Schema{
 		"id":       getIDSchema(),
 		"space_id": getSpaceIDSchema(),
 		"name": {
@@ -95,6 +97,10 @@ func getGitCredentialSchema() map[string]*schema.Schema {
 			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
 		},
 	}
+
+	addSecretVersions(gitCredentialSchema, "password")
+
+	return gitCredentialSchema
 }
 
 func setGitCredential(ctx context.Context, d *schema.ResourceData, resource *credentials.Resource) error {
@@ -105,5 +111,7 @@ func setGitCredential(ctx context.Context, d *schema.ResourceData, resource *cre
 	usernamePassword := resource.Details.(*credentials.UsernamePassword)
 	d.Set("username", usernamePassword.Username)
 
+	clearVersionedSecrets(d, "password")
+
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/credentials"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	prj "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/projects"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -63,6 +64,11 @@ func expandUsernamePasswordGitCredential(ctx context.Context, flattenedMap map[s
 	)
 }
 
+func expandGitHubGitCredential(ctx context.Context, flattenedMap map[string]interface{}) credentials.GitCredential {
+	tflog.Info(ctx, "expanding GitHub credential")
+	return prj.NewGitHubGitCredential(flattenedMap["github_connection_id"].(string))
+}
+
 func expandAnonymousGitCredential(ctx context.Context, flattenedMap map[string]interface{}) credentials.GitCredential {
 	tflog.Info(ctx, "expanding Anonymous credential")
 	return credentials.NewAnonymous()
@@ -81,7 +87,7 @@ func flattenGitPersistenceSettings(ctx context.Context, persistenceSettings proj
 	flattenedGitPersistenceSettings["protected_branches"] = gitPersistenceSettings.ProtectedBranchNamePatterns()
 
 	credential := gitPersistenceSettings.Credential()
-	switch credential.Type() {
+	switch getGitCredentialType(credential) {
 	case credentials.GitCredentialTypeReference:
 		tflog.Info(ctx, "flatten reference credential")
 		flattenedGitPersistenceSettings["git_credential_id"] = credential.(*credentials.Reference).ID
@@ -89,6 +95,9 @@ func flattenGitPersistenceSettings(ctx context.Context, persistenceSettings proj
 		tflog.Info(ctx, "flatten U/P credential")
 		flattenedGitPersistenceSettings["username"] = credential.(*credentials.UsernamePassword).Username
 		flattenedGitPersistenceSettings["password"] = credential.(*credentials.UsernamePassword).Password.NewValue
+	case prj.GitCredentialTypeGitHub:
+		tflog.Info(ctx, "flatten GitHub credential")
+		flattenedGitPersistenceSettings["github_connection_id"] = credential.(*prj.GitHubGitCredential).ID
 	}
 
 	if gitPersistenceSettings.URL() != nil {
@@ -111,7 +120,7 @@ func setGitPersistenceSettings(ctx context.Context, persistenceSettings projects
 	flattenedGitPersistenceSettings["protected_branches"] = gitPersistenceSettings.ProtectedBranchNamePatterns()
 
 	credential := gitPersistenceSettings.Credential()
-	switch credential.Type() {
+	switch getGitCredentialType(credential) {
 	case credentials.GitCredentialTypeReference:
 		tflog.Info(ctx, "flatten reference credential")
 		flattenedGitPersistenceSettings["git_credential_id"] = credential.(*credentials.Reference).ID
@@ -119,6 +128,9 @@ func setGitPersistenceSettings(ctx context.Context, persistenceSettings projects
 		tflog.Info(ctx, "flatten U/P credential")
 		flattenedGitPersistenceSettings["username"] = credential.(*credentials.UsernamePassword).Username
 		flattenedGitPersistenceSettings["password"] = credential.(*credentials.UsernamePassword).Password.NewValue
+	case prj.GitCredentialTypeGitHub:
+		tflog.Info(ctx, "flatten GitHub credential")
+		flattenedGitPersistenceSettings["github_connection_id"] = credential.(*prj.GitHubGitCredential).ID
 	}
 
 	if gitPersistenceSettings.URL() != nil {
@@ -127,3 +139,13 @@ func setGitPersistenceSettings(ctx context.Context, persistenceSettings projects
 
 	return []interface{}{flattenedGitPersistenceSettings}
 }
+
+// getGitCredentialType returns the type of a Git credential. The credential of a version-controlled project is nil when
+// go-octopusdeploy does not model its type and it has not been loaded separately.
+func getGitCredentialType(credential credentials.GitCredential) credentials.Type {
+	if credential == nil {
+		return ""
+	}
+
+	return credential.Type()
+}
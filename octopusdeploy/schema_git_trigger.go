@@ -0,0 +1,127 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	trg "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/triggers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// gitFilterType is the filter type of triggers that fire when commits are pushed to a Git repository
+const gitFilterType = "GitFilter"
+
+func expandGitTrigger(d *schema.ResourceData, project *projects.Project) *trg.ReleaseCreationTrigger {
+	trigger := trg.NewReleaseCreationTrigger(d.Get("name").(string), project.GetID(), project.SpaceID, d.Get("channel_id").(string), gitFilterType)
+	trigger.Description = d.Get("description").(string)
+	trigger.ID = d.Id()
+	trigger.IsDisabled = d.Get("is_disabled").(bool)
+
+	for _, v := range d.Get("source").([]interface{}) {
+		sourceMap := v.(map[string]interface{})
+		trigger.Filter.Sources = append(trigger.Filter.Sources, trg.GitTriggerSource{
+			DeploymentActionSlug: sourceMap["deployment_action_slug"].(string),
+			ExcludeFilePaths:     getSliceFromTerraformTypeList(sourceMap["exclude_file_paths"]),
+			GitDependencyName:    sourceMap["git_dependency_name"].(string),
+			IncludeFilePaths:     getSliceFromTerraformTypeList(sourceMap["include_file_paths"]),
+		})
+	}
+
+	return trigger
+}
+
+func getGitTriggerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"channel_id": {
+			Description:      "The ID of the channel in which releases are created.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"description": getDescriptionSchema("Git trigger"),
+		"id":          getIDSchema(),
+		"is_disabled": {
+			Default:     false,
+			Description: "Whether the trigger is disabled.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"name": getNameSchema(true),
+		"project_id": {
+			Description:      "The ID of the version-controlled project to attach the trigger.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"source": {
+			Description: "A Git repository referenced by a deployment action that is monitored for commits.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"deployment_action_slug": {
+						Description:      "The slug of the deployment action that references the Git repository.",
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+					},
+					"exclude_file_paths": {
+						Description: "Glob patterns of paths within the repository whose changes do not fire the trigger.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Optional:    true,
+						Type:        schema.TypeList,
+					},
+					"git_dependency_name": {
+						Description: "The name of the Git dependency of the deployment action. Empty for the primary Git dependency.",
+						Optional:    true,
+						Type:        schema.TypeString,
+					},
+					"include_file_paths": {
+						Description: "Glob patterns of paths within the repository whose changes fire the trigger. Changes to any path fire the trigger when omitted.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Optional:    true,
+						Type:        schema.TypeList,
+					},
+				},
+			},
+			MinItems: 1,
+			Required: true,
+			Type:     schema.TypeList,
+		},
+		"space_id": {
+			Computed:    true,
+			Description: "The space ID associated with this trigger.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func setGitTrigger(d *schema.ResourceData, trigger *trg.ReleaseCreationTrigger) error {
+	if trigger.Filter.FilterType != gitFilterType {
+		return fmt.Errorf("the trigger %s is not a Git trigger (%s)", trigger.GetID(), trigger.Filter.FilterType)
+	}
+
+	d.Set("channel_id", trigger.Action.ChannelID)
+	d.Set("description", trigger.Description)
+	d.Set("is_disabled", trigger.IsDisabled)
+	d.Set("name", trigger.Name)
+	d.Set("project_id", trigger.ProjectID)
+	d.Set("space_id", trigger.SpaceID)
+
+	sources := []interface{}{}
+	for _, source := range trigger.Filter.Sources {
+		sources = append(sources, map[string]interface{}{
+			"deployment_action_slug": source.DeploymentActionSlug,
+			"exclude_file_paths":     source.ExcludeFilePaths,
+			"git_dependency_name":    source.GitDependencyName,
+			"include_file_paths":     source.IncludeFilePaths,
+		})
+	}
+
+	if err := d.Set("source", sources); err != nil {
+		return fmt.Errorf("error setting source: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	trg "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/triggers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitTrigger(t *testing.T) {
+	project := projects.NewProject("Project", "Lifecycles-1", "ProjectGroups-1")
+	project.ID = "Projects-1"
+	project.SpaceID = "Spaces-1"
+
+	d := schema.TestResourceDataRaw(t, getGitTriggerSchema(), map[string]interface{}{
+		"channel_id": "Channels-1",
+		"name":       "Manifests changed",
+		"project_id": "Projects-1",
+		"source": []interface{}{map[string]interface{}{
+			"deployment_action_slug": "deploy-manifests",
+			"exclude_file_paths":     []interface{}{"manifests/**/*.md"},
+			"include_file_paths":     []interface{}{"manifests/**"},
+		}},
+	})
+
+	trigger := expandGitTrigger(d, project)
+
+	b, err := json.Marshal(trigger)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"Action": {"ActionType": "CreateRelease", "ChannelId": "Channels-1"},
+		"Filter": {
+			"FilterType": "GitFilter",
+			"Sources": [{
+				"DeploymentActionSlug": "deploy-manifests",
+				"ExcludeFilePaths": ["manifests/**/*.md"],
+				"GitDependencyName": "",
+				"IncludeFilePaths": ["manifests/**"]
+			}]
+		},
+		"IsDisabled": false,
+		"Name": "Manifests changed",
+		"ProjectId": "Projects-1",
+		"SpaceId": "Spaces-1"
+	}`, string(b))
+
+	var readTrigger trg.ReleaseCreationTrigger
+	require.NoError(t, json.Unmarshal(b, &readTrigger))
+
+	state := schema.TestResourceDataRaw(t, getGitTriggerSchema(), map[string]interface{}{})
+	require.NoError(t, setGitTrigger(state, &readTrigger))
+	require.Equal(t, "Channels-1", state.Get("channel_id"))
+	require.Equal(t, "deploy-manifests", state.Get("source.0.deployment_action_slug"))
+	require.Equal(t, []interface{}{"manifests/**/*.md"}, state.Get("source.0.exclude_file_paths"))
+	require.Equal(t, []interface{}{"manifests/**"}, state.Get("source.0.include_file_paths"))
+	require.Equal(t, "", state.Get("source.0.git_dependency_name"))
+
+	readTrigger.Filter.FilterType = feedFilterType
+	require.Error(t, setGitTrigger(state, &readTrigger))
+}
@@ -36,8 +36,8 @@ func expandGitHubRepositoryFeed(d *schema.ResourceData) (*feeds.GitHubRepository
 		feed.PackageAcquisitionLocationOptions = getSliceFromTerraformTypeList(v)
 	}
 
-	if v, ok := d.GetOk("password"); ok {
-		feed.Password = core.NewSensitiveValue(v.(string))
+	if v := getSensitiveString(d, "password"); len(v) > 0 {
+		feed.Password = core.NewSensitiveValue(v)
 	}
 
 	if v, ok := d.GetOk("space_id"); ok {
@@ -52,7 +52,7 @@ func expandGitHubRepositoryFeed(d *schema.ResourceData) (*feeds.GitHubRepository
 }
 
 func getGitHubRepositoryFeedSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	feedSchema := map[string]*schema.Schema{
 		"download_attempts": {
 			Default:     5,
 			Description: "The number of times a deployment should attempt to download a package from this feed before failing.",
@@ -86,6 +86,10 @@ func getGitHubRepositoryFeedSchema() map[string]*schema.Schema {
 		"space_id": getSpaceIDSchema(),
 		"username": getUsernameSchema(false),
 	}
+
+	addSecretVersions(feedSchema, "password")
+
+	return feedSchema
 }
 
 func setGitHubRepositoryFeed(ctx context.Context, d *schema.ResourceData, feed *feeds.GitHubRepositoryFeed) error {
@@ -102,5 +106,7 @@ func setGitHubRepositoryFeed(ctx context.Context, d *schema.ResourceData, feed *
 
 	d.SetId(feed.GetID())
 
+	clearVersionedSecrets(d, "password")
+
 	return nil
 }
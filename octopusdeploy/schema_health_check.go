@@ -0,0 +1,79 @@
+package octopusdeploy
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var healthCheckTargets = []string{"environment_id", "machine_ids", "machine_policy_id"}
+
+func getHealthCheckSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"environment_id": {
+			Description:      "The ID of the environment whose deployment targets are checked.",
+			ExactlyOneOf:     healthCheckTargets,
+			ForceNew:         true,
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"id": getIDSchema(),
+		"machine_ids": {
+			Description:  "The IDs of the deployment targets to check.",
+			Elem:         &schema.Schema{Type: schema.TypeString},
+			ExactlyOneOf: healthCheckTargets,
+			ForceNew:     true,
+			MinItems:     1,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+		"machine_policy_id": {
+			Description:      "The ID of the machine policy whose deployment targets are checked.",
+			ExactlyOneOf:     healthCheckTargets,
+			ForceNew:         true,
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"space_id": {
+			Computed:    true,
+			Description: "The space ID associated with this health check.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"task_error_message": {
+			Computed:    true,
+			Description: "The error message of the task of the health check, if it did not succeed.",
+			Type:        schema.TypeString,
+		},
+		"task_id": {
+			Computed:    true,
+			Description: "The ID of the task that runs the health check.",
+			Type:        schema.TypeString,
+		},
+		"task_state": {
+			Computed:    true,
+			Description: "The state of the task that runs the health check (e.g. `Success` or `Failed`).",
+			Type:        schema.TypeString,
+		},
+		"triggers": {
+			Description: "Arbitrary values that run the health check again when they change, such as the thumbprint of a rotated certificate.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeMap,
+		},
+	}
+}
+
+func setHealthCheckTask(d *schema.ResourceData, task *tasks.Task) {
+	d.Set("task_error_message", task.ErrorMessage)
+	d.Set("task_id", task.GetID())
+	d.Set("task_state", task.State)
+
+	if len(task.SpaceID) > 0 {
+		d.Set("space_id", task.SpaceID)
+	}
+}
@@ -28,8 +28,8 @@ func expandHelmFeed(d *schema.ResourceData) (*feeds.HelmFeed, error) {
 		helmFeed.PackageAcquisitionLocationOptions = getSliceFromTerraformTypeList(v)
 	}
 
-	if v, ok := d.GetOk("password"); ok {
-		helmFeed.Password = core.NewSensitiveValue(v.(string))
+	if v := getSensitiveString(d, "password"); len(v) > 0 {
+		helmFeed.Password = core.NewSensitiveValue(v)
 	}
 
 	if v, ok := d.GetOk("username"); ok {
@@ -40,7 +40,7 @@ func expandHelmFeed(d *schema.ResourceData) (*feeds.HelmFeed, error) {
 }
 
 func getHelmFeedSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	feedSchema := map[string]*schema.Schema{
 		"feed_uri": {
 			Required: true,
 			Type:     schema.TypeString,
@@ -62,6 +62,10 @@ func getHelmFeedSchema() map[string]*schema.Schema {
 		"space_id": getSpaceIDSchema(),
 		"username": getUsernameSchema(false),
 	}
+
+	addSecretVersions(feedSchema, "password")
+
+	return feedSchema
 }
 
 func setHelmFeed(ctx context.Context, d *schema.ResourceData, mavenFeed *feeds.HelmFeed) error {
@@ -76,5 +80,7 @@ func setHelmFeed(ctx context.Context, d *schema.ResourceData, mavenFeed *feeds.H
 
 	d.SetId(mavenFeed.GetID())
 
+	clearVersionedSecrets(d, "password")
+
 	return nil
 }
@@ -0,0 +1,125 @@
+package octopusdeploy
+
+import (
+	"time"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/insights"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandProjectInsightsQuery(d *schema.ResourceData) insights.ProjectInsightsQuery {
+	query := insights.ProjectInsightsQuery{
+		EnvironmentIDs: expandArray(d.Get("environment_ids").([]interface{})),
+		ProjectID:      d.Get("project_id").(string),
+	}
+
+	// the values are validated as RFC 3339 times by the schema
+	if v, ok := d.GetOk("from"); ok {
+		query.From, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	if v, ok := d.GetOk("to"); ok {
+		query.To, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	return query
+}
+
+func getInsightsDataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"deployment_count": {
+			Computed:    true,
+			Description: "The number of deployments in the window.",
+			Type:        schema.TypeInt,
+		},
+		"deployment_failure_rate": {
+			Computed:    true,
+			Description: "The fraction of deployments in the window that failed, between `0` and `1`.",
+			Type:        schema.TypeFloat,
+		},
+		"deployment_frequency": {
+			Computed:    true,
+			Description: "The average number of deployments per day in the window.",
+			Type:        schema.TypeFloat,
+		},
+		"environment_ids": {
+			Description: "The IDs of the environments to calculate the metrics for. Deployments to all environments are included when omitted.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"failed_deployment_count": {
+			Computed:    true,
+			Description: "The number of deployments in the window that failed.",
+			Type:        schema.TypeInt,
+		},
+		"from": {
+			Computed:         true,
+			Description:      "The start of the window, as an RFC 3339 time such as `2024-01-01T00:00:00Z`. Defaults to the start of the window chosen by Octopus.",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+		},
+		"id": getDataSchemaID(),
+		"lead_time_hours": {
+			Computed:    true,
+			Description: "The average time in hours from the creation of a release to its successful deployment.",
+			Type:        schema.TypeFloat,
+		},
+		"mean_time_to_recovery_hours": {
+			Computed:    true,
+			Description: "The average time in hours from a failed deployment to the next successful deployment.",
+			Type:        schema.TypeFloat,
+		},
+		"project_id": {
+			Description:      "The ID of the project to calculate the metrics for.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"space_id": getQuerySpaceID(),
+		"successful_deployment_count": {
+			Computed:    true,
+			Description: "The number of deployments in the window that succeeded.",
+			Type:        schema.TypeInt,
+		},
+		"to": {
+			Computed:         true,
+			Description:      "The end of the window, as an RFC 3339 time. Defaults to the end of the window chosen by Octopus.",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+		},
+	}
+}
+
+func setProjectInsights(d *schema.ResourceData, projectInsights *insights.ProjectInsights) error {
+	leadTime, err := insights.ParseTimeSpan(projectInsights.LeadTime)
+	if err != nil {
+		return err
+	}
+
+	meanTimeToRecovery, err := insights.ParseTimeSpan(projectInsights.MeanTimeToRecovery)
+	if err != nil {
+		return err
+	}
+
+	d.Set("deployment_count", projectInsights.DeploymentCount)
+	d.Set("deployment_failure_rate", projectInsights.DeploymentFailureRate)
+	d.Set("deployment_frequency", projectInsights.DeploymentFrequency)
+	d.Set("failed_deployment_count", projectInsights.FailedDeploymentCount)
+	d.Set("lead_time_hours", leadTime.Hours())
+	d.Set("mean_time_to_recovery_hours", meanTimeToRecovery.Hours())
+	d.Set("successful_deployment_count", projectInsights.SuccessfulDeploymentCount)
+
+	if len(projectInsights.From) > 0 {
+		d.Set("from", projectInsights.From)
+	}
+
+	if len(projectInsights.To) > 0 {
+		d.Set("to", projectInsights.To)
+	}
+
+	return nil
+}
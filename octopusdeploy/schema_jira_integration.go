@@ -0,0 +1,77 @@
+package octopusdeploy
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/configuration"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandJiraIntegration(d *schema.ResourceData) *configuration.JiraIntegration {
+	return &configuration.JiraIntegration{
+		BaseURL:   d.Get("base_url").(string),
+		IsEnabled: d.Get("is_enabled").(bool),
+		Password:  expandKeptSensitiveValue(d, "connect_app_password"),
+		ReleaseNoteOptions: configuration.JiraReleaseNoteOptions{
+			Password:          expandKeptSensitiveValue(d, "release_notes_password"),
+			ReleaseNotePrefix: d.Get("release_note_prefix").(string),
+			Username:          d.Get("release_notes_username").(string),
+		},
+	}
+}
+
+func getJiraIntegrationSchema() map[string]*schema.Schema {
+	jiraIntegrationSchema := map[string]*schema.Schema{
+		"base_url": {
+			Description:      "The URL of the Jira instance, e.g. `https://example.atlassian.net`.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+		},
+		"connect_app_password": {
+			Description: "The password generated by the Octopus Deploy for Jira app, which lets Octopus send deployment information to Jira. A password that is already held by Octopus is kept when omitted.",
+			Optional:    true,
+			Sensitive:   true,
+			Type:        schema.TypeString,
+		},
+		"id": getIDSchema(),
+		"is_enabled": {
+			Default:     true,
+			Description: "Whether the Jira integration is enabled.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"release_note_prefix": {
+			Description: "The prefix of the comments on Jira issues that are used as release notes. The summary of the issue is used when no comment has the prefix.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"release_notes_password": {
+			Description: "The password or API token of the Jira user that reads the issues linked to the commits of a build. A password that is already held by Octopus is kept when omitted.",
+			Optional:    true,
+			Sensitive:   true,
+			Type:        schema.TypeString,
+		},
+		"release_notes_username": {
+			Description: "The Jira user that reads the issues linked to the commits of a build.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+	}
+
+	addSecretVersions(jiraIntegrationSchema, "connect_app_password", "release_notes_password")
+
+	return jiraIntegrationSchema
+}
+
+// setJiraIntegration refreshes the state from the settings held by Octopus. The passwords are never returned by
+// Octopus, so they are left as configured unless their versions are set.
+func setJiraIntegration(d *schema.ResourceData, jiraIntegration *configuration.JiraIntegration) {
+	d.Set("base_url", jiraIntegration.BaseURL)
+	d.Set("is_enabled", jiraIntegration.IsEnabled)
+	d.Set("release_note_prefix", jiraIntegration.ReleaseNoteOptions.ReleaseNotePrefix)
+	d.Set("release_notes_username", jiraIntegration.ReleaseNoteOptions.Username)
+
+	clearVersionedSecrets(d, "connect_app_password", "release_notes_password")
+
+	d.SetId(configuration.JiraIntegrationID)
+}
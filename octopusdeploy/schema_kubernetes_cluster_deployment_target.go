@@ -118,7 +118,7 @@ func flattenKubernetesClusterDeploymentTarget(deploymentTarget *machines.Deploym
 
 func getKubernetesClusterDeploymentTargetDataSchema() map[string]*schema.Schema {
 	dataSchema := getKubernetesClusterDeploymentTargetSchema()
-	setDataSchema(&dataSchema)
+	setDeploymentTargetDataSchema(&dataSchema)
 
 	deploymentTargetDataSchema := getDeploymentTargetDataSchema()
 
@@ -62,7 +62,8 @@ func getLibraryVariableSetDataSchema() map[string]*schema.Schema {
 		},
 		"partial_name": getQueryPartialName(),
 		"skip":         getQuerySkip(),
-		"take":         getQueryTake(),
+		"space_id":     getQuerySpaceID(),
+		"take":         getQueryTakeAll(),
 	}
 }
 
@@ -0,0 +1,65 @@
+package octopusdeploy
+
+import (
+	"strings"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/licenses"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandLicense(d *schema.ResourceData) *licenses.License {
+	license := &licenses.License{
+		LicenseText: d.Get("license_text").(string),
+	}
+	license.ID = licenses.CurrentLicenseID
+
+	return license
+}
+
+func getLicenseSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"compliance_summary": {
+			Computed:    true,
+			Description: "A summary of how the usage of the Octopus instance compares with the limits of the license.",
+			Type:        schema.TypeString,
+		},
+		"effective_expiry_date": {
+			Computed:    true,
+			Description: "The date on which the license expires.",
+			Type:        schema.TypeString,
+		},
+		"id": getIDSchema(),
+		"is_compliant": {
+			Computed:    true,
+			Description: "Whether the usage of the Octopus instance is within the limits of the license.",
+			Type:        schema.TypeBool,
+		},
+		"license_text": {
+			Description:      "The license, as the XML document issued by Octopus Deploy.",
+			DiffSuppressFunc: suppressLicenseTextDiff,
+			Required:         true,
+			Sensitive:        true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+	}
+}
+
+func setLicense(d *schema.ResourceData, license *licenses.License, licenseStatus *licenses.LicenseStatus) {
+	d.Set("license_text", license.LicenseText)
+
+	if licenseStatus != nil {
+		d.Set("compliance_summary", licenseStatus.ComplianceSummary)
+		d.Set("effective_expiry_date", licenseStatus.EffectiveExpiryDate)
+		d.Set("is_compliant", licenseStatus.IsCompliant)
+	}
+
+	d.SetId(licenses.CurrentLicenseID)
+}
+
+// suppressLicenseTextDiff ignores the leading and trailing whitespace of licenses, which is commonly added by heredocs
+// and files but is not kept by Octopus.
+func suppressLicenseTextDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
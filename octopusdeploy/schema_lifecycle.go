@@ -25,6 +25,9 @@ func expandLifecycle(d *schema.ResourceData) *lifecycles.Lifecycle {
 
 	if v, ok := d.GetOk("phase"); ok {
 		lifecycle.Phases = expandPhases(v)
+
+		existingPhases, _ := d.GetChange("phase")
+		matchPhaseIDs(lifecycle.Phases, expandPhases(existingPhases))
 	}
 
 	if v, ok := d.GetOk("release_retention_policy"); ok {
@@ -73,7 +76,8 @@ func getLifecycleDataSchema() map[string]*schema.Schema {
 		},
 		"partial_name": getQueryPartialName(),
 		"skip":         getQuerySkip(),
-		"take":         getQueryTake(),
+		"space_id":     getQuerySpaceID(),
+		"take":         getQueryTakeAll(),
 	}
 }
 
@@ -1,9 +1,12 @@
 package octopusdeploy
 
 import (
-	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"context"
 	"testing"
 
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/lifecycles"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/require"
@@ -44,3 +47,21 @@ func TestExpandLifecycle(t *testing.T) {
 	require.Equal(t, lifecycle.TentacleRetentionPolicy, tentacleRetention)
 	require.Equal(t, lifecycle.SpaceID, spaceID)
 }
+
+func TestSetLifecycleWithMultiplePhases(t *testing.T) {
+	lifecycle := lifecycles.NewLifecycle(acctest.RandStringFromCharSet(20, acctest.CharSetAlpha))
+	lifecycle.ID = acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	for _, name := range []string{"Development", "Test", "Production"} {
+		phase := lifecycles.NewPhase(name)
+		phase.ID = acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+		phase.AutomaticDeploymentTargets = []string{}
+		phase.OptionalDeploymentTargets = []string{}
+		lifecycle.Phases = append(lifecycle.Phases, phase)
+	}
+
+	d := schema.TestResourceDataRaw(t, getLifecycleSchema(), map[string]interface{}{})
+	require.NoError(t, setLifecycle(context.Background(), d, lifecycle))
+
+	require.Len(t, d.Get("phase").([]interface{}), 3)
+	require.Equal(t, lifecycle.Phases, expandLifecycle(d).Phases)
+}
@@ -49,7 +49,7 @@ func flattenListeningTentacleDeploymentTarget(deploymentTarget *machines.Deploym
 
 func getListeningTentacleDeploymentTargetDataSchema() map[string]*schema.Schema {
 	dataSchema := getListeningTentacleDeploymentTargetSchema()
-	setDataSchema(&dataSchema)
+	setDeploymentTargetDataSchema(&dataSchema)
 
 	deploymentTargetDataSchema := getDeploymentTargetDataSchema()
 
@@ -80,7 +80,7 @@ func getListeningTentacleDeploymentTargetSchema() map[string]*schema.Schema {
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Required:    true,
 			MinItems:    1,
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 		},
 		"has_latest_calamari": {
 			Computed: true,
@@ -124,7 +124,7 @@ func getListeningTentacleDeploymentTargetSchema() map[string]*schema.Schema {
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			MinItems:    1,
 			Required:    true,
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 		},
 		"shell_name": {
 			Computed:    true,
@@ -141,9 +141,9 @@ func getListeningTentacleDeploymentTargetSchema() map[string]*schema.Schema {
 		"space_id":                          getSpaceIDSchema(),
 		"status":                            getStatusSchema(),
 		"status_summary":                    getStatusSummarySchema(),
-		"tenanted_deployment_participation": getTenantedDeploymentSchema(),
-		"tenants":                           getTenantsSchema(),
-		"tenant_tags":                       getTenantTagsSchema(),
+		"tenanted_deployment_participation": getDeploymentTargetTenantedDeploymentSchema(),
+		"tenants":                           getDeploymentTargetTenantsSchema(),
+		"tenant_tags":                       getDeploymentTargetTenantTagsSchema(),
 		"tentacle_version_details": {
 			Computed: true,
 			Elem:     &schema.Resource{Schema: getTentacleVersionDetailsSchema()},
@@ -169,6 +169,7 @@ func getListeningTentacleDeploymentTargetSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			// ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
 		},
+		"wait_for_healthy": getWaitForHealthySchema(),
 	}
 }
 
@@ -122,7 +122,8 @@ func getMachinePolicyDataSchema() map[string]*schema.Schema {
 		},
 		"partial_name": getQueryPartialName(),
 		"skip":         getQuerySkip(),
-		"take":         getQueryTake(),
+		"space_id":     getQuerySpaceID(),
+		"take":         getQueryTakeAll(),
 	}
 }
 
@@ -0,0 +1,39 @@
+package octopusdeploy
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// filterMachineRoles returns the sorted roles whose names contain the given partial name, without regard to case.
+func filterMachineRoles(machineRoles []*string, partialName string) []string {
+	filteredMachineRoles := []string{}
+	for _, machineRole := range machineRoles {
+		if machineRole == nil {
+			continue
+		}
+
+		if strings.Contains(strings.ToLower(*machineRole), strings.ToLower(partialName)) {
+			filteredMachineRoles = append(filteredMachineRoles, *machineRole)
+		}
+	}
+
+	sort.Strings(filteredMachineRoles)
+	return filteredMachineRoles
+}
+
+func getMachineRoleDataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id":           getDataSchemaID(),
+		"partial_name": getQueryPartialName(),
+		"roles": {
+			Computed:    true,
+			Description: "The roles of the deployment targets of the space that match the filter, sorted by name.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"space_id": getQuerySpaceID(),
+	}
+}
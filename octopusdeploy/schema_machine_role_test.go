@@ -0,0 +1,20 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterMachineRoles(t *testing.T) {
+	machineRoles := []*string{}
+	for _, machineRole := range []string{"web-server", "db-server", "Web-Worker"} {
+		machineRole := machineRole
+		machineRoles = append(machineRoles, &machineRole)
+	}
+	machineRoles = append(machineRoles, nil)
+
+	require.Equal(t, []string{"Web-Worker", "db-server", "web-server"}, filterMachineRoles(machineRoles, ""))
+	require.Equal(t, []string{"Web-Worker", "web-server"}, filterMachineRoles(machineRoles, "WEB"))
+	require.Empty(t, filterMachineRoles(machineRoles, "cache"))
+}
@@ -36,8 +36,8 @@ func expandMavenFeed(d *schema.ResourceData) (*feeds.MavenFeed, error) {
 		feed.PackageAcquisitionLocationOptions = getSliceFromTerraformTypeList(v)
 	}
 
-	if v, ok := d.GetOk("password"); ok {
-		feed.Password = core.NewSensitiveValue(v.(string))
+	if v := getSensitiveString(d, "password"); len(v) > 0 {
+		feed.Password = core.NewSensitiveValue(v)
 	}
 
 	if v, ok := d.GetOk("space_id"); ok {
@@ -52,7 +52,7 @@ func expandMavenFeed(d *schema.ResourceData) (*feeds.MavenFeed, error) {
 }
 
 func getMavenFeedSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	feedSchema := map[string]*schema.Schema{
 		"download_attempts": {
 			Default:     5,
 			Description: "The number of times a deployment should attempt to download a package from this feed before failing.",
@@ -86,6 +86,10 @@ func getMavenFeedSchema() map[string]*schema.Schema {
 		"space_id": getSpaceIDSchema(),
 		"username": getUsernameSchema(false),
 	}
+
+	addSecretVersions(feedSchema, "password")
+
+	return feedSchema
 }
 
 func setMavenFeed(ctx context.Context, d *schema.ResourceData, feed *feeds.MavenFeed) error {
@@ -102,5 +106,7 @@ func setMavenFeed(ctx context.Context, d *schema.ResourceData, feed *feeds.Maven
 
 	d.SetId(feed.GetID())
 
+	clearVersionedSecrets(d, "password")
+
 	return nil
 }
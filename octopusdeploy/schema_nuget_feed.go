@@ -37,8 +37,8 @@ func expandNuGetFeed(d *schema.ResourceData) (*feeds.NuGetFeed, error) {
 		feed.PackageAcquisitionLocationOptions = getSliceFromTerraformTypeList(v)
 	}
 
-	if v, ok := d.GetOk("password"); ok {
-		feed.Password = core.NewSensitiveValue(v.(string))
+	if v := getSensitiveString(d, "password"); len(v) > 0 {
+		feed.Password = core.NewSensitiveValue(v)
 	}
 
 	if v, ok := d.GetOk("space_id"); ok {
@@ -53,7 +53,7 @@ func expandNuGetFeed(d *schema.ResourceData) (*feeds.NuGetFeed, error) {
 }
 
 func getNuGetFeedSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	feedSchema := map[string]*schema.Schema{
 		"download_attempts": {
 			Default:     5,
 			Description: "The number of times a deployment should attempt to download a package from this feed before failing.",
@@ -94,6 +94,10 @@ func getNuGetFeedSchema() map[string]*schema.Schema {
 		"space_id": getSpaceIDSchema(),
 		"username": getUsernameSchema(false),
 	}
+
+	addSecretVersions(feedSchema, "password")
+
+	return feedSchema
 }
 
 func setNuGetFeed(ctx context.Context, d *schema.ResourceData, feed *feeds.NuGetFeed) error {
@@ -111,5 +115,7 @@ func setNuGetFeed(ctx context.Context, d *schema.ResourceData, feed *feeds.NuGet
 
 	d.SetId(feed.GetID())
 
+	clearVersionedSecrets(d, "password")
+
 	return nil
 }
@@ -43,7 +43,7 @@ func flattenOfflinePackageDropDeploymentTarget(deploymentTarget *machines.Deploy
 
 func getOfflinePackageDropDeploymentTargetDataSchema() map[string]*schema.Schema {
 	dataSchema := getOfflinePackageDropDeploymentTargetSchema()
-	setDataSchema(&dataSchema)
+	setDeploymentTargetDataSchema(&dataSchema)
 
 	deploymentTargetDataSchema := getDeploymentTargetDataSchema()
 
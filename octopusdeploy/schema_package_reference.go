@@ -5,6 +5,7 @@ import (
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
@@ -29,10 +30,13 @@ func addPackagesSchema(element *schema.Resource, primaryIsRequired bool) {
 
 	packageElementSchema := element.Schema["package"].Elem.(*schema.Resource).Schema
 
+	element.Schema["package"].Description = "The additional packages referenced by this action. Each reference is exposed to scripts by its name."
+
 	packageElementSchema["name"] = &schema.Schema{
-		Description: "The name of the package",
-		Required:    true,
-		Type:        schema.TypeString,
+		Description:      "The name of the package reference, used to refer to the package from scripts and variables",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
 	}
 
 	packageElementSchema["extract_during_deployment"] = &schema.Schema{
@@ -44,20 +48,30 @@ func addPackagesSchema(element *schema.Resource, primaryIsRequired bool) {
 }
 
 func flattenPackageReference(packageReference *packages.PackageReference) map[string]interface{} {
+	properties := map[string]string{}
+	for k, v := range packageReference.Properties {
+		properties[k] = v
+	}
+
 	flattenedPackageReference := map[string]interface{}{
 		"acquisition_location": packageReference.AcquisitionLocation,
 		"feed_id":              packageReference.FeedID,
 		"id":                   packageReference.ID,
 		"name":                 packageReference.Name,
 		"package_id":           packageReference.PackageID,
-		"properties":           packageReference.Properties,
+		"properties":           properties,
 	}
 
 	// primary packages have no name and always extract during deployment; therefore, this
 	// condition (below) only applies to non-primary packages
 	if len(packageReference.Name) > 0 {
-		if v, ok := packageReference.Properties["Extract"]; ok {
-			flattenedPackageReference["extract_during_deployment"] = v
+		if v, ok := properties["Extract"]; ok {
+			extractDuringDeployment, _ := strconv.ParseBool(v)
+			flattenedPackageReference["extract_during_deployment"] = extractDuringDeployment
+
+			// the extract flag is exposed as its own attribute; leaving it in the properties map as well would
+			// let the stale value override a change to extract_during_deployment on the next apply
+			delete(properties, "Extract")
 		}
 	}
 
@@ -91,7 +105,7 @@ func getPackageSchema(required bool) *schema.Schema {
 				},
 				"properties": {
 					Computed:    true,
-					Description: "A list of properties associated with this package.",
+					Description: "Additional properties of this package reference, such as 'SelectionMode' or 'PackageParameterName'.",
 					Elem:        &schema.Schema{Type: schema.TypeString},
 					Optional:    true,
 					Type:        schema.TypeMap,
@@ -113,10 +127,6 @@ func expandPackageReference(tfPkg map[string]interface{}) *packages.PackageRefer
 		Properties:          map[string]string{},
 	}
 
-	if v, ok := tfPkg["extract_during_deployment"]; ok {
-		pkg.Properties["Extract"] = cases.Title(language.Und, cases.NoLower).String(strconv.FormatBool(v.(bool)))
-	}
-
 	if properties := tfPkg["properties"]; properties != nil {
 		propertyMap := properties.(map[string]interface{})
 		for k, v := range propertyMap {
@@ -124,5 +134,9 @@ func expandPackageReference(tfPkg map[string]interface{}) *packages.PackageRefer
 		}
 	}
 
+	if v, ok := tfPkg["extract_during_deployment"]; ok {
+		pkg.Properties["Extract"] = cases.Title(language.Und, cases.NoLower).String(strconv.FormatBool(v.(bool)))
+	}
+
 	return pkg
 }
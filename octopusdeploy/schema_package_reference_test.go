@@ -0,0 +1,52 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandPackageReference(t *testing.T) {
+	packageReference := expandPackageReference(map[string]interface{}{
+		"acquisition_location":      "ExecutionTarget",
+		"extract_during_deployment": false,
+		"feed_id":                   "feeds-123",
+		"name":                      "tools",
+		"package_id":                "MyTools",
+		"properties": map[string]interface{}{
+			"Extract":       "True",
+			"SelectionMode": "immediate",
+		},
+	})
+
+	require.Equal(t, "ExecutionTarget", packageReference.AcquisitionLocation)
+	require.Equal(t, "feeds-123", packageReference.FeedID)
+	require.Equal(t, "tools", packageReference.Name)
+	require.Equal(t, "MyTools", packageReference.PackageID)
+	require.Equal(t, "False", packageReference.Properties["Extract"])
+	require.Equal(t, "immediate", packageReference.Properties["SelectionMode"])
+}
+
+func TestFlattenPackageReference(t *testing.T) {
+	packageReference := &packages.PackageReference{
+		AcquisitionLocation: "Server",
+		FeedID:              "feeds-builtin",
+		Name:                "tools",
+		PackageID:           "MyTools",
+		Properties: map[string]string{
+			"Extract":       "True",
+			"SelectionMode": "immediate",
+		},
+	}
+
+	flattenedPackageReference := flattenPackageReference(packageReference)
+	require.Equal(t, true, flattenedPackageReference["extract_during_deployment"])
+	require.Equal(t, map[string]string{"SelectionMode": "immediate"}, flattenedPackageReference["properties"])
+	require.Equal(t, "True", packageReference.Properties["Extract"])
+
+	packageReference.Name = ""
+	flattenedPackageReference = flattenPackageReference(packageReference)
+	require.NotContains(t, flattenedPackageReference, "extract_during_deployment")
+	require.Equal(t, "True", flattenedPackageReference["properties"].(map[string]string)["Extract"])
+}
@@ -22,6 +22,10 @@ func expandPhase(flattenedPhase interface{}) *lifecycles.Phase {
 	name := flattenedValues["name"].(string)
 	phase := lifecycles.NewPhase(name)
 
+	if v, ok := flattenedValues["id"]; ok {
+		phase.ID = v.(string)
+	}
+
 	if v, ok := flattenedValues["automatic_deployment_targets"]; ok {
 		phase.AutomaticDeploymentTargets = getSliceFromTerraformTypeList(v)
 	}
@@ -82,6 +86,28 @@ func expandPhases(flattenedPhases interface{}) []*lifecycles.Phase {
 	return phases
 }
 
+// matchPhaseIDs sets the IDs of phases that already exist in Octopus. Phases are matched by name rather than by their
+// position in the list, since the position of a phase changes when another phase is inserted before it or the phases
+// are reordered. Phases that do not exist yet are sent without an ID.
+func matchPhaseIDs(phases []*lifecycles.Phase, existingPhases []*lifecycles.Phase) {
+	if len(existingPhases) == 0 {
+		return
+	}
+
+	phaseIDs := map[string]string{}
+	for _, existingPhase := range existingPhases {
+		if existingPhase != nil {
+			phaseIDs[existingPhase.Name] = existingPhase.ID
+		}
+	}
+
+	for _, phase := range phases {
+		if phase != nil {
+			phase.ID = phaseIDs[phase.Name]
+		}
+	}
+}
+
 func flattenPhase(phase *lifecycles.Phase) interface{} {
 	if phase == nil {
 		return nil
@@ -118,7 +144,7 @@ func getPhaseSchema() map[string]*schema.Schema {
 			Description: "Environment IDs in this phase that a release is automatically deployed to when it is eligible for this phase",
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Optional:    true,
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 		},
 		"id": getIDSchema(),
 		"is_optional_phase": {
@@ -138,19 +164,21 @@ func getPhaseSchema() map[string]*schema.Schema {
 			Description: "Environment IDs in this phase that a release can be deployed to, but is not automatically deployed to",
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Optional:    true,
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 		},
 		"release_retention_policy": {
-			Elem:     &schema.Resource{Schema: getRetentionPeriodSchema()},
-			Optional: true,
-			MaxItems: 1,
-			Type:     schema.TypeList,
+			Description: "The retention policy for releases deployed to this phase. If not set, the release retention policy of the lifecycle applies.",
+			Elem:        &schema.Resource{Schema: getRetentionPeriodSchema()},
+			Optional:    true,
+			MaxItems:    1,
+			Type:        schema.TypeList,
 		},
 		"tentacle_retention_policy": {
-			Elem:     &schema.Resource{Schema: getRetentionPeriodSchema()},
-			Optional: true,
-			MaxItems: 1,
-			Type:     schema.TypeList,
+			Description: "The retention policy for the files that deployments in this phase leave on Tentacles. If not set, the tentacle retention policy of the lifecycle applies.",
+			Elem:        &schema.Resource{Schema: getRetentionPeriodSchema()},
+			Optional:    true,
+			MaxItems:    1,
+			Type:        schema.TypeList,
 		},
 	}
 }
@@ -1,11 +1,14 @@
 package octopusdeploy
 
 import (
+	"context"
 	"testing"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/lifecycles"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 )
 
@@ -116,3 +119,92 @@ func TestExpandPhasesWithSensibleDefaults(t *testing.T) {
 	require.NotNil(t, phases)
 	require.Len(t, phases, 2)
 }
+
+func TestExpandPhasesPreservesOrder(t *testing.T) {
+	names := []string{"Development", "Test", "Staging", "Production"}
+
+	actualPhases := []*lifecycles.Phase{}
+	for i, name := range names {
+		phase := lifecycles.NewPhase(name)
+		phase.ID = acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+		phase.AutomaticDeploymentTargets = []string{}
+		phase.IsOptionalPhase = i%2 == 0
+		phase.MinimumEnvironmentsBeforePromotion = int32(i)
+		phase.OptionalDeploymentTargets = []string{}
+		actualPhases = append(actualPhases, phase)
+	}
+
+	phases := expandPhases(flattenPhases(actualPhases))
+
+	require.Len(t, phases, len(names))
+	for i, phase := range phases {
+		require.Equal(t, actualPhases[i], phase)
+	}
+}
+
+func TestExpandPhaseWithRetentionPolicies(t *testing.T) {
+	phase := expandPhase(map[string]interface{}{
+		"name":                      "Production",
+		"release_retention_policy":  flattenRetentionPeriod(core.NewRetentionPeriod(0, "Days", true)),
+		"tentacle_retention_policy": []interface{}{},
+	})
+
+	require.NotNil(t, phase)
+	require.Equal(t, core.NewRetentionPeriod(0, "Days", true), phase.ReleaseRetentionPolicy)
+
+	// phases without a retention policy inherit the retention policy of the lifecycle
+	require.Nil(t, phase.TentacleRetentionPolicy)
+}
+
+func TestPhaseDeploymentTargetsIgnoreOrder(t *testing.T) {
+	resource := &schema.Resource{Schema: getPhaseSchema()}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"automatic_deployment_targets": []interface{}{"Environments-1", "Environments-2"},
+		"name":                         "Production",
+		"optional_deployment_targets":  []interface{}{"Environments-3", "Environments-4"},
+	})
+	d.SetId("Phases-1")
+
+	// Octopus may return the environments of a phase in a different order to the configuration
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"automatic_deployment_targets": []interface{}{"Environments-2", "Environments-1"},
+		"name":                         "Production",
+		"optional_deployment_targets":  []interface{}{"Environments-4", "Environments-3"},
+	})
+
+	diff, err := resource.Diff(context.Background(), d.State(), config, nil)
+	require.NoError(t, err)
+	require.True(t, diff == nil || diff.Empty())
+}
+
+func TestMatchPhaseIDs(t *testing.T) {
+	existingPhases := []*lifecycles.Phase{}
+	for _, name := range []string{"Development", "Test", "Production"} {
+		phase := lifecycles.NewPhase(name)
+		phase.ID = acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+		existingPhases = append(existingPhases, phase)
+	}
+
+	// a phase inserted before Production takes the ID of Production from its position in the list
+	phases := []*lifecycles.Phase{}
+	for i, name := range []string{"Development", "Test", "Staging", "Production"} {
+		phase := lifecycles.NewPhase(name)
+		if i < len(existingPhases) {
+			phase.ID = existingPhases[i].ID
+		}
+		phases = append(phases, phase)
+	}
+
+	matchPhaseIDs(phases, existingPhases)
+
+	require.Equal(t, existingPhases[0].ID, phases[0].ID)
+	require.Equal(t, existingPhases[1].ID, phases[1].ID)
+	require.Empty(t, phases[2].ID)
+	require.Equal(t, existingPhases[2].ID, phases[3].ID)
+
+	// the IDs of phases are kept when there are no existing phases, i.e. when a lifecycle is created
+	phases[2].ID = "Phases-1"
+	matchPhaseIDs(phases, nil)
+	require.Equal(t, "Phases-1", phases[2].ID)
+}
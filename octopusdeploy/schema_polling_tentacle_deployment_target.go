@@ -43,7 +43,7 @@ func flattenPollingTentacleDeploymentTarget(deploymentTarget *machines.Deploymen
 
 func getPollingTentacleDeploymentTargetDataSchema() map[string]*schema.Schema {
 	dataSchema := getPollingTentacleDeploymentTargetSchema()
-	setDataSchema(&dataSchema)
+	setDeploymentTargetDataSchema(&dataSchema)
 
 	deploymentTargetDataSchema := getDeploymentTargetDataSchema()
 
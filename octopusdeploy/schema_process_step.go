@@ -0,0 +1,77 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// expandProcessStep builds a deployment step from the resource data. The step attributes share their schema with the
+// step blocks of octopusdeploy_deployment_process, so the step is expanded the same way.
+func expandProcessStep(ctx context.Context, d *schema.ResourceData) *deployments.DeploymentStep {
+	flattenedStep := map[string]interface{}{}
+	for key := range getDeploymentStepElementSchema() {
+		flattenedStep[key] = d.Get(key)
+	}
+
+	step := expandDeploymentStep(ctx, flattenedStep)
+	step.ID = d.Id()
+	return step
+}
+
+// getDeploymentStepElementSchema returns the schema of a single step of a deployment process.
+func getDeploymentStepElementSchema() map[string]*schema.Schema {
+	return getDeploymentStepSchema().Elem.(*schema.Resource).Schema
+}
+
+func getProcessStepSchema() map[string]*schema.Schema {
+	processStepSchema := getDeploymentStepElementSchema()
+
+	processStepSchema["git_ref"] = &schema.Schema{
+		Description: "The branch or tag holding the deployment process of a version-controlled project. Defaults to the default branch of the project.",
+		ForceNew:    true,
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+	processStepSchema["project_id"] = &schema.Schema{
+		Description:      "The ID of the project whose deployment process contains this step.",
+		ForceNew:         true,
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+	}
+	processStepSchema["space_id"] = getSpaceIDInputSchema()
+
+	return processStepSchema
+}
+
+func setProcessStep(d *schema.ResourceData, step *deployments.DeploymentStep) error {
+	flattenedStep := flattenDeploymentSteps([]*deployments.DeploymentStep{step})[0]
+
+	// every attribute is set so that actions removed outside of Terraform are removed from state
+	for key := range getDeploymentStepElementSchema() {
+		if key == "id" {
+			continue
+		}
+
+		if err := d.Set(key, flattenedStep[key]); err != nil {
+			return fmt.Errorf("error setting %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
+
+// findDeploymentStep returns the index of the step with the given ID within the deployment process, or -1 if the
+// process contains no such step.
+func findDeploymentStep(deploymentProcess *deployments.DeploymentProcess, id string) int {
+	for i, step := range deploymentProcess.Steps {
+		if step.ID == id {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,50 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandProcessStep(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getProcessStepSchema(), map[string]interface{}{
+		"name":         "Run a Script",
+		"project_id":   "Projects-1",
+		"target_roles": []interface{}{"web"},
+		"run_script_action": []interface{}{
+			map[string]interface{}{
+				"name":        "Run a Script",
+				"script_body": "Write-Host 'Hello'",
+			},
+		},
+	})
+	d.SetId("Steps-1")
+
+	step := expandProcessStep(context.Background(), d)
+	require.Equal(t, "Steps-1", step.ID)
+	require.Equal(t, "Run a Script", step.Name)
+	require.Equal(t, "web", step.Properties["Octopus.Action.TargetRoles"].Value)
+	require.Len(t, step.Actions, 1)
+	require.Equal(t, "Octopus.Script", step.Actions[0].ActionType)
+
+	require.NoError(t, setProcessStep(d, step))
+	require.Equal(t, "Run a Script", d.Get("run_script_action.0.name"))
+
+	// actions removed outside of Terraform are removed from state
+	step.Actions = nil
+	require.NoError(t, setProcessStep(d, step))
+	require.Empty(t, d.Get("run_script_action"))
+}
+
+func TestFindDeploymentStep(t *testing.T) {
+	deploymentProcess := deployments.NewDeploymentProcess("Projects-1")
+	step := deployments.NewDeploymentStep("Test")
+	step.ID = "Steps-1"
+	deploymentProcess.Steps = append(deploymentProcess.Steps, step)
+
+	require.Equal(t, 0, findDeploymentStep(deploymentProcess, "Steps-1"))
+	require.Equal(t, -1, findDeploymentStep(deploymentProcess, "Steps-2"))
+}
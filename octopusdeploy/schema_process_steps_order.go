@@ -0,0 +1,80 @@
+package octopusdeploy
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func getProcessStepsOrderSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"git_ref": {
+			Description: "The branch or tag holding the deployment process of a version-controlled project. Defaults to the default branch of the project.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"id": getIDSchema(),
+		"project_id": {
+			Description:      "The ID of the project whose deployment process steps are ordered.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"space_id": getSpaceIDInputSchema(),
+		"steps": {
+			Description: "The IDs of the steps in the order in which they run. Steps of the process that are not listed run after the listed steps, in their existing order.",
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+			MinItems: 1,
+			Required: true,
+			Type:     schema.TypeList,
+		},
+	}
+}
+
+// orderDeploymentSteps moves the steps with the given IDs to the start of the deployment process in the given order.
+// Steps that are not listed keep their relative order after the listed steps, and IDs that do not match a step are
+// ignored.
+func orderDeploymentSteps(deploymentProcess *deployments.DeploymentProcess, stepIDs []string) {
+	stepsByID := map[string]*deployments.DeploymentStep{}
+	for _, step := range deploymentProcess.Steps {
+		stepsByID[step.ID] = step
+	}
+
+	orderedSteps := []*deployments.DeploymentStep{}
+	for _, stepID := range stepIDs {
+		if step, ok := stepsByID[stepID]; ok {
+			orderedSteps = append(orderedSteps, step)
+			delete(stepsByID, stepID)
+		}
+	}
+
+	for _, step := range deploymentProcess.Steps {
+		if _, ok := stepsByID[step.ID]; ok {
+			orderedSteps = append(orderedSteps, step)
+		}
+	}
+
+	deploymentProcess.Steps = orderedSteps
+}
+
+// flattenDeploymentStepsOrder returns the IDs of the listed steps in the order in which they currently run.
+func flattenDeploymentStepsOrder(deploymentProcess *deployments.DeploymentProcess, stepIDs []string) []string {
+	listedStepIDs := map[string]bool{}
+	for _, stepID := range stepIDs {
+		listedStepIDs[stepID] = true
+	}
+
+	orderedStepIDs := []string{}
+	for _, step := range deploymentProcess.Steps {
+		if listedStepIDs[step.ID] {
+			orderedStepIDs = append(orderedStepIDs, step.ID)
+		}
+	}
+
+	return orderedStepIDs
+}
@@ -0,0 +1,28 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderDeploymentSteps(t *testing.T) {
+	deploymentProcess := deployments.NewDeploymentProcess("Projects-1")
+	for _, id := range []string{"a", "b", "c", "d"} {
+		step := deployments.NewDeploymentStep(id)
+		step.ID = id
+		deploymentProcess.Steps = append(deploymentProcess.Steps, step)
+	}
+
+	orderDeploymentSteps(deploymentProcess, []string{"c", "a", "missing"})
+
+	stepIDs := []string{}
+	for _, step := range deploymentProcess.Steps {
+		stepIDs = append(stepIDs, step.ID)
+	}
+	require.Equal(t, []string{"c", "a", "b", "d"}, stepIDs)
+
+	require.Equal(t, []string{"c", "a"}, flattenDeploymentStepsOrder(deploymentProcess, []string{"a", "c"}))
+	require.Empty(t, flattenDeploymentStepsOrder(deploymentProcess, nil))
+}
@@ -11,10 +11,16 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/extensions"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
 	prj "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/projects"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// gitPersistencePasswordKey is the key of the password of the Git credential of a project that is stored in Git with a
+// username and password.
+const gitPersistencePasswordKey = "git_username_password_persistence_settings.0.password"
+
 func expandProject(ctx context.Context, d *schema.ResourceData) *projects.Project {
 	name := d.Get("name").(string)
 	lifecycleID := d.Get("lifecycle_id").(string)
@@ -66,10 +72,17 @@ func expandProject(ctx context.Context, d *schema.ResourceData) *projects.Projec
 	}
 	if v, ok := d.GetOk("git_username_password_persistence_settings"); ok {
 		project.PersistenceSettings = expandGitPersistenceSettings(ctx, v, expandUsernamePasswordGitCredential)
+		if project.PersistenceSettings != nil && isVersionedSecret(d, gitPersistencePasswordKey) {
+			credential := project.PersistenceSettings.(projects.GitPersistenceSettings).Credential().(*credentials.UsernamePassword)
+			credential.Password = core.NewSensitiveValue(getSensitiveString(d, gitPersistencePasswordKey))
+		}
 	}
 	if v, ok := d.GetOk("git_anonymous_persistence_settings"); ok {
 		project.PersistenceSettings = expandGitPersistenceSettings(ctx, v, expandAnonymousGitCredential)
 	}
+	if v, ok := d.GetOk("git_github_persistence_settings"); ok {
+		project.PersistenceSettings = expandGitPersistenceSettings(ctx, v, expandGitHubGitCredential)
+	}
 
 	if project.PersistenceSettings != nil {
 		tflog.Info(ctx, fmt.Sprintf("expanded persistence settings {%v}", project.PersistenceSettings))
@@ -92,11 +105,15 @@ func expandProject(ctx context.Context, d *schema.ResourceData) *projects.Projec
 	}
 
 	if v, ok := d.GetOk("jira_service_management_extension_settings"); ok {
-		project.ExtensionSettings = append(project.ExtensionSettings, prj.ExpandJiraServiceManagementExtensionSettings(v))
+		if jiraServiceManagementExtensionSettings := prj.ExpandJiraServiceManagementExtensionSettings(v); jiraServiceManagementExtensionSettings != nil {
+			project.ExtensionSettings = append(project.ExtensionSettings, jiraServiceManagementExtensionSettings)
+		}
 	}
 
 	if v, ok := d.GetOk("servicenow_extension_settings"); ok {
-		project.ExtensionSettings = append(project.ExtensionSettings, prj.ExpandServiceNowExtensionSettings(v))
+		if serviceNowExtensionSettings := prj.ExpandServiceNowExtensionSettings(v); serviceNowExtensionSettings != nil {
+			project.ExtensionSettings = append(project.ExtensionSettings, serviceNowExtensionSettings)
+		}
 	}
 
 	if v, ok := d.GetOk("release_creation_strategy"); ok {
@@ -180,7 +197,7 @@ func flattenProject(ctx context.Context, d *schema.ResourceData, project *projec
 
 	if project.PersistenceSettings != nil {
 		if project.PersistenceSettings.Type() == projects.PersistenceSettingsTypeVersionControlled {
-			gitCredentialType := project.PersistenceSettings.(projects.GitPersistenceSettings).Credential().Type()
+			gitCredentialType := getGitCredentialType(project.PersistenceSettings.(projects.GitPersistenceSettings).Credential())
 			switch gitCredentialType {
 			case credentials.GitCredentialTypeReference:
 				projectMap["git_library_persistence_settings"] = flattenGitPersistenceSettings(ctx, project.PersistenceSettings)
@@ -188,6 +205,8 @@ func flattenProject(ctx context.Context, d *schema.ResourceData, project *projec
 				projectMap["git_username_password_persistence_settings"] = flattenGitPersistenceSettings(ctx, project.PersistenceSettings)
 			case credentials.GitCredentialTypeAnonymous:
 				projectMap["git_anonymous_persistence_settings"] = flattenGitPersistenceSettings(ctx, project.PersistenceSettings)
+			case prj.GitCredentialTypeGitHub:
+				projectMap["git_github_persistence_settings"] = flattenGitPersistenceSettings(ctx, project.PersistenceSettings)
 			}
 		}
 	}
@@ -199,6 +218,9 @@ func getProjectDataSchema() map[string]*schema.Schema {
 	dataSchema := getProjectSchema()
 	setDataSchema(&dataSchema)
 
+	// the project to clone is only used when a project is created
+	delete(dataSchema, "clone_from_project_id")
+
 	return map[string]*schema.Schema{
 		"cloned_from_project_id": getQueryClonedFromProjectID(),
 		"id":                     getDataSchemaID(),
@@ -213,22 +235,46 @@ func getProjectDataSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Type:        schema.TypeList,
 		},
-		"skip": getQuerySkip(),
-		"take": getQueryTake(),
+		"skip":     getQuerySkip(),
+		"space_id": getQuerySpaceID(),
+		"take":     getQueryTakeAll(),
+	}
+}
+
+// warnIfNotSlug returns an error for a blank slug, and a warning for a slug that is not in the form of the slugs that
+// Octopus derives from names. Octopus accepts other slugs, so they are not rejected.
+func warnIfNotSlug() schema.SchemaValidateDiagFunc {
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		diags := validation.ToDiagFunc(validation.StringIsNotWhiteSpace)(v, path)
+		if diags.HasError() {
+			return diags
+		}
+
+		if slug := v.(string); !slugPattern.MatchString(slug) {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       fmt.Sprintf("the slug %q is not in the form of the slugs that Octopus derives from names", slug),
+				Detail:        "Slugs derived by Octopus contain lowercase letters, digits and single hyphens, such as my-project.",
+				AttributePath: path,
+			})
+		}
+
+		return diags
 	}
 }
 
 func getProjectSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	projectSchema := map[string]*schema.Schema{
 		"allow_deployments_to_no_targets": {
 			Deprecated: "This value is only valid for an associated connectivity policy and should not be specified here.",
 			Optional:   true,
 			Type:       schema.TypeBool,
 		},
 		"auto_create_release": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeBool,
+			Computed:    true,
+			Description: "Whether a release is created automatically when a new version of the package configured in `release_creation_strategy` is pushed to the built-in feed.",
+			Optional:    true,
+			Type:        schema.TypeBool,
 		},
 		"auto_deploy_release_overrides": {
 			Computed: true,
@@ -237,10 +283,18 @@ func getProjectSchema() map[string]*schema.Schema {
 			Optional: true,
 			Type:     schema.TypeList,
 		},
+		"clone_from_project_id": {
+			Description:      "The ID of a project to clone when this project is created, such as a template project. The clone starts with the deployment process, runbooks, channels and variables of that project, and the other arguments of this resource are then applied to it. Changing this argument replaces the project.",
+			ForceNew:         true,
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
 		"cloned_from_project_id": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the project that this project was cloned from.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"connectivity_policy": {
 			Computed: true,
@@ -285,9 +339,50 @@ func getProjectSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Type:        schema.TypeBool,
 		},
+		"git_github_persistence_settings": {
+			ConflictsWith: []string{"git_library_persistence_settings", "git_username_password_persistence_settings", "git_anonymous_persistence_settings"},
+			Description:   "Stores the project in a GitHub repository, authenticating with the Octopus GitHub App installed for a GitHub App connection instead of a personal access token. A database-backed project is converted to version control when this block is added; the conversion cannot be reversed.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"base_path": {
+						Default:     ".octopus",
+						Description: "The base path associated with these version control settings.",
+						Optional:    true,
+						Type:        schema.TypeString,
+					},
+					"default_branch": {
+						Default:     "main",
+						Description: "The default branch associated with these version control settings.",
+						Optional:    true,
+						Type:        schema.TypeString,
+					},
+					"github_connection_id": {
+						Description:      "The ID of the GitHub App connection used to access the repository. The repository must be one that the GitHub App installation of the connection has been granted access to.",
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+					},
+					"protected_branches": {
+						Description: "A list of protected branch patterns.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Optional:    true,
+						Type:        schema.TypeSet,
+					},
+					"url": {
+						Description:      "The URL of the GitHub repository, e.g. `https://github.com/example/repository.git`.",
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+					},
+				},
+			},
+			MaxItems: 1,
+			Optional: true,
+			Type:     schema.TypeList,
+		},
 		"git_library_persistence_settings": {
-			ConflictsWith: []string{"git_username_password_persistence_settings", "git_anonymous_persistence_settings"},
-			Description:   "Provides Git-related persistence settings for a version-controlled project.",
+			ConflictsWith: []string{"git_username_password_persistence_settings", "git_anonymous_persistence_settings", "git_github_persistence_settings"},
+			Description:   "Stores the project in Git, authenticating with a Git credential from the library. A database-backed project is converted to version control when this block is added; the conversion cannot be reversed.",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"base_path": {
@@ -303,6 +398,7 @@ func getProjectSchema() map[string]*schema.Schema {
 						Type:        schema.TypeString,
 					},
 					"git_credential_id": {
+						Description:      "The ID of the Git credential from the library used to access the repository.",
 						Required:         true,
 						Type:             schema.TypeString,
 						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
@@ -326,8 +422,8 @@ func getProjectSchema() map[string]*schema.Schema {
 			Type:     schema.TypeList,
 		},
 		"git_username_password_persistence_settings": {
-			ConflictsWith: []string{"git_library_persistence_settings", "git_anonymous_persistence_settings"},
-			Description:   "Provides Git-related persistence settings for a version-controlled project.",
+			ConflictsWith: []string{"git_library_persistence_settings", "git_anonymous_persistence_settings", "git_github_persistence_settings"},
+			Description:   "Stores the project in Git, authenticating with a username and password (or personal access token). A database-backed project is converted to version control when this block is added; the conversion cannot be reversed.",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"base_path": {
@@ -374,8 +470,8 @@ func getProjectSchema() map[string]*schema.Schema {
 			Type:     schema.TypeList,
 		},
 		"git_anonymous_persistence_settings": {
-			ConflictsWith: []string{"git_library_persistence_settings", "git_username_password_persistence_settings"},
-			Description:   "Provides Git-related persistence settings for a version-controlled project.",
+			ConflictsWith: []string{"git_library_persistence_settings", "git_username_password_persistence_settings", "git_github_persistence_settings"},
+			Description:   "Stores the project in a publicly readable Git repository. A database-backed project is converted to version control when this block is added; the conversion cannot be reversed.",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"base_path": {
@@ -410,10 +506,11 @@ func getProjectSchema() map[string]*schema.Schema {
 		},
 		"id": getIDSchema(),
 		"included_library_variable_sets": {
-			Computed: true,
-			Elem:     &schema.Schema{Type: schema.TypeString},
-			Optional: true,
-			Type:     schema.TypeList,
+			Computed:    true,
+			Description: "The IDs of the library variable sets included in this project. Omit this argument when the library variable sets of the project are managed with `octopusdeploy_project_library_variable_set`.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
 		},
 		"is_disabled": {
 			Computed: true,
@@ -432,7 +529,7 @@ func getProjectSchema() map[string]*schema.Schema {
 			Type:     schema.TypeBool,
 		},
 		"jira_service_management_extension_settings": {
-			Description: "Provides extension settings for the Jira Service Management (JSM) integration for this project.",
+			Description: "Provides extension settings for the Jira Service Management (JSM) integration for this project, allowing deployments to be gated by change requests raised in the given service desk project.",
 			Elem:        &schema.Resource{Schema: prj.GetJiraServiceManagementExtensionSettingsSchema()},
 			MaxItems:    1,
 			Optional:    true,
@@ -457,11 +554,12 @@ func getProjectSchema() map[string]*schema.Schema {
 			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
 		},
 		"release_creation_strategy": {
-			Computed: true,
-			Elem:     &schema.Resource{Schema: getReleaseCreationStrategySchema()},
-			MaxItems: 1,
-			Optional: true,
-			Type:     schema.TypeList,
+			Computed:    true,
+			Description: "Controls which package and channel automatically created releases are based on.",
+			Elem:        &schema.Resource{Schema: getReleaseCreationStrategySchema()},
+			MaxItems:    1,
+			Optional:    true,
+			Type:        schema.TypeList,
 		},
 		"release_notes_template": {
 			Computed: true,
@@ -469,7 +567,7 @@ func getProjectSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 		},
 		"servicenow_extension_settings": {
-			Description: "Provides extension settings for the ServiceNow integration for this project.",
+			Description: "Provides extension settings for the ServiceNow integration for this project, allowing deployments to be gated by change requests raised against the given connection.",
 			Elem:        &schema.Resource{Schema: prj.GetServiceNowExtensionSettingsSchema()},
 			MaxItems:    1,
 			Optional:    true,
@@ -477,10 +575,10 @@ func getProjectSchema() map[string]*schema.Schema {
 		},
 		"slug": {
 			Computed:         true,
-			Description:      "A human-readable, unique identifier, used to identify a project.",
+			Description:      "A human-readable, unique identifier, used to identify a project in URLs and in the paths of version-controlled projects. Octopus derives the slug from the name when it is not set, and keeps it when the project is renamed. Changing the slug updates the project in place, so links to the previous slug stop working. The slugs that Octopus derives contain lowercase letters, digits and single hyphens, such as `my-project`.",
 			Optional:         true,
 			Type:             schema.TypeString,
-			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			ValidateDiagFunc: warnIfNotSlug(),
 		},
 		"space_id": {
 			Computed:         true,
@@ -490,9 +588,10 @@ func getProjectSchema() map[string]*schema.Schema {
 			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
 		},
 		"template": {
-			Elem:     &schema.Resource{Schema: getActionTemplateParameterSchema()},
-			Optional: true,
-			Type:     schema.TypeList,
+			Description: "The project variable templates for which each tenant connected to this project provides a value. Template names must be unique.",
+			Elem:        &schema.Resource{Schema: getActionTemplateParameterSchema()},
+			Optional:    true,
+			Type:        schema.TypeList,
 		},
 		"tenanted_deployment_participation": getTenantedDeploymentSchema(),
 		"variable_set_id": {
@@ -506,6 +605,11 @@ func getProjectSchema() map[string]*schema.Schema {
 			Type:     schema.TypeSet,
 		},
 	}
+
+	gitUsernamePasswordPersistenceSettings := projectSchema["git_username_password_persistence_settings"].Elem.(*schema.Resource)
+	addSecretVersions(gitUsernamePasswordPersistenceSettings.Schema, "password")
+
+	return projectSchema
 }
 
 func setProject(ctx context.Context, d *schema.ResourceData, project *projects.Project) error {
@@ -527,10 +631,8 @@ func setProject(ctx context.Context, d *schema.ResourceData, project *projects.P
 	d.Set("deployment_process_id", project.DeploymentProcessID)
 	d.Set("description", project.Description)
 
-	if len(project.ExtensionSettings) != 0 {
-		if err := prj.SetExtensionSettings(d, project.ExtensionSettings); err != nil {
-			return fmt.Errorf("error setting extension settings: %s", err)
-		}
+	if err := prj.SetExtensionSettings(d, project.ExtensionSettings); err != nil {
+		return fmt.Errorf("error setting extension settings: %s", err)
 	}
 
 	if err := d.Set("included_library_variable_sets", project.IncludedLibraryVariableSets); err != nil {
@@ -548,15 +650,16 @@ func setProject(ctx context.Context, d *schema.ResourceData, project *projects.P
 		if project.PersistenceSettings.Type() == projects.PersistenceSettingsTypeVersionControlled {
 			credential := project.PersistenceSettings.(projects.GitPersistenceSettings).Credential()
 			tflog.Info(ctx, fmt.Sprintf("reading Git Persistence Settings - {%v}", credential))
-			gitCredentialType := credential.Type()
+			gitCredentialType := getGitCredentialType(credential)
 			tflog.Info(ctx, fmt.Sprintf("reading Git Persistence Settings - {%s}", gitCredentialType))
 
 			// if the current settings are u/p, we need to keep the password value from state and put it back
 			// This is different to how this would be dealt with elsewhere, because of the way we have to reshape
 			// the internal objects into the schema.
+			passwordVersion := d.Get(gitPersistencePasswordKey + "_version").(string)
 			if v, ok := d.GetOk("git_username_password_persistence_settings"); ok {
 				settings := expandGitPersistenceSettings(ctx, v, expandUsernamePasswordGitCredential)
-				if project.PersistenceSettings.(projects.GitPersistenceSettings).Credential().Type() == credentials.GitCredentialTypeUsernamePassword {
+				if gitCredentialType == credentials.GitCredentialTypeUsernamePassword {
 					credential := project.PersistenceSettings.(projects.GitPersistenceSettings).Credential().(*credentials.UsernamePassword)
 					credential.Password.NewValue = settings.Credential().(*credentials.UsernamePassword).Password.NewValue
 				}
@@ -574,6 +677,9 @@ func setProject(ctx context.Context, d *schema.ResourceData, project *projects.P
 			if err := d.Set("git_anonymous_persistence_settings", nil); err != nil {
 				return fmt.Errorf("error setting git_library_persistence_settings: %s", err)
 			}
+			if err := d.Set("git_github_persistence_settings", nil); err != nil {
+				return fmt.Errorf("error setting git_github_persistence_settings: %s", err)
+			}
 
 			switch gitCredentialType {
 			case credentials.GitCredentialTypeReference:
@@ -581,13 +687,25 @@ func setProject(ctx context.Context, d *schema.ResourceData, project *projects.P
 					return fmt.Errorf("error setting git_library_persistence_settings: %s", err)
 				}
 			case credentials.GitCredentialTypeUsernamePassword:
-				if err := d.Set("git_username_password_persistence_settings", setGitPersistenceSettings(ctx, project.PersistenceSettings)); err != nil {
+				gitPersistenceSettings := setGitPersistenceSettings(ctx, project.PersistenceSettings)
+
+				// the password is not kept in state once its version is set
+				gitPersistenceSettings[0].(map[string]interface{})["password_version"] = passwordVersion
+				if len(passwordVersion) > 0 {
+					gitPersistenceSettings[0].(map[string]interface{})["password"] = ""
+				}
+
+				if err := d.Set("git_username_password_persistence_settings", gitPersistenceSettings); err != nil {
 					return fmt.Errorf("error setting git_username_password_persistence_settings: %s", err)
 				}
 			case credentials.GitCredentialTypeAnonymous:
 				if err := d.Set("git_anonymous_persistence_settings", setGitPersistenceSettings(ctx, project.PersistenceSettings)); err != nil {
 					return fmt.Errorf("error setting git_anonymous_persistence_settings: %s", err)
 				}
+			case prj.GitCredentialTypeGitHub:
+				if err := d.Set("git_github_persistence_settings", setGitPersistenceSettings(ctx, project.PersistenceSettings)); err != nil {
+					return fmt.Errorf("error setting git_github_persistence_settings: %s", err)
+				}
 			}
 		}
 	} else {
@@ -0,0 +1,135 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// expandProjectDeploymentSettings applies the configured settings to the settings currently held by Octopus. Optional
+// blocks that are not configured are sent back unchanged.
+func expandProjectDeploymentSettings(d *schema.ResourceData, deploymentSettings *deployments.DeploymentSettings) *deployments.DeploymentSettings {
+	if v, ok := d.GetOk("connectivity_policy"); ok {
+		deploymentSettings.ConnectivityPolicy = expandConnectivityPolicy(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("default_guided_failure_mode"); ok {
+		deploymentSettings.DefaultGuidedFailureMode = core.GuidedFailureMode(v.(string))
+	}
+
+	deploymentSettings.DefaultToSkipIfAlreadyInstalled = d.Get("default_to_skip_if_already_installed").(bool)
+	deploymentSettings.DeploymentChangesTemplate = d.Get("deployment_changes_template").(string)
+	deploymentSettings.ReleaseNotesTemplate = d.Get("release_notes_template").(string)
+
+	if v, ok := d.GetOk("versioning_strategy"); ok {
+		deploymentSettings.VersioningStrategy = expandVersioningStrategy(v)
+	}
+
+	if v, ok := d.GetOk("git_ref"); ok && len(v.(string)) > 0 {
+		deploymentSettings.ChangeDescription = getProjectDeploymentSettingsCommitMessage(d)
+	}
+
+	return deploymentSettings
+}
+
+func getProjectDeploymentSettingsCommitMessage(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("commit_message"); ok && len(v.(string)) > 0 {
+		return v.(string)
+	}
+	return "Update deployment settings"
+}
+
+func getProjectDeploymentSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"commit_message": {
+			Description: "The commit message used when the settings of a version-controlled project are changed.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"connectivity_policy": {
+			Computed:    true,
+			Description: "Controls how the deployment behaves when deployment targets are unavailable or unhealthy.",
+			Elem:        &schema.Resource{Schema: getConnectivityPolicySchema()},
+			MaxItems:    1,
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"default_guided_failure_mode": {
+			Computed:    true,
+			Description: "The guided failure mode of deployments of this project, one of `EnvironmentDefault`, `Off` or `On`.",
+			Optional:    true,
+			Type:        schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				"EnvironmentDefault",
+				"Off",
+				"On",
+			}, false)),
+		},
+		"default_to_skip_if_already_installed": {
+			Default:     false,
+			Description: "Whether deployments skip packages that are already installed by default.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"deployment_changes_template": {
+			Description: "The template used to render the changes included in a deployment.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"git_ref": {
+			Description: "The branch or tag holding the settings of a version-controlled project. Must be omitted for projects that are not version controlled.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"id": getIDSchema(),
+		"project_id": {
+			Description:      "The ID of the project these deployment settings belong to.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"release_notes_template": {
+			Description: "The template used to pre-populate the notes of new releases.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"space_id": {
+			Computed:    true,
+			Description: "The space ID associated with this resource.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"versioning_strategy": {
+			Computed:    true,
+			Description: "How release numbers are generated: either from a template or from the version of a package referenced by a step.",
+			Elem:        &schema.Resource{Schema: getVersionStrategySchema()},
+			MaxItems:    1,
+			Optional:    true,
+			Type:        schema.TypeSet,
+		},
+	}
+}
+
+func setProjectDeploymentSettings(d *schema.ResourceData, deploymentSettings *deployments.DeploymentSettings) error {
+	if err := d.Set("connectivity_policy", flattenConnectivityPolicy(deploymentSettings.ConnectivityPolicy)); err != nil {
+		return fmt.Errorf("error setting connectivity_policy: %s", err)
+	}
+
+	d.Set("default_guided_failure_mode", deploymentSettings.DefaultGuidedFailureMode)
+	d.Set("default_to_skip_if_already_installed", deploymentSettings.DefaultToSkipIfAlreadyInstalled)
+	d.Set("deployment_changes_template", deploymentSettings.DeploymentChangesTemplate)
+	d.Set("project_id", deploymentSettings.ProjectID)
+	d.Set("release_notes_template", deploymentSettings.ReleaseNotesTemplate)
+	d.Set("space_id", deploymentSettings.SpaceID)
+
+	if err := d.Set("versioning_strategy", flattenVersioningStrategy(deploymentSettings.VersioningStrategy)); err != nil {
+		return fmt.Errorf("error setting versioning_strategy: %s", err)
+	}
+
+	return nil
+}
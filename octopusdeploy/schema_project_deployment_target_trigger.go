@@ -1,9 +1,35 @@
 package octopusdeploy
 
 import (
+	trg "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/triggers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+func expandDeploymentTargetTriggerRunRunbookAction(values []interface{}) trg.DeploymentTargetTriggerAction {
+	actionMap := values[0].(map[string]interface{})
+	return trg.DeploymentTargetTriggerAction{
+		ActionType:     trg.RunRunbookActionType,
+		EnvironmentIDs: getSliceFromTerraformTypeList(actionMap["environment_ids"]),
+		RunbookID:      actionMap["runbook_id"].(string),
+		TenantIDs:      getSliceFromTerraformTypeList(actionMap["tenant_ids"]),
+		TenantTags:     getSliceFromTerraformTypeList(actionMap["tenant_tags"]),
+	}
+}
+
+func flattenDeploymentTargetTriggerRunRunbookAction(action trg.DeploymentTargetTriggerAction) []interface{} {
+	if action.ActionType != trg.RunRunbookActionType {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"environment_ids": action.EnvironmentIDs,
+		"runbook_id":      action.RunbookID,
+		"tenant_ids":      action.TenantIDs,
+		"tenant_tags":     action.TenantTags,
+	}}
+}
+
 func getProjectDeploymentTargetTriggerSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"name": getNameSchema(true),
@@ -12,6 +38,42 @@ func getProjectDeploymentTargetTriggerSchema() map[string]*schema.Schema {
 			Required:    true,
 			Type:        schema.TypeString,
 		},
+		"run_runbook_action": {
+			ConflictsWith: []string{"should_redeploy"},
+			Description:   "Runs a runbook when the trigger fires, instead of deploying the current release to the deployment targets.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"environment_ids": {
+						Description: "The IDs of the environments to run the runbook in.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						MinItems:    1,
+						Required:    true,
+						Type:        schema.TypeSet,
+					},
+					"runbook_id": {
+						Description:      "The ID of the runbook to run. The runbook must have a published snapshot.",
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+					},
+					"tenant_ids": {
+						Description: "The IDs of the tenants to run the runbook for.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Optional:    true,
+						Type:        schema.TypeSet,
+					},
+					"tenant_tags": {
+						Description: "The tenant tags of the tenants to run the runbook for, in the form of `TagSet/Tag`.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Optional:    true,
+						Type:        schema.TypeSet,
+					},
+				},
+			},
+			MaxItems: 1,
+			Optional: true,
+			Type:     schema.TypeList,
+		},
 		"should_redeploy": {
 			Default:     false,
 			Description: "Enable to re-deploy to the deployment targets even if they are already up-to-date with the current deployment.",
@@ -34,13 +96,20 @@ func getProjectDeploymentTargetTriggerSchema() map[string]*schema.Schema {
 			Description: "Apply event role filters to restrict which deployment targets will actually cause the trigger to fire, and consequently, which deployment targets will be automatically deployed to.",
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Optional:    true,
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 		},
 		"environment_ids": {
 			Description: "Apply environment id filters to restrict which deployment targets will actually cause the trigger to fire, and consequently, which deployment targets will be automatically deployed to.",
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Optional:    true,
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
+		},
+		"space_id": getSpaceIDInputSchema(),
+		"tenant_tags": {
+			Description: "Apply tenant tag filters, in the form of `TagSet/Tag`, to restrict which deployment targets will actually cause the trigger to fire, and consequently, which deployment targets will be automatically deployed to.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeSet,
 		},
 	}
 }
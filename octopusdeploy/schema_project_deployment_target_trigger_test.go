@@ -0,0 +1,54 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"testing"
+
+	trg "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/triggers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentTargetTriggerRunRunbookAction(t *testing.T) {
+	action := expandDeploymentTargetTriggerRunRunbookAction([]interface{}{map[string]interface{}{
+		"environment_ids": []interface{}{"Environments-1"},
+		"runbook_id":      "Runbooks-1",
+		"tenant_ids":      []interface{}{},
+		"tenant_tags":     []interface{}{"Region/West"},
+	}})
+
+	filter := trg.DeploymentTargetTriggerFilter{
+		EventGroups: []string{"Machine"},
+		TenantTags:  []string{"Hosting/Dedicated"},
+	}
+
+	trigger := trg.NewDeploymentTargetTrigger("Target added", "Projects-1", "Spaces-1", action, filter)
+
+	b, err := json.Marshal(trigger)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"Action": {
+			"ActionType": "RunRunbook",
+			"EnvironmentIds": ["Environments-1"],
+			"RunbookId": "Runbooks-1",
+			"ShouldRedeployWhenMachineHasBeenDeployedTo": false,
+			"TenantTags": ["Region/West"]
+		},
+		"Filter": {
+			"EventGroups": ["Machine"],
+			"FilterType": "MachineFilter",
+			"TenantTags": ["Hosting/Dedicated"]
+		},
+		"IsDisabled": false,
+		"Name": "Target added",
+		"ProjectId": "Projects-1",
+		"SpaceId": "Spaces-1"
+	}`, string(b))
+
+	flattenedAction := flattenDeploymentTargetTriggerRunRunbookAction(trigger.Action)
+	require.Len(t, flattenedAction, 1)
+	require.Equal(t, "Runbooks-1", flattenedAction[0].(map[string]interface{})["runbook_id"])
+	require.Equal(t, []string{"Environments-1"}, flattenedAction[0].(map[string]interface{})["environment_ids"])
+
+	// triggers that redeploy the current release have no runbook action
+	require.Nil(t, flattenDeploymentTargetTriggerRunRunbookAction(trg.DeploymentTargetTriggerAction{ActionType: trg.AutoDeployActionType, ShouldRedeploy: true}))
+}
@@ -57,8 +57,9 @@ func getProjectGroupDataSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Type:        schema.TypeList,
 		},
-		"skip": getQuerySkip(),
-		"take": getQueryTake(),
+		"skip":     getQuerySkip(),
+		"space_id": getQuerySpaceID(),
+		"take":     getQueryTakeAll(),
 	}
 }
 
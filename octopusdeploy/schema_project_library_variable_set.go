@@ -0,0 +1,38 @@
+package octopusdeploy
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func getProjectLibraryVariableSetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": getIDSchema(),
+		"library_variable_set_id": {
+			Description:      "The ID of the library variable set to include in the project.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"project_id": {
+			Description:      "The ID of the project that includes the library variable set.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"space_id": getSpaceIDInputSchema(),
+	}
+}
+
+// removeLibraryVariableSet returns the given IDs of library variable sets without the given one.
+func removeLibraryVariableSet(libraryVariableSetIDs []string, libraryVariableSetID string) []string {
+	remaining := []string{}
+	for _, id := range libraryVariableSetIDs {
+		if id != libraryVariableSetID {
+			remaining = append(remaining, id)
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,567 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actions"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/filters"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/triggers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// scheduleTimeLayout is the layout of the times of a schedule, which are interpreted in the timezone of the trigger
+const scheduleTimeLayout = "2006-01-02T15:04:05"
+
+var scheduleKeys = []string{
+	"continuous_daily_schedule",
+	"cron_expression_schedule",
+	"days_per_month_schedule",
+	"once_daily_schedule",
+}
+
+func expandProjectScheduledTrigger(d *schema.ResourceData, project *projects.Project) (*triggers.ProjectTrigger, error) {
+	filter, err := expandTriggerSchedule(d)
+	if err != nil {
+		return nil, err
+	}
+
+	var action actions.ITriggerAction
+	if v, ok := d.GetOk("deploy_latest_release_action"); ok {
+		actionMap := v.([]interface{})[0].(map[string]interface{})
+		deployLatestReleaseAction := actions.NewDeployLatestReleaseAction(
+			actionMap["destination_environment_id"].(string),
+			actionMap["should_redeploy"].(bool),
+			getSliceFromTerraformTypeList(actionMap["source_environment_ids"]),
+			"",
+		)
+		deployLatestReleaseAction.Channel = d.Get("channel_id").(string)
+		deployLatestReleaseAction.Tenants = getSliceFromTerraformTypeList(d.Get("tenant_ids"))
+		deployLatestReleaseAction.TenantTags = getSliceFromTerraformTypeList(d.Get("tenant_tags"))
+		action = deployLatestReleaseAction
+	}
+
+	if v, ok := d.GetOk("deploy_new_release_action"); ok {
+		actionMap := v.([]interface{})[0].(map[string]interface{})
+
+		var versionControlReference *actions.VersionControlReference
+		if gitRef := actionMap["git_reference"].(string); len(gitRef) > 0 {
+			versionControlReference = &actions.VersionControlReference{GitRef: gitRef}
+		}
+
+		deployNewReleaseAction := actions.NewDeployNewReleaseAction(actionMap["destination_environment_id"].(string), "", versionControlReference)
+		deployNewReleaseAction.Channel = d.Get("channel_id").(string)
+		deployNewReleaseAction.Tenants = getSliceFromTerraformTypeList(d.Get("tenant_ids"))
+		deployNewReleaseAction.TenantTags = getSliceFromTerraformTypeList(d.Get("tenant_tags"))
+		action = deployNewReleaseAction
+	}
+
+	if action == nil {
+		return nil, fmt.Errorf("one of deploy_latest_release_action or deploy_new_release_action must be specified")
+	}
+
+	projectTrigger := triggers.NewProjectTrigger(d.Get("name").(string), d.Get("description").(string), d.Get("is_disabled").(bool), project, action, filter)
+
+	// the description is not set by the constructor
+	projectTrigger.Description = d.Get("description").(string)
+	projectTrigger.ID = d.Id()
+
+	return projectTrigger, nil
+}
+
+// expandTriggerSchedule builds the filter of a scheduled trigger from the schedule block that is configured. The
+// timezone of the trigger applies to all times of the schedule.
+func expandTriggerSchedule(d *schema.ResourceData) (filters.ITriggerFilter, error) {
+	timezone := d.Get("timezone").(string)
+
+	if v, ok := d.GetOk("once_daily_schedule"); ok {
+		scheduleMap := v.([]interface{})[0].(map[string]interface{})
+
+		startTime, err := time.Parse(scheduleTimeLayout, scheduleMap["start_time"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		days, err := expandWeekdays(scheduleMap["days_of_week"])
+		if err != nil {
+			return nil, err
+		}
+
+		filter := filters.NewOnceDailyScheduledTriggerFilter(days, startTime)
+		filter.TimeZone = timezone
+		return filter, nil
+	}
+
+	if v, ok := d.GetOk("continuous_daily_schedule"); ok {
+		scheduleMap := v.([]interface{})[0].(map[string]interface{})
+
+		days, err := expandWeekdays(scheduleMap["days_of_week"])
+		if err != nil {
+			return nil, err
+		}
+
+		runAfter, err := time.Parse(scheduleTimeLayout, scheduleMap["run_after"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		runUntil, err := time.Parse(scheduleTimeLayout, scheduleMap["run_until"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		interval, err := filters.DailyScheduledIntervalString(scheduleMap["interval"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		filter := filters.NewContinuousDailyScheduledTriggerFilter(days, timezone)
+		filter.Interval = &interval
+		filter.RunAfter = &runAfter
+		filter.RunUntil = &runUntil
+
+		switch interval {
+		case filters.OnceHourly:
+			hourInterval := int16(scheduleMap["hour_interval"].(int))
+			filter.HourInterval = &hourInterval
+		case filters.OnceEveryMinute:
+			minuteInterval := int16(scheduleMap["minute_interval"].(int))
+			filter.MinuteInterval = &minuteInterval
+		}
+
+		return filter, nil
+	}
+
+	if v, ok := d.GetOk("days_per_month_schedule"); ok {
+		scheduleMap := v.([]interface{})[0].(map[string]interface{})
+
+		startTime, err := time.Parse(scheduleTimeLayout, scheduleMap["start_time"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		monthlySchedule, err := filters.MonthlyScheduleString(scheduleMap["monthly_schedule_type"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		filter := filters.NewDaysPerMonthScheduledTriggerFilter(monthlySchedule, startTime)
+		filter.TimeZone = timezone
+
+		switch monthlySchedule {
+		case filters.DateOfMonth:
+			filter.DateOfMonth = scheduleMap["date_of_month"].(string)
+		case filters.DayOfMonth:
+			day, err := filters.WeekdayString(scheduleMap["day_of_week"].(string))
+			if err != nil {
+				return nil, err
+			}
+			filter.Day = &day
+			filter.DayNumberOfMonth = scheduleMap["day_number_of_month"].(string)
+		}
+
+		return filter, nil
+	}
+
+	if v, ok := d.GetOk("cron_expression_schedule"); ok {
+		scheduleMap := v.([]interface{})[0].(map[string]interface{})
+		return filters.NewCronScheduledTriggerFilter(scheduleMap["cron_expression"].(string), timezone), nil
+	}
+
+	return nil, fmt.Errorf("one of %v must be specified", scheduleKeys)
+}
+
+func expandWeekdays(values interface{}) ([]filters.Weekday, error) {
+	weekdays := []filters.Weekday{}
+	for _, value := range getSliceFromTerraformTypeList(values) {
+		weekday, err := filters.WeekdayString(value)
+		if err != nil {
+			return nil, err
+		}
+		weekdays = append(weekdays, weekday)
+	}
+
+	return weekdays, nil
+}
+
+func flattenWeekdays(weekdays []filters.Weekday) []string {
+	flattenedWeekdays := []string{}
+	for _, weekday := range weekdays {
+		flattenedWeekdays = append(flattenedWeekdays, weekday.String())
+	}
+
+	return flattenedWeekdays
+}
+
+func flattenScheduleTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(scheduleTimeLayout)
+}
+
+// flattenTriggerSchedule sets the schedule block that matches the filter of a scheduled trigger, along with its
+// timezone, and clears the others.
+func flattenTriggerSchedule(d *schema.ResourceData, filter filters.ITriggerFilter) error {
+	schedules := map[string][]interface{}{}
+	var timezone string
+
+	switch filter := filter.(type) {
+	case *filters.OnceDailyScheduledTriggerFilter:
+		timezone = filter.TimeZone
+		schedules["once_daily_schedule"] = []interface{}{map[string]interface{}{
+			"days_of_week": flattenWeekdays(filter.Days),
+			"start_time":   flattenScheduleTime(&filter.Start),
+		}}
+	case *filters.ContinuousDailyScheduledTriggerFilter:
+		timezone = filter.TimeZone
+		schedule := map[string]interface{}{
+			"days_of_week": flattenWeekdays(filter.Days),
+			"run_after":    flattenScheduleTime(filter.RunAfter),
+			"run_until":    flattenScheduleTime(filter.RunUntil),
+		}
+		if filter.Interval != nil {
+			schedule["interval"] = filter.Interval.String()
+		}
+		if filter.HourInterval != nil {
+			schedule["hour_interval"] = int(*filter.HourInterval)
+		}
+		if filter.MinuteInterval != nil {
+			schedule["minute_interval"] = int(*filter.MinuteInterval)
+		}
+		schedules["continuous_daily_schedule"] = []interface{}{schedule}
+	case *filters.DaysPerMonthScheduledTriggerFilter:
+		timezone = filter.TimeZone
+		schedule := map[string]interface{}{
+			"date_of_month":         filter.DateOfMonth,
+			"day_number_of_month":   filter.DayNumberOfMonth,
+			"monthly_schedule_type": filter.MonthlySchedule.String(),
+			"start_time":            flattenScheduleTime(&filter.Start),
+		}
+		if filter.Day != nil {
+			schedule["day_of_week"] = filter.Day.String()
+		}
+		schedules["days_per_month_schedule"] = []interface{}{schedule}
+	case *filters.CronScheduledTriggerFilter:
+		timezone = filter.TimeZone
+		schedules["cron_expression_schedule"] = []interface{}{map[string]interface{}{
+			"cron_expression": filter.CronExpression,
+		}}
+	default:
+		return fmt.Errorf("unsupported schedule for a scheduled trigger: %T", filter)
+	}
+
+	for _, key := range scheduleKeys {
+		if err := d.Set(key, schedules[key]); err != nil {
+			return fmt.Errorf("error setting %s: %s", key, err)
+		}
+	}
+
+	d.Set("timezone", timezone)
+
+	return nil
+}
+
+func getProjectScheduledTriggerSchema() map[string]*schema.Schema {
+	triggerSchema := getTriggerScheduleSchema()
+
+	triggerSchema["channel_id"] = &schema.Schema{
+		Computed:    true,
+		Description: "The ID of the channel of the releases that are deployed. Defaults to the default channel of the project.",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+	triggerSchema["deploy_latest_release_action"] = &schema.Schema{
+		Description: "Deploys the latest release to an environment. Promotes the latest release of the source environments to the destination environment when `source_environment_ids` is set.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination_environment_id": {
+					Description:      "The ID of the environment to deploy to.",
+					Required:         true,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+				},
+				"should_redeploy": {
+					Default:     false,
+					Description: "Whether the release is deployed again when it is already the current release of the destination environment.",
+					Optional:    true,
+					Type:        schema.TypeBool,
+				},
+				"source_environment_ids": {
+					Description: "The IDs of the environments to promote the latest release from.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Optional:    true,
+					Type:        schema.TypeList,
+				},
+			},
+		},
+		ExactlyOneOf: []string{"deploy_latest_release_action", "deploy_new_release_action"},
+		MaxItems:     1,
+		Optional:     true,
+		Type:         schema.TypeList,
+	}
+	triggerSchema["deploy_new_release_action"] = &schema.Schema{
+		Description: "Creates a new release and deploys it to an environment.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination_environment_id": {
+					Description:      "The ID of the environment to deploy to.",
+					Required:         true,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+				},
+				"git_reference": {
+					Description: "The branch or tag to create the release from for a version-controlled project.",
+					Optional:    true,
+					Type:        schema.TypeString,
+				},
+			},
+		},
+		ExactlyOneOf: []string{"deploy_latest_release_action", "deploy_new_release_action"},
+		MaxItems:     1,
+		Optional:     true,
+		Type:         schema.TypeList,
+	}
+	triggerSchema["tenant_ids"] = &schema.Schema{
+		Description: "The IDs of the tenants to deploy to.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Type:        schema.TypeList,
+	}
+	triggerSchema["tenant_tags"] = &schema.Schema{
+		Description: "The tenant tags of the tenants to deploy to, in the form of `TagSet/Tag`.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Type:        schema.TypeList,
+	}
+
+	return triggerSchema
+}
+
+// getTriggerScheduleSchema returns the attributes shared by all scheduled triggers: the trigger itself and the schedule
+// on which it runs.
+func getTriggerScheduleSchema() map[string]*schema.Schema {
+	daysOfWeekSchema := &schema.Schema{
+		Description: "The days of the week on which the trigger runs.",
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(weekdayNames(), false)),
+		},
+		MinItems: 1,
+		Required: true,
+		Type:     schema.TypeList,
+	}
+
+	scheduleTimeSchema := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Description:      fmt.Sprintf("%s, in the form of `YYYY-MM-DDTHH:MM:SS` (e.g. `2024-01-01T09:00:00`) in the timezone of the trigger.", description),
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validateScheduleTime),
+		}
+	}
+
+	return map[string]*schema.Schema{
+		"continuous_daily_schedule": {
+			Description: "Runs the trigger repeatedly between two times on selected days of the week.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"days_of_week": daysOfWeekSchema,
+					"hour_interval": {
+						Description:      "The number of hours between runs when `interval` is `OnceHourly`.",
+						Optional:         true,
+						Type:             schema.TypeInt,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+					},
+					"interval": {
+						Description:      "How often the trigger runs. Valid values are `OnceHourly` and `OnceEveryMinute`.",
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{filters.OnceHourly.String(), filters.OnceEveryMinute.String()}, false)),
+					},
+					"minute_interval": {
+						Description:      "The number of minutes between runs when `interval` is `OnceEveryMinute`.",
+						Optional:         true,
+						Type:             schema.TypeInt,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+					},
+					"run_after": scheduleTimeSchema("The time of day after which the trigger runs"),
+					"run_until": scheduleTimeSchema("The time of day until which the trigger runs"),
+				},
+			},
+			ExactlyOneOf: scheduleKeys,
+			MaxItems:     1,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+		"cron_expression_schedule": {
+			Description: "Runs the trigger on a schedule defined by a cron expression.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"cron_expression": {
+						Description:      "The cron expression of the schedule (e.g. `0 0 9 * * Mon-Fri`).",
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+					},
+				},
+			},
+			ExactlyOneOf: scheduleKeys,
+			MaxItems:     1,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+		"days_per_month_schedule": {
+			Description: "Runs the trigger once on selected days of the month.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"date_of_month": {
+						Description: "The day of the month (`1` to `31`, or `L` for the last day) on which the trigger runs when `monthly_schedule_type` is `DateOfMonth`.",
+						Optional:    true,
+						Type:        schema.TypeString,
+					},
+					"day_number_of_month": {
+						Description: "The occurrence (`1` to `4`, or `L` for the last) of `day_of_week` in the month on which the trigger runs when `monthly_schedule_type` is `DayOfMonth`.",
+						Optional:    true,
+						Type:        schema.TypeString,
+					},
+					"day_of_week": {
+						Description:      "The day of the week on which the trigger runs when `monthly_schedule_type` is `DayOfMonth`.",
+						Optional:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(weekdayNames(), false)),
+					},
+					"monthly_schedule_type": {
+						Description:      "How the day of the month is selected. Valid values are `DateOfMonth` and `DayOfMonth`.",
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{filters.DateOfMonth.String(), filters.DayOfMonth.String()}, false)),
+					},
+					"start_time": scheduleTimeSchema("The date and time from which the trigger runs; the trigger runs at this time of day"),
+				},
+			},
+			ExactlyOneOf: scheduleKeys,
+			MaxItems:     1,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+		"description": getDescriptionSchema("scheduled trigger"),
+		"id":          getIDSchema(),
+		"is_disabled": {
+			Default:     false,
+			Description: "Whether the trigger is disabled.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"name": getNameSchema(true),
+		"once_daily_schedule": {
+			Description: "Runs the trigger once a day on selected days of the week.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"days_of_week": daysOfWeekSchema,
+					"start_time":   scheduleTimeSchema("The date and time from which the trigger runs; the trigger runs at this time of day"),
+				},
+			},
+			ExactlyOneOf: scheduleKeys,
+			MaxItems:     1,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+		"project_id": {
+			Description:      "The ID of the project to attach the trigger.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"space_id": {
+			Computed:    true,
+			Description: "The space ID associated with this trigger.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"timezone": {
+			Default:     "UTC",
+			Description: "The timezone in which the times of the schedule are interpreted (e.g. `UTC` or `AUS Eastern Standard Time`).",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func setProjectScheduledTrigger(d *schema.ResourceData, projectTrigger *triggers.ProjectTrigger) error {
+	d.Set("description", projectTrigger.Description)
+	d.Set("is_disabled", projectTrigger.IsDisabled)
+	d.Set("name", projectTrigger.Name)
+	d.Set("project_id", projectTrigger.ProjectID)
+	d.Set("space_id", projectTrigger.SpaceID)
+
+	if err := flattenTriggerSchedule(d, projectTrigger.Filter); err != nil {
+		return err
+	}
+
+	deployLatestReleaseAction := []interface{}{}
+	deployNewReleaseAction := []interface{}{}
+
+	switch action := projectTrigger.Action.(type) {
+	case *actions.DeployLatestReleaseAction:
+		d.Set("channel_id", action.Channel)
+		d.Set("tenant_ids", action.Tenants)
+		d.Set("tenant_tags", action.TenantTags)
+		deployLatestReleaseAction = append(deployLatestReleaseAction, map[string]interface{}{
+			"destination_environment_id": action.DestinationEnvironment,
+			"should_redeploy":            action.ShouldRedeploy,
+			"source_environment_ids":     action.SourceEnvironments,
+		})
+	case *actions.DeployNewReleaseAction:
+		d.Set("channel_id", action.Channel)
+		d.Set("tenant_ids", action.Tenants)
+		d.Set("tenant_tags", action.TenantTags)
+
+		gitReference := ""
+		if action.VersionControlReference != nil {
+			gitReference = action.VersionControlReference.GitRef
+		}
+		deployNewReleaseAction = append(deployNewReleaseAction, map[string]interface{}{
+			"destination_environment_id": action.Environment,
+			"git_reference":              gitReference,
+		})
+	default:
+		return fmt.Errorf("unsupported action for a project scheduled trigger: %T", action)
+	}
+
+	if err := d.Set("deploy_latest_release_action", deployLatestReleaseAction); err != nil {
+		return fmt.Errorf("error setting deploy_latest_release_action: %s", err)
+	}
+
+	if err := d.Set("deploy_new_release_action", deployNewReleaseAction); err != nil {
+		return fmt.Errorf("error setting deploy_new_release_action: %s", err)
+	}
+
+	return nil
+}
+
+func validateScheduleTime(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if _, err := time.Parse(scheduleTimeLayout, v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a time in the form of YYYY-MM-DDTHH:MM:SS, got %s", k, v)}
+	}
+
+	return nil, nil
+}
+
+func weekdayNames() []string {
+	names := []string{}
+	for _, weekday := range filters.WeekdayValues() {
+		names = append(names, weekday.String())
+	}
+
+	return names
+}
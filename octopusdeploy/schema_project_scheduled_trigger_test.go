@@ -0,0 +1,143 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/triggers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func testProjectScheduledTriggerRoundTrip(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	project := projects.NewProject("Project", "Lifecycles-1", "ProjectGroups-1")
+	project.ID = "Projects-1"
+	project.SpaceID = "Spaces-1"
+
+	d := schema.TestResourceDataRaw(t, getProjectScheduledTriggerSchema(), raw)
+	projectTrigger, err := expandProjectScheduledTrigger(d, project)
+	require.NoError(t, err)
+	require.Equal(t, "Projects-1", projectTrigger.ProjectID)
+
+	// the trigger is read back through its JSON representation, as it is when returned by Octopus
+	b, err := json.Marshal(projectTrigger)
+	require.NoError(t, err)
+
+	var readProjectTrigger triggers.ProjectTrigger
+	require.NoError(t, json.Unmarshal(b, &readProjectTrigger))
+
+	state := schema.TestResourceDataRaw(t, getProjectScheduledTriggerSchema(), map[string]interface{}{})
+	require.NoError(t, setProjectScheduledTrigger(state, &readProjectTrigger))
+	return state
+}
+
+func TestProjectScheduledTriggerOnceDailySchedule(t *testing.T) {
+	d := testProjectScheduledTriggerRoundTrip(t, map[string]interface{}{
+		"name":       "Nightly",
+		"project_id": "Projects-1",
+		"timezone":   "AUS Eastern Standard Time",
+		"once_daily_schedule": []interface{}{map[string]interface{}{
+			"days_of_week": []interface{}{"Monday", "Friday"},
+			"start_time":   "2024-01-01T09:30:00",
+		}},
+		"deploy_new_release_action": []interface{}{map[string]interface{}{
+			"destination_environment_id": "Environments-1",
+			"git_reference":              "refs/heads/main",
+		}},
+		"tenant_ids": []interface{}{"Tenants-1"},
+	})
+
+	require.Equal(t, "AUS Eastern Standard Time", d.Get("timezone"))
+	require.Equal(t, []interface{}{"Monday", "Friday"}, d.Get("once_daily_schedule.0.days_of_week"))
+	require.Equal(t, "2024-01-01T09:30:00", d.Get("once_daily_schedule.0.start_time"))
+	require.Empty(t, d.Get("cron_expression_schedule"))
+	require.Equal(t, "Environments-1", d.Get("deploy_new_release_action.0.destination_environment_id"))
+	require.Equal(t, "refs/heads/main", d.Get("deploy_new_release_action.0.git_reference"))
+	require.Empty(t, d.Get("deploy_latest_release_action"))
+	require.Equal(t, []interface{}{"Tenants-1"}, d.Get("tenant_ids"))
+	require.Equal(t, "Spaces-1", d.Get("space_id"))
+}
+
+func TestProjectScheduledTriggerContinuousDailySchedule(t *testing.T) {
+	d := testProjectScheduledTriggerRoundTrip(t, map[string]interface{}{
+		"name":       "Hourly",
+		"project_id": "Projects-1",
+		"continuous_daily_schedule": []interface{}{map[string]interface{}{
+			"days_of_week":  []interface{}{"Saturday", "Sunday"},
+			"hour_interval": 2,
+			"interval":      "OnceHourly",
+			"run_after":     "2024-01-01T08:00:00",
+			"run_until":     "2024-01-01T18:00:00",
+		}},
+		"deploy_latest_release_action": []interface{}{map[string]interface{}{
+			"destination_environment_id": "Environments-2",
+			"source_environment_ids":     []interface{}{"Environments-1"},
+		}},
+	})
+
+	require.Equal(t, "UTC", d.Get("timezone"))
+	require.Equal(t, []interface{}{"Saturday", "Sunday"}, d.Get("continuous_daily_schedule.0.days_of_week"))
+	require.Equal(t, 2, d.Get("continuous_daily_schedule.0.hour_interval"))
+	require.Equal(t, "OnceHourly", d.Get("continuous_daily_schedule.0.interval"))
+	require.Equal(t, "2024-01-01T08:00:00", d.Get("continuous_daily_schedule.0.run_after"))
+	require.Equal(t, "2024-01-01T18:00:00", d.Get("continuous_daily_schedule.0.run_until"))
+	require.Equal(t, "Environments-2", d.Get("deploy_latest_release_action.0.destination_environment_id"))
+	require.Equal(t, []interface{}{"Environments-1"}, d.Get("deploy_latest_release_action.0.source_environment_ids"))
+	require.Equal(t, false, d.Get("deploy_latest_release_action.0.should_redeploy"))
+}
+
+func TestProjectScheduledTriggerDaysPerMonthSchedule(t *testing.T) {
+	d := testProjectScheduledTriggerRoundTrip(t, map[string]interface{}{
+		"name":       "Monthly",
+		"project_id": "Projects-1",
+		"days_per_month_schedule": []interface{}{map[string]interface{}{
+			"day_number_of_month":   "L",
+			"day_of_week":           "Friday",
+			"monthly_schedule_type": "DayOfMonth",
+			"start_time":            "2024-01-01T23:00:00",
+		}},
+		"deploy_latest_release_action": []interface{}{map[string]interface{}{
+			"destination_environment_id": "Environments-1",
+			"should_redeploy":            true,
+		}},
+	})
+
+	require.Equal(t, "L", d.Get("days_per_month_schedule.0.day_number_of_month"))
+	require.Equal(t, "Friday", d.Get("days_per_month_schedule.0.day_of_week"))
+	require.Equal(t, "DayOfMonth", d.Get("days_per_month_schedule.0.monthly_schedule_type"))
+	require.Equal(t, "2024-01-01T23:00:00", d.Get("days_per_month_schedule.0.start_time"))
+	require.Equal(t, true, d.Get("deploy_latest_release_action.0.should_redeploy"))
+}
+
+func TestProjectScheduledTriggerCronExpressionSchedule(t *testing.T) {
+	d := testProjectScheduledTriggerRoundTrip(t, map[string]interface{}{
+		"description": "Weekday mornings",
+		"is_disabled": true,
+		"name":        "Cron",
+		"project_id":  "Projects-1",
+		"cron_expression_schedule": []interface{}{map[string]interface{}{
+			"cron_expression": "0 0 9 * * Mon-Fri",
+		}},
+		"deploy_new_release_action": []interface{}{map[string]interface{}{
+			"destination_environment_id": "Environments-1",
+		}},
+	})
+
+	require.Equal(t, "Weekday mornings", d.Get("description"))
+	require.Equal(t, true, d.Get("is_disabled"))
+	require.Equal(t, "0 0 9 * * Mon-Fri", d.Get("cron_expression_schedule.0.cron_expression"))
+	require.Empty(t, d.Get("once_daily_schedule"))
+	require.Equal(t, "", d.Get("deploy_new_release_action.0.git_reference"))
+}
+
+func TestValidateScheduleTime(t *testing.T) {
+	_, errs := validateScheduleTime("2024-01-01T09:00:00", "start_time")
+	require.Empty(t, errs)
+
+	_, errs = validateScheduleTime("09:00", "start_time")
+	require.Len(t, errs, 1)
+
+	_, errs = validateScheduleTime("2024-01-01T09:00:00Z", "start_time")
+	require.Len(t, errs, 1)
+}
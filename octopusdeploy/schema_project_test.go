@@ -0,0 +1,304 @@
+package octopusdeploy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/credentials"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	prj "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/projects"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandProjectServiceNowExtensionSettings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getProjectSchema(), map[string]interface{}{
+		"lifecycle_id":     "Lifecycles-1",
+		"name":             "Test",
+		"project_group_id": "ProjectGroups-1",
+		"servicenow_extension_settings": []interface{}{
+			map[string]interface{}{
+				"connection_id":                       "ServiceNowConnections-1",
+				"is_enabled":                          true,
+				"is_state_automatically_transitioned": true,
+				"standard_change_template_name":       "Standard Deployment",
+			},
+		},
+	})
+
+	project := expandProject(context.Background(), d)
+	require.Len(t, project.ExtensionSettings, 1)
+
+	serviceNowExtensionSettings, ok := project.ExtensionSettings[0].(*projects.ServiceNowExtensionSettings)
+	require.True(t, ok)
+	require.Equal(t, "ServiceNowConnections-1", serviceNowExtensionSettings.ConnectionID())
+	require.True(t, serviceNowExtensionSettings.IsChangeControlled())
+	require.True(t, serviceNowExtensionSettings.IsStateAutomaticallyTransitioned)
+	require.Equal(t, "Standard Deployment", serviceNowExtensionSettings.StandardChangeTemplateName)
+}
+
+func TestSetProjectServiceNowExtensionSettings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getProjectSchema(), map[string]interface{}{})
+	project := projects.NewProject("Test", "Lifecycles-1", "ProjectGroups-1")
+
+	project.ExtensionSettings = append(project.ExtensionSettings, projects.NewServiceNowExtensionSettings("ServiceNowConnections-1", true, "Standard Deployment", false))
+	require.NoError(t, setProject(context.Background(), d, project))
+	require.Equal(t, "ServiceNowConnections-1", d.Get("servicenow_extension_settings.0.connection_id"))
+	require.Equal(t, "Standard Deployment", d.Get("servicenow_extension_settings.0.standard_change_template_name"))
+
+	// settings removed in Octopus are removed from state
+	project.ExtensionSettings = nil
+	require.NoError(t, setProject(context.Background(), d, project))
+	require.Empty(t, d.Get("servicenow_extension_settings"))
+}
+
+func TestExpandProjectJiraServiceManagementExtensionSettings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getProjectSchema(), map[string]interface{}{
+		"jira_service_management_extension_settings": []interface{}{
+			map[string]interface{}{
+				"connection_id":             "JiraServiceManagementConnections-1",
+				"is_enabled":                true,
+				"service_desk_project_name": "CHANGE",
+			},
+		},
+		"lifecycle_id":     "Lifecycles-1",
+		"name":             "Test",
+		"project_group_id": "ProjectGroups-1",
+	})
+
+	project := expandProject(context.Background(), d)
+	require.Len(t, project.ExtensionSettings, 1)
+
+	jiraServiceManagementExtensionSettings, ok := project.ExtensionSettings[0].(*projects.JiraServiceManagementExtensionSettings)
+	require.True(t, ok)
+	require.Equal(t, "JiraServiceManagementConnections-1", jiraServiceManagementExtensionSettings.ConnectionID())
+	require.True(t, jiraServiceManagementExtensionSettings.IsChangeControlled())
+	require.Equal(t, "CHANGE", jiraServiceManagementExtensionSettings.ServiceDeskProjectName)
+}
+
+func TestSetProjectJiraServiceManagementExtensionSettings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getProjectSchema(), map[string]interface{}{})
+	project := projects.NewProject("Test", "Lifecycles-1", "ProjectGroups-1")
+
+	project.ExtensionSettings = append(project.ExtensionSettings, projects.NewJiraServiceManagementExtensionSettings("JiraServiceManagementConnections-1", true, "CHANGE"))
+	require.NoError(t, setProject(context.Background(), d, project))
+	require.Equal(t, "JiraServiceManagementConnections-1", d.Get("jira_service_management_extension_settings.0.connection_id"))
+	require.Equal(t, "CHANGE", d.Get("jira_service_management_extension_settings.0.service_desk_project_name"))
+
+	// settings removed in Octopus are removed from state
+	project.ExtensionSettings = nil
+	require.NoError(t, setProject(context.Background(), d, project))
+	require.Empty(t, d.Get("jira_service_management_extension_settings"))
+}
+
+func TestProjectGitHubPersistenceSettings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getProjectSchema(), map[string]interface{}{
+		"git_github_persistence_settings": []interface{}{
+			map[string]interface{}{
+				"github_connection_id": "GitHubAppConnections-1",
+				"url":                  "https://github.com/example/repository.git",
+			},
+		},
+		"lifecycle_id":     "Lifecycles-1",
+		"name":             "Test",
+		"project_group_id": "ProjectGroups-1",
+	})
+
+	project := expandProject(context.Background(), d)
+	gitPersistenceSettings, ok := project.PersistenceSettings.(projects.GitPersistenceSettings)
+	require.True(t, ok)
+	require.Equal(t, prj.NewGitHubGitCredential("GitHubAppConnections-1"), gitPersistenceSettings.Credential())
+
+	persistenceSettings, err := json.Marshal(gitPersistenceSettings)
+	require.NoError(t, err)
+	require.Contains(t, string(persistenceSettings), `"Credentials":{"Type":"GitHub","Id":"GitHubAppConnections-1"}`)
+
+	// go-octopusdeploy drops the GitHub credential when it reads the project, so it is loaded separately
+	projectJSON := `{"Id":"Projects-1","SpaceId":"Spaces-1","Name":"Test","LifecycleId":"Lifecycles-1","ProjectGroupId":"ProjectGroups-1","PersistenceSettings":{"Type":"VersionControlled","Url":"https://github.com/example/repository.git","BasePath":".octopus","DefaultBranch":"main","ProtectedBranchNamePatterns":[],"Credentials":{"Type":"GitHub","Id":"GitHubAppConnections-1"}},"Links":{}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api":
+			w.Write([]byte(`{"Links":{}}`))
+		case "/api/Spaces-1":
+			w.Write([]byte(`{"Id":"Spaces-1","Links":{}}`))
+		case "/api/Spaces-1/projects/Projects-1":
+			w.Write([]byte(projectJSON))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"ErrorMessage":"Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+
+	var readProject *projects.Project
+	require.NoError(t, json.Unmarshal([]byte(projectJSON), &readProject))
+	require.Nil(t, readProject.PersistenceSettings.(projects.GitPersistenceSettings).Credential())
+	require.NoError(t, prj.LoadGitHubGitCredential(octopus, readProject))
+
+	d = schema.TestResourceDataRaw(t, getProjectSchema(), map[string]interface{}{})
+	require.NoError(t, setProject(context.Background(), d, readProject))
+	require.Equal(t, "GitHubAppConnections-1", d.Get("git_github_persistence_settings.0.github_connection_id"))
+	require.Equal(t, "https://github.com/example/repository.git", d.Get("git_github_persistence_settings.0.url"))
+	require.Empty(t, d.Get("git_library_persistence_settings"))
+}
+
+func TestProjectSlug(t *testing.T) {
+	resource := resourceProject()
+	state := &terraform.InstanceState{
+		ID: "Projects-1",
+		Attributes: map[string]string{
+			"id":               "Projects-1",
+			"lifecycle_id":     "Lifecycles-1",
+			"name":             "Web",
+			"project_group_id": "ProjectGroups-1",
+			"slug":             "web",
+		},
+	}
+	config := map[string]interface{}{
+		"lifecycle_id":     "Lifecycles-1",
+		"name":             "Website",
+		"project_group_id": "ProjectGroups-1",
+	}
+
+	// the slug is kept when the project is renamed
+	diff, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+	require.NotContains(t, diff.Attributes, "slug")
+	require.False(t, diff.RequiresNew())
+
+	// changing the slug updates the project in place
+	config["slug"] = "website"
+	diff, err = resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+	require.Equal(t, "website", diff.Attributes["slug"].New)
+	require.False(t, diff.RequiresNew())
+
+	d := schema.TestResourceDataRaw(t, getProjectSchema(), config)
+	require.Equal(t, "website", expandProject(context.Background(), d).Slug)
+
+	// slugs that are not in the form that Octopus derives are warned of rather than rejected
+	for slug, severity := range map[string]diag.Severity{
+		"website":    -1,
+		"web-site-2": -1,
+		"Website":    diag.Warning,
+		"web--site":  diag.Warning,
+		"-website":   diag.Warning,
+		"":           diag.Error,
+		" ":          diag.Error,
+	} {
+		diags := resource.Schema["slug"].ValidateDiagFunc(slug, cty.Path{})
+		if severity < 0 {
+			require.Empty(t, diags, slug)
+			continue
+		}
+		require.Len(t, diags, 1, slug)
+		require.Equal(t, severity, diags[0].Severity, slug)
+	}
+}
+
+func TestVersionedGitPersistencePassword(t *testing.T) {
+	d := resourceProject().Data(&terraform.InstanceState{
+		ID: "Projects-1",
+		Attributes: map[string]string{
+			"git_username_password_persistence_settings.#":                  "1",
+			"git_username_password_persistence_settings.0.base_path":        ".octopus",
+			"git_username_password_persistence_settings.0.default_branch":   "main",
+			"git_username_password_persistence_settings.0.password_version": "1",
+			"git_username_password_persistence_settings.0.url":              "https://example.com/repository.git",
+			"git_username_password_persistence_settings.0.username":         "octopus",
+			"lifecycle_id":     "Lifecycles-1",
+			"name":             "Test",
+			"project_group_id": "ProjectGroups-1",
+		},
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"git_username_password_persistence_settings": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{"password": cty.StringVal("secret")}),
+			}),
+		}),
+	})
+	require.True(t, suppressVersionedSecretDiff(gitPersistencePasswordKey, "", "secret", d))
+
+	// the password is sent from the configuration, since it is not stored in state
+	project := expandProject(context.Background(), d)
+	credential := project.PersistenceSettings.(projects.GitPersistenceSettings).Credential().(*credentials.UsernamePassword)
+	require.Equal(t, "secret", *credential.Password.NewValue)
+
+	// the version is kept in state, but the password is not
+	require.NoError(t, setProject(context.Background(), d, project))
+	require.Equal(t, "1", d.Get("git_username_password_persistence_settings.0.password_version"))
+	require.Empty(t, d.Get(gitPersistencePasswordKey))
+	require.Equal(t, "octopus", d.Get("git_username_password_persistence_settings.0.username"))
+}
+
+func TestProjectCreateFromClone(t *testing.T) {
+	var cloneQuery url.Values
+	var cloneRequest, updateRequest map[string]interface{}
+	clonedProjectJSON := `{"Id":"Projects-2","SpaceId":"Spaces-1","Name":"Payments","Slug":"payments","LifecycleId":"Lifecycles-1","ProjectGroupId":"ProjectGroups-2","ClonedFromProjectId":"Projects-1","DeploymentProcessId":"deploymentprocess-Projects-2","VariableSetId":"variableset-Projects-2","IncludedLibraryVariableSetIds":["LibraryVariableSets-1"],"Links":{"Self":"/api/Spaces-1/projects/Projects-2"}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api":
+			w.Write([]byte(`{"Links":{}}`))
+		case r.URL.Path == "/api/Spaces-1":
+			w.Write([]byte(`{"Id":"Spaces-1","Links":{"Projects":"/api/Spaces-1/projects{/id}{?name,skip,ids,clone,take,partialName,clonedFromProjectId}"}}`))
+		case r.URL.Path == "/api/Spaces-1/projects/Projects-1":
+			w.Write([]byte(`{"Id":"Projects-1","SpaceId":"Spaces-1","Name":"Template","LifecycleId":"Lifecycles-1","ProjectGroupId":"ProjectGroups-1","Links":{}}`))
+		case r.URL.Path == "/api/Spaces-1/projects" && r.Method == http.MethodPost:
+			cloneQuery = r.URL.Query()
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&cloneRequest))
+			w.Write([]byte(clonedProjectJSON))
+		case r.URL.Path == "/api/Spaces-1/projects/Projects-2" && r.Method == http.MethodPut:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&updateRequest))
+			updateRequest["Links"] = map[string]interface{}{}
+			json.NewEncoder(w).Encode(updateRequest)
+		case r.URL.Path == "/api/Spaces-1/projects/Projects-2":
+			json.NewEncoder(w).Encode(updateRequest)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"ErrorMessage":"Not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	apiURL, _ := url.Parse(server.URL)
+	octopus, err := client.NewClient(nil, apiURL, "API-TEST", "Spaces-1")
+	require.NoError(t, err)
+	meta := newProviderMeta(octopus)
+
+	d := resourceProject().TestResourceData()
+	d.Set("clone_from_project_id", "Projects-1")
+	d.Set("description", "The payments service.")
+	d.Set("lifecycle_id", "Lifecycles-1")
+	d.Set("name", "Payments")
+	d.Set("project_group_id", "ProjectGroups-2")
+	d.Set("tenanted_deployment_participation", "Tenanted")
+
+	require.False(t, resourceProjectCreate(context.Background(), d, meta).HasError())
+	require.Equal(t, "Projects-1", cloneQuery.Get("clone"))
+	require.Equal(t, "Payments", cloneRequest["Name"])
+	require.Equal(t, "The payments service.", cloneRequest["Description"])
+	require.Equal(t, "ProjectGroups-2", cloneRequest["ProjectGroupID"])
+
+	// the clone is updated with the configured settings, keeping what was cloned
+	require.Equal(t, "Tenanted", updateRequest["TenantedDeploymentMode"])
+	require.Equal(t, "deploymentprocess-Projects-2", updateRequest["DeploymentProcessId"])
+	require.Equal(t, []interface{}{"LibraryVariableSets-1"}, updateRequest["IncludedLibraryVariableSetIds"])
+	require.Equal(t, "payments", updateRequest["Slug"])
+
+	require.Equal(t, "Projects-2", d.Id())
+	require.Equal(t, "Projects-1", d.Get("cloned_from_project_id"))
+	require.Equal(t, "Projects-1", d.Get("clone_from_project_id"))
+}
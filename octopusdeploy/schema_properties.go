@@ -1,7 +1,12 @@
 package octopusdeploy
 
 import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func expandProperties(propertyValues interface{}) map[string]core.PropertyValue {
@@ -28,3 +33,46 @@ func flattenProperties(propertyValues map[string]core.PropertyValue) map[string]
 	}
 	return flattenedProperties
 }
+
+// normalizeJSON returns a JSON object or array with its whitespace removed and the keys of its objects sorted, so
+// that documents that differ only in their formatting can be compared. Values that are not JSON objects or arrays
+// are not normalized.
+func normalizeJSON(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	if !strings.HasPrefix(value, "{") && !strings.HasPrefix(value, "[") {
+		return "", false
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil || decoder.More() {
+		return "", false
+	}
+
+	var normalized bytes.Buffer
+	encoder := json.NewEncoder(&normalized)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(document); err != nil {
+		return "", false
+	}
+	return strings.TrimSpace(normalized.String()), true
+}
+
+// suppressEquivalentJSONPropertyDiff suppresses the diff of a property whose old and new values are equivalent JSON
+// documents, such as the containers of a Kubernetes step or the inputs of a step package. Octopus may hold these with
+// a different formatting and key order than the configuration, e.g. once the step has been edited in the portal.
+func suppressEquivalentJSONPropertyDiff(k, old, new string, d *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") {
+		return false
+	}
+
+	normalizedOld, ok := normalizeJSON(old)
+	if !ok {
+		return false
+	}
+
+	normalizedNew, ok := normalizeJSON(new)
+	return ok && normalizedOld == normalizedNew
+}
@@ -0,0 +1,39 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeJSON(t *testing.T) {
+	normalized, ok := normalizeJSON(`
+		{
+			"name": "web",
+			"ports": [{ "value": 80 }, { "value": 443 }],
+			"image": "nginx:#{Version}"
+		}`)
+	require.True(t, ok)
+	require.Equal(t, `{"image":"nginx:#{Version}","name":"web","ports":[{"value":80},{"value":443}]}`, normalized)
+
+	// large numbers keep their precision
+	normalized, ok = normalizeJSON(`[12345678901234567890, 1.50]`)
+	require.True(t, ok)
+	require.Equal(t, `[12345678901234567890,1.50]`, normalized)
+
+	for _, value := range []string{"", "True", "80", `"quoted"`, "{ not json }", `{"a": 1} {"b": 2}`} {
+		_, ok := normalizeJSON(value)
+		require.False(t, ok, value)
+	}
+}
+
+func TestSuppressEquivalentJSONPropertyDiff(t *testing.T) {
+	k := "step.0.action.0.properties.Octopus.Action.KubernetesContainers.Containers"
+
+	require.True(t, suppressEquivalentJSONPropertyDiff(k, `[{"Name":"web","Image":"nginx"}]`, "[\n  {\n    \"Image\": \"nginx\",\n    \"Name\": \"web\"\n  }\n]", nil))
+	require.False(t, suppressEquivalentJSONPropertyDiff(k, `[{"Name":"web","Image":"nginx"}]`, `[{"Name":"web","Image":"httpd"}]`, nil))
+	require.False(t, suppressEquivalentJSONPropertyDiff(k, `[{"Name":"web"},{"Name":"api"}]`, `[{"Name":"api"},{"Name":"web"}]`, nil))
+	require.False(t, suppressEquivalentJSONPropertyDiff(k, "", `{}`, nil))
+	require.False(t, suppressEquivalentJSONPropertyDiff("step.0.action.0.properties.Octopus.Action.RunOnServer", "true", "True", nil))
+	require.False(t, suppressEquivalentJSONPropertyDiff("step.0.action.0.properties.%", "1", "1", nil))
+}
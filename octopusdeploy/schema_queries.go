@@ -229,6 +229,15 @@ func getQueryProjectID() *schema.Schema {
 	}
 }
 
+func getQueryProjects() *schema.Schema {
+	return &schema.Schema{
+		Description: "A filter to search by a list of project IDs.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Type:        schema.TypeList,
+	}
+}
+
 func getQueryRoles() *schema.Schema {
 	return &schema.Schema{
 		Description: "A filter to search by a list of role IDs.",
@@ -254,9 +263,17 @@ func getQuerySkip() *schema.Schema {
 	}
 }
 
+func getQuerySpaceID() *schema.Schema {
+	return &schema.Schema{
+		Description: "The space ID to search in. Defaults to the space of the provider.",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+}
+
 func getQueryTags() *schema.Schema {
 	return &schema.Schema{
-		Description: "A filter to search by a list of tags.",
+		Description: "A filter to search by a list of tenant tags, given by their canonical names (e.g. `Regions/North America`). Tenants with any of the tags of a tag set match that tag set, and tenants must match every tag set in the list.",
 		Elem:        &schema.Schema{Type: schema.TypeString},
 		Optional:    true,
 		Type:        schema.TypeList,
@@ -271,6 +288,31 @@ func getQueryTake() *schema.Schema {
 	}
 }
 
+func getQueryTakeAll() *schema.Schema {
+	return &schema.Schema{
+		Description: "A filter to specify the number of items to take (or return) in the response. If not set, all of the items that match the filter(s) are returned.",
+		Type:        schema.TypeInt,
+		Optional:    true,
+	}
+}
+
+func getQueryTaskState() *schema.Schema {
+	return &schema.Schema{
+		Description: "A filter to search by the state of the task. Valid task states are `Canceled`, `Cancelling`, `Executing`, `Failed`, `Queued`, `Success`, or `TimedOut`.",
+		Optional:    true,
+		Type:        schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+			"Canceled",
+			"Cancelling",
+			"Executing",
+			"Failed",
+			"Queued",
+			"Success",
+			"TimedOut",
+		}, false)),
+	}
+}
+
 func getQueryTenant() *schema.Schema {
 	return &schema.Schema{
 		Description: "A filter to search by a tenant ID.",
@@ -0,0 +1,161 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/releases"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandRelease(d *schema.ResourceData) *releases.Release {
+	release := releases.NewRelease(d.Get("channel_id").(string), d.Get("project_id").(string), d.Get("version").(string))
+	release.ID = d.Id()
+	release.IgnoreChannelRules = d.Get("ignore_channel_rules").(bool)
+	release.ReleaseNotes = d.Get("release_notes").(string)
+	release.SpaceID = d.Get("space_id").(string)
+
+	if v, ok := d.GetOk("package"); ok {
+		release.SelectedPackages = expandSelectedPackages(v.(*schema.Set))
+	}
+
+	return release
+}
+
+func expandSelectedPackages(v *schema.Set) []*packages.SelectedPackage {
+	selectedPackages := []*packages.SelectedPackage{}
+	for _, p := range v.List() {
+		packageMap := p.(map[string]interface{})
+		selectedPackages = append(selectedPackages, &packages.SelectedPackage{
+			ActionName:           packageMap["action_name"].(string),
+			PackageReferenceName: packageMap["package_reference_name"].(string),
+			Version:              packageMap["version"].(string),
+		})
+	}
+
+	return selectedPackages
+}
+
+func flattenSelectedPackages(selectedPackages []*packages.SelectedPackage) []interface{} {
+	flattenedPackages := []interface{}{}
+	for _, selectedPackage := range selectedPackages {
+		if selectedPackage == nil {
+			continue
+		}
+
+		flattenedPackages = append(flattenedPackages, map[string]interface{}{
+			"action_name":            selectedPackage.ActionName,
+			"package_reference_name": selectedPackage.PackageReferenceName,
+			"version":                selectedPackage.Version,
+		})
+	}
+
+	return flattenedPackages
+}
+
+func getReleaseSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"channel_id": {
+			Computed:    true,
+			Description: "The ID of the channel of the release. Defaults to the default channel of the project.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"id": getIDSchema(),
+		"ignore_channel_rules": {
+			Default:     false,
+			Description: "Whether the version rules of the channel are ignored when the package versions are selected.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"package": {
+			Computed:    true,
+			Description: "The version of a package of the deployment process to include in the release. A version must be given for every package of the deployment process.",
+			Elem:        getSelectedPackageSchema(),
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeSet,
+		},
+		"project_deployment_process_snapshot_id": {
+			Computed:    true,
+			Description: "The ID of the snapshot of the deployment process taken when the release was created.",
+			Type:        schema.TypeString,
+		},
+		"project_id": {
+			Description:      "The ID of the project of the release.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"project_variable_set_snapshot_id": {
+			Computed:    true,
+			Description: "The ID of the snapshot of the project variables taken when the release was created.",
+			Type:        schema.TypeString,
+		},
+		"release_notes": {
+			Description: "The release notes of the release, in Markdown.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"space_id": {
+			Computed:    true,
+			Description: "The space ID associated with this release.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"version": {
+			Description:      "The version number of the release (e.g. `1.2.3`).",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+	}
+}
+
+// getSelectedPackageSchema returns the schema of the version of a package that is selected for a release or runbook
+// snapshot.
+func getSelectedPackageSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"action_name": {
+				Description:      "The name of the deployment action that references the package.",
+				Required:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+			"package_reference_name": {
+				Description: "The name of the package reference within the deployment action. Empty for the primary package.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"version": {
+				Description:      "The version of the package.",
+				Required:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+		},
+	}
+}
+
+func setRelease(d *schema.ResourceData, release *releases.Release) error {
+	d.Set("channel_id", release.ChannelID)
+	d.Set("ignore_channel_rules", release.IgnoreChannelRules)
+	d.Set("project_deployment_process_snapshot_id", release.ProjectDeploymentProcessSnapshotID)
+	d.Set("project_id", release.ProjectID)
+	d.Set("project_variable_set_snapshot_id", release.ProjectVariableSetSnapshotID)
+	d.Set("release_notes", release.ReleaseNotes)
+	d.Set("space_id", release.SpaceID)
+	d.Set("version", release.Version)
+
+	if err := d.Set("package", flattenSelectedPackages(release.SelectedPackages)); err != nil {
+		return fmt.Errorf("error setting package: %s", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,9 @@
 package octopusdeploy
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -10,7 +13,11 @@ func expandReleaseCreationStrategy(releaseCreationStrategy []interface{}) *proje
 		return nil
 	}
 
-	releaseCreationStrategyMap := releaseCreationStrategy[0].(map[string]interface{})
+	releaseCreationStrategyMap, ok := releaseCreationStrategy[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
 	return &projects.ReleaseCreationStrategy{
 		ChannelID:                    releaseCreationStrategyMap["channel_id"].(string),
 		ReleaseCreationPackage:       expandDeploymentActionPackage(releaseCreationStrategyMap["release_creation_package"]),
@@ -33,19 +40,42 @@ func flattenReleaseCreationStrategy(releaseCreationStrategy *projects.ReleaseCre
 func getReleaseCreationStrategySchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"channel_id": {
-			Optional: true,
-			Type:     schema.TypeString,
+			Description: "The ID of the channel that automatically created releases are created in. The default channel is used when omitted.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"release_creation_package": {
-			Computed: true,
-			Optional: true,
-			Elem:     &schema.Resource{Schema: getDeploymentActionPackageSchema()},
-			MaxItems: 1,
-			Type:     schema.TypeList,
+			Computed:    true,
+			Description: "The package whose pushes to the built-in feed trigger the creation of a release.",
+			Optional:    true,
+			Elem:        &schema.Resource{Schema: getDeploymentActionPackageSchema()},
+			MaxItems:    1,
+			Type:        schema.TypeList,
 		},
 		"release_creation_package_step_id": {
-			Optional: true,
-			Type:     schema.TypeString,
+			Description: "The ID of the step whose package triggers the creation of a release. Superseded by `release_creation_package`.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 	}
 }
+
+// validateAutoCreateRelease ensures a release creation package is configured whenever automatic release creation is
+// enabled, which Octopus otherwise only rejects once the project is saved
+func validateAutoCreateRelease(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.Get("auto_create_release").(bool) {
+		return nil
+	}
+
+	releaseCreationStrategy := expandReleaseCreationStrategy(d.Get("release_creation_strategy").([]interface{}))
+	if releaseCreationStrategy != nil && (releaseCreationStrategy.ReleaseCreationPackage != nil || len(releaseCreationStrategy.ReleaseCreationPackageStepID) > 0) {
+		return nil
+	}
+
+	// the strategy may still be unknown, e.g. when it refers to a step that has not been created yet
+	if !d.NewValueKnown("release_creation_strategy") {
+		return nil
+	}
+
+	return fmt.Errorf("auto_create_release requires a release_creation_strategy with a release_creation_package")
+}
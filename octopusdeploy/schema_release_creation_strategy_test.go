@@ -0,0 +1,33 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandReleaseCreationStrategy(t *testing.T) {
+	require.Nil(t, expandReleaseCreationStrategy(nil))
+	require.Nil(t, expandReleaseCreationStrategy([]interface{}{}))
+	require.Nil(t, expandReleaseCreationStrategy([]interface{}{nil}))
+
+	releaseCreationStrategy := expandReleaseCreationStrategy([]interface{}{
+		map[string]interface{}{
+			"channel_id": "Channels-1",
+			"release_creation_package": []interface{}{
+				map[string]interface{}{
+					"deployment_action": "Deploy Web App",
+					"package_reference": "",
+				},
+			},
+			"release_creation_package_step_id": "",
+		},
+	})
+	require.NotNil(t, releaseCreationStrategy)
+	require.Equal(t, "Channels-1", releaseCreationStrategy.ChannelID)
+	require.Equal(t, "Deploy Web App", releaseCreationStrategy.ReleaseCreationPackage.DeploymentAction)
+
+	flattenedReleaseCreationStrategy := flattenReleaseCreationStrategy(releaseCreationStrategy)
+	require.Len(t, flattenedReleaseCreationStrategy, 1)
+	require.Equal(t, "Channels-1", flattenedReleaseCreationStrategy[0].(map[string]interface{})["channel_id"])
+}
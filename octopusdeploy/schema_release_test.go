@@ -0,0 +1,54 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandRelease(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getReleaseSchema(), map[string]interface{}{
+		"channel_id":    "Channels-1",
+		"project_id":    "Projects-1",
+		"release_notes": "Fixes the login page",
+		"version":       "1.2.3",
+		"package": []interface{}{
+			map[string]interface{}{
+				"action_name": "Deploy web app",
+				"version":     "1.2.3",
+			},
+			map[string]interface{}{
+				"action_name":            "Deploy web app",
+				"package_reference_name": "config",
+				"version":                "0.4.0",
+			},
+		},
+	})
+
+	release := expandRelease(d)
+	require.Equal(t, "Channels-1", release.ChannelID)
+	require.Equal(t, "Projects-1", release.ProjectID)
+	require.Equal(t, "Fixes the login page", release.ReleaseNotes)
+	require.Equal(t, "1.2.3", release.Version)
+	require.False(t, release.IgnoreChannelRules)
+	require.Len(t, release.SelectedPackages, 2)
+
+	versions := map[string]string{}
+	for _, selectedPackage := range release.SelectedPackages {
+		require.Equal(t, "Deploy web app", selectedPackage.ActionName)
+		versions[selectedPackage.PackageReferenceName] = selectedPackage.Version
+	}
+	require.Equal(t, map[string]string{"": "1.2.3", "config": "0.4.0"}, versions)
+
+	release.ID = "Releases-1"
+	release.SpaceID = "Spaces-1"
+	release.ProjectDeploymentProcessSnapshotID = "deploymentprocess-Projects-1-s-1"
+
+	state := schema.TestResourceDataRaw(t, getReleaseSchema(), map[string]interface{}{})
+	require.NoError(t, setRelease(state, release))
+	require.Equal(t, "Spaces-1", state.Get("space_id"))
+	require.Equal(t, "deploymentprocess-Projects-1-s-1", state.Get("project_deployment_process_snapshot_id"))
+	require.Equal(t, 2, state.Get("package").(*schema.Set).Len())
+	require.True(t, state.Get("package").(*schema.Set).Equal(d.Get("package")))
+}
@@ -1,7 +1,12 @@
 package octopusdeploy
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -14,11 +19,15 @@ func expandRetentionPeriod(flattenedRetentionPeriod interface{}) *core.Retention
 	retentionPeriodProperties := flattenedRetentionPeriod.([]interface{})
 	if len(retentionPeriodProperties) == 1 {
 		retentionPeriodMap := retentionPeriodProperties[0].(map[string]interface{})
-		return core.NewRetentionPeriod(
-			int32(retentionPeriodMap["quantity_to_keep"].(int)),
-			retentionPeriodMap["unit"].(string),
-			retentionPeriodMap["should_keep_forever"].(bool),
-		)
+		quantityToKeep := int32(retentionPeriodMap["quantity_to_keep"].(int))
+		shouldKeepForever := retentionPeriodMap["should_keep_forever"].(bool)
+
+		// Octopus keeps items forever only when there is no quantity to keep
+		if shouldKeepForever {
+			quantityToKeep = 0
+		}
+
+		return core.NewRetentionPeriod(quantityToKeep, retentionPeriodMap["unit"].(string), shouldKeepForever)
 	}
 
 	return nil
@@ -36,7 +45,8 @@ func getRetentionPeriodSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"quantity_to_keep": {
 			Default:          30,
-			Description:      "The number of days/releases to keep. The default value is `30`. If `0` then all are kept.",
+			Description:      "The number of days/releases to keep. The default value is `30`. If `0` then all are kept. Must be `0`, or left unset, when `should_keep_forever` is `true`.",
+			DiffSuppressFunc: suppressQuantityToKeepDiff,
 			Optional:         true,
 			Type:             schema.TypeInt,
 			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
@@ -59,3 +69,76 @@ func getRetentionPeriodSchema() map[string]*schema.Schema {
 		},
 	}
 }
+
+// suppressQuantityToKeepDiff ignores the quantity to keep of a retention policy that keeps items forever, as Octopus
+// stores its quantity as 0 regardless of the configured (or default) value.
+func suppressQuantityToKeepDiff(k, old, new string, d *schema.ResourceData) bool {
+	shouldKeepForever, ok := d.Get(strings.TrimSuffix(k, "quantity_to_keep") + "should_keep_forever").(bool)
+	return ok && shouldKeepForever
+}
+
+// validateRetentionPolicies ensures that the retention policies of a lifecycle, and of its phases, do not have a
+// quantity to keep when they keep items forever. Only the configuration is inspected so that the default quantity to
+// keep is not reported.
+func validateRetentionPolicies(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() {
+		return nil
+	}
+
+	if err := validateRetentionPolicyConfig(rawConfig, "release_retention_policy"); err != nil {
+		return err
+	}
+
+	if err := validateRetentionPolicyConfig(rawConfig, "tentacle_retention_policy"); err != nil {
+		return err
+	}
+
+	phases := rawConfig.GetAttr("phase")
+	if phases.IsNull() || !phases.IsKnown() {
+		return nil
+	}
+
+	for i, phase := range phases.AsValueSlice() {
+		if !phase.IsKnown() || phase.IsNull() {
+			continue
+		}
+
+		if err := validateRetentionPolicyConfig(phase, "release_retention_policy"); err != nil {
+			return fmt.Errorf("phase %d: %s", i, err)
+		}
+
+		if err := validateRetentionPolicyConfig(phase, "tentacle_retention_policy"); err != nil {
+			return fmt.Errorf("phase %d: %s", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateRetentionPolicyConfig(config cty.Value, key string) error {
+	retentionPolicies := config.GetAttr(key)
+	if retentionPolicies.IsNull() || !retentionPolicies.IsKnown() {
+		return nil
+	}
+
+	for _, retentionPolicy := range retentionPolicies.AsValueSlice() {
+		if !retentionPolicy.IsKnown() || retentionPolicy.IsNull() {
+			continue
+		}
+
+		shouldKeepForever := retentionPolicy.GetAttr("should_keep_forever")
+		quantityToKeep := retentionPolicy.GetAttr("quantity_to_keep")
+		if shouldKeepForever.IsNull() || !shouldKeepForever.IsKnown() || shouldKeepForever.False() {
+			continue
+		}
+
+		if quantityToKeep.IsNull() || !quantityToKeep.IsKnown() || quantityToKeep.Equals(cty.Zero).True() {
+			continue
+		}
+
+		return fmt.Errorf("%s must have a quantity_to_keep of 0 when should_keep_forever is true", key)
+	}
+
+	return nil
+}
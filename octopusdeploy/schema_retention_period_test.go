@@ -0,0 +1,64 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandRetentionPeriodThatKeepsForever(t *testing.T) {
+	retentionPeriod := expandRetentionPeriod([]interface{}{map[string]interface{}{
+		"quantity_to_keep":    30,
+		"should_keep_forever": true,
+		"unit":                "Days",
+	}})
+	require.Equal(t, core.NewRetentionPeriod(0, "Days", true), retentionPeriod)
+
+	retentionPeriod = expandRetentionPeriod([]interface{}{map[string]interface{}{
+		"quantity_to_keep":    3,
+		"should_keep_forever": false,
+		"unit":                "Items",
+	}})
+	require.Equal(t, core.NewRetentionPeriod(3, "Items", false), retentionPeriod)
+}
+
+func TestValidateRetentionPolicyConfig(t *testing.T) {
+	config := func(quantityToKeep cty.Value, shouldKeepForever cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"release_retention_policy": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"quantity_to_keep":    quantityToKeep,
+				"should_keep_forever": shouldKeepForever,
+				"unit":                cty.NullVal(cty.String),
+			})}),
+		})
+	}
+
+	require.NoError(t, validateRetentionPolicyConfig(cty.ObjectVal(map[string]cty.Value{
+		"release_retention_policy": cty.NullVal(cty.List(cty.DynamicPseudoType)),
+	}), "release_retention_policy"))
+	require.NoError(t, validateRetentionPolicyConfig(config(cty.NullVal(cty.Number), cty.True), "release_retention_policy"))
+	require.NoError(t, validateRetentionPolicyConfig(config(cty.Zero, cty.True), "release_retention_policy"))
+	require.NoError(t, validateRetentionPolicyConfig(config(cty.NumberIntVal(30), cty.False), "release_retention_policy"))
+	require.NoError(t, validateRetentionPolicyConfig(config(cty.NumberIntVal(30), cty.NullVal(cty.Bool)), "release_retention_policy"))
+	require.NoError(t, validateRetentionPolicyConfig(config(cty.UnknownVal(cty.Number), cty.True), "release_retention_policy"))
+	require.Error(t, validateRetentionPolicyConfig(config(cty.NumberIntVal(1), cty.True), "release_retention_policy"))
+}
+
+func TestSuppressQuantityToKeepDiff(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getLifecycleSchema(), map[string]interface{}{
+		"name": "Lifecycle",
+		"phase": []interface{}{map[string]interface{}{
+			"name":                     "Production",
+			"release_retention_policy": flattenRetentionPeriod(core.NewRetentionPeriod(30, "Days", true)),
+		}},
+		"release_retention_policy":  flattenRetentionPeriod(core.NewRetentionPeriod(30, "Days", true)),
+		"tentacle_retention_policy": flattenRetentionPeriod(core.NewRetentionPeriod(30, "Days", false)),
+	})
+
+	require.True(t, suppressQuantityToKeepDiff("release_retention_policy.0.quantity_to_keep", "0", "30", d))
+	require.True(t, suppressQuantityToKeepDiff("phase.0.release_retention_policy.0.quantity_to_keep", "0", "30", d))
+	require.False(t, suppressQuantityToKeepDiff("tentacle_retention_policy.0.quantity_to_keep", "0", "30", d))
+}
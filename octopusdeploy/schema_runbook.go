@@ -103,14 +103,15 @@ func getRunbookSchema() map[string]*schema.Schema {
 		},
 		"multi_tenancy_mode": getTenantedDeploymentSchema(),
 		"connectivity_policy": {
-			Computed: true,
-			Elem:     &schema.Resource{Schema: getConnectivityPolicySchema()},
-			MaxItems: 1,
-			Optional: true,
-			Type:     schema.TypeList,
+			Computed:    true,
+			Description: "Controls how the runbook behaves when deployment targets are unavailable or unhealthy.",
+			Elem:        &schema.Resource{Schema: getConnectivityPolicySchema()},
+			MaxItems:    1,
+			Optional:    true,
+			Type:        schema.TypeList,
 		},
 		"environment_scope": {
-			Description: "Determines how the runbook is scoped to environments.",
+			Description: "Determines how the runbook is scoped to environments, one of `All`, `Specified` or `FromProjectLifecycles`.",
 			Computed:    true,
 			Optional:    true,
 			Type:        schema.TypeString,
@@ -125,10 +126,10 @@ func getRunbookSchema() map[string]*schema.Schema {
 			Computed:    true,
 			Optional:    true,
 			Elem:        &schema.Schema{Type: schema.TypeString},
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 		},
 		"default_guided_failure_mode": {
-			Description: "Sets the runbook guided failure mode.",
+			Description: "Sets the runbook guided failure mode, one of `EnvironmentDefault`, `Off` or `On`.",
 			Computed:    true,
 			Optional:    true,
 			Type:        schema.TypeString,
@@ -139,7 +140,7 @@ func getRunbookSchema() map[string]*schema.Schema {
 			}, false)),
 		},
 		"retention_policy": {
-			Description: "Sets the runbook retention policy",
+			Description: "Sets how many runs of this runbook are kept per environment. Runs are retained by count; Octopus does not support a time-based unit for runbook retention.",
 			Computed:    true,
 			DefaultFunc: func() (interface{}, error) {
 				return flattenRunbookRetentionPeriod(&runbooks.RunbookRetentionPeriod{
@@ -178,5 +179,9 @@ func setRunbook(ctx context.Context, d *schema.ResourceData, runbook *runbooks.R
 	d.Set("default_guided_failure_mode", runbook.DefaultGuidedFailureMode)
 	d.Set("force_package_download", runbook.ForcePackageDownload)
 
+	if err := d.Set("retention_policy", flattenRunbookRetentionPeriod(runbook.RunRetentionPolicy)); err != nil {
+		return fmt.Errorf("error setting retention_policy: %s", err)
+	}
+
 	return nil
 }
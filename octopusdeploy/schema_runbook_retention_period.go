@@ -24,6 +24,10 @@ func expandRunbookRetentionPolicy(flattenedRetentionPeriod interface{}) *runbook
 }
 
 func flattenRunbookRetentionPeriod(r *runbooks.RunbookRetentionPeriod) []interface{} {
+	if r == nil {
+		return nil
+	}
+
 	retentionPeriod := make(map[string]interface{})
 	retentionPeriod["quantity_to_keep"] = int(r.QuantityToKeep)
 	retentionPeriod["should_keep_forever"] = r.ShouldKeepForever
@@ -0,0 +1,87 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actions"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/triggers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandRunbookScheduledTrigger(d *schema.ResourceData, project *projects.Project) (*triggers.ProjectTrigger, error) {
+	filter, err := expandTriggerSchedule(d)
+	if err != nil {
+		return nil, err
+	}
+
+	action := actions.NewRunRunbookAction()
+	action.Environments = getSliceFromTerraformTypeList(d.Get("environment_ids"))
+	action.Runbook = d.Get("runbook_id").(string)
+	action.Tenants = getSliceFromTerraformTypeList(d.Get("tenant_ids"))
+	action.TenantTags = getSliceFromTerraformTypeList(d.Get("tenant_tags"))
+
+	projectTrigger := triggers.NewProjectTrigger(d.Get("name").(string), d.Get("description").(string), d.Get("is_disabled").(bool), project, action, filter)
+
+	// the description is not set by the constructor
+	projectTrigger.Description = d.Get("description").(string)
+	projectTrigger.ID = d.Id()
+
+	return projectTrigger, nil
+}
+
+func getRunbookScheduledTriggerSchema() map[string]*schema.Schema {
+	triggerSchema := getTriggerScheduleSchema()
+
+	triggerSchema["environment_ids"] = &schema.Schema{
+		Description: "The IDs of the environments to run the runbook in.",
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		MinItems: 1,
+		Required: true,
+		Type:     schema.TypeList,
+	}
+	triggerSchema["project_id"].Description = "The ID of the project that owns the runbook."
+	triggerSchema["runbook_id"] = &schema.Schema{
+		Description:      "The ID of the runbook to run. The runbook must have a published snapshot.",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+	}
+	triggerSchema["tenant_ids"] = &schema.Schema{
+		Description: "The IDs of the tenants to run the runbook for.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Type:        schema.TypeList,
+	}
+	triggerSchema["tenant_tags"] = &schema.Schema{
+		Description: "The tenant tags of the tenants to run the runbook for, in the form of `TagSet/Tag`.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Type:        schema.TypeList,
+	}
+
+	return triggerSchema
+}
+
+func setRunbookScheduledTrigger(d *schema.ResourceData, projectTrigger *triggers.ProjectTrigger) error {
+	action, ok := projectTrigger.Action.(*actions.RunRunbookAction)
+	if !ok {
+		return fmt.Errorf("unsupported action for a runbook scheduled trigger: %T", projectTrigger.Action)
+	}
+
+	d.Set("description", projectTrigger.Description)
+	d.Set("environment_ids", action.Environments)
+	d.Set("is_disabled", projectTrigger.IsDisabled)
+	d.Set("name", projectTrigger.Name)
+	d.Set("project_id", projectTrigger.ProjectID)
+	d.Set("runbook_id", action.Runbook)
+	d.Set("space_id", projectTrigger.SpaceID)
+	d.Set("tenant_ids", action.Tenants)
+	d.Set("tenant_tags", action.TenantTags)
+
+	return flattenTriggerSchedule(d, projectTrigger.Filter)
+}
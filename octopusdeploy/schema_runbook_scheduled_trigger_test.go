@@ -0,0 +1,59 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actions"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/triggers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunbookScheduledTrigger(t *testing.T) {
+	project := projects.NewProject("Project", "Lifecycles-1", "ProjectGroups-1")
+	project.ID = "Projects-1"
+	project.SpaceID = "Spaces-1"
+
+	d := schema.TestResourceDataRaw(t, getRunbookScheduledTriggerSchema(), map[string]interface{}{
+		"environment_ids": []interface{}{"Environments-1", "Environments-2"},
+		"name":            "Nightly maintenance",
+		"project_id":      "Projects-1",
+		"runbook_id":      "Runbooks-1",
+		"tenant_tags":     []interface{}{"Region/West"},
+		"timezone":        "GMT Standard Time",
+		"once_daily_schedule": []interface{}{map[string]interface{}{
+			"days_of_week": []interface{}{"Sunday"},
+			"start_time":   "2024-01-01T01:00:00",
+		}},
+	})
+
+	projectTrigger, err := expandRunbookScheduledTrigger(d, project)
+	require.NoError(t, err)
+
+	action, ok := projectTrigger.Action.(*actions.RunRunbookAction)
+	require.True(t, ok)
+	require.Equal(t, actions.RunRunbook, action.GetActionType())
+	require.Equal(t, "Runbooks-1", action.Runbook)
+
+	// the trigger is read back through its JSON representation, as it is when returned by Octopus
+	b, err := json.Marshal(projectTrigger)
+	require.NoError(t, err)
+
+	var readProjectTrigger triggers.ProjectTrigger
+	require.NoError(t, json.Unmarshal(b, &readProjectTrigger))
+
+	state := schema.TestResourceDataRaw(t, getRunbookScheduledTriggerSchema(), map[string]interface{}{})
+	require.NoError(t, setRunbookScheduledTrigger(state, &readProjectTrigger))
+	require.Equal(t, []interface{}{"Environments-1", "Environments-2"}, state.Get("environment_ids"))
+	require.Equal(t, "Runbooks-1", state.Get("runbook_id"))
+	require.Equal(t, []interface{}{"Region/West"}, state.Get("tenant_tags"))
+	require.Empty(t, state.Get("tenant_ids"))
+	require.Equal(t, "GMT Standard Time", state.Get("timezone"))
+	require.Equal(t, "2024-01-01T01:00:00", state.Get("once_daily_schedule.0.start_time"))
+
+	// triggers that deploy releases are managed by octopusdeploy_project_scheduled_trigger
+	readProjectTrigger.Action = actions.NewDeployNewReleaseAction("Environments-1", "", nil)
+	require.Error(t, setRunbookScheduledTrigger(state, &readProjectTrigger))
+}
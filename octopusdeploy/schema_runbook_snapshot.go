@@ -0,0 +1,159 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbooks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// expandRunbookSnapshot creates the snapshot of a runbook from its snapshot template. Packages that are not configured
+// use the version selected for the last snapshot of the runbook.
+func expandRunbookSnapshot(d *schema.ResourceData, runbook *runbooks.Runbook, template *runbooks.RunbookSnapshotTemplate) (*runbooks.RunbookSnapshot, error) {
+	name := d.Get("name").(string)
+	if len(name) == 0 {
+		name = template.NextNameIncrement
+	}
+
+	runbookSnapshot := runbooks.NewRunbookSnapshot(name, runbook.ProjectID, runbook.GetID())
+	runbookSnapshot.Notes = d.Get("notes").(string)
+	runbookSnapshot.SpaceID = runbook.SpaceID
+
+	selectedPackages := []*packages.SelectedPackage{}
+	if v, ok := d.GetOk("package"); ok {
+		selectedPackages = expandSelectedPackages(v.(*schema.Set))
+	}
+
+	for _, templatePackage := range template.Packages {
+		selectedPackage := findSelectedPackage(selectedPackages, templatePackage.ActionName, templatePackage.PackageReferenceName)
+		if selectedPackage == nil {
+			if len(templatePackage.VersionSelectedLastRelease) == 0 {
+				return nil, fmt.Errorf("a version must be given for the package '%s' of the action '%s' of runbook %s, which has not been included in a snapshot before", templatePackage.PackageID, templatePackage.ActionName, runbook.GetID())
+			}
+
+			selectedPackage = &packages.SelectedPackage{
+				ActionName:           templatePackage.ActionName,
+				PackageReferenceName: templatePackage.PackageReferenceName,
+				Version:              templatePackage.VersionSelectedLastRelease,
+			}
+		}
+
+		runbookSnapshot.SelectedPackages = append(runbookSnapshot.SelectedPackages, selectedPackage)
+	}
+
+	for _, selectedPackage := range selectedPackages {
+		if findSelectedPackage(runbookSnapshot.SelectedPackages, selectedPackage.ActionName, selectedPackage.PackageReferenceName) != selectedPackage {
+			return nil, fmt.Errorf("the process of runbook %s has no package '%s' for the action '%s'", runbook.GetID(), selectedPackage.PackageReferenceName, selectedPackage.ActionName)
+		}
+	}
+
+	return runbookSnapshot, nil
+}
+
+func findSelectedPackage(selectedPackages []*packages.SelectedPackage, actionName string, packageReferenceName string) *packages.SelectedPackage {
+	for _, selectedPackage := range selectedPackages {
+		if selectedPackage.ActionName == actionName && selectedPackage.PackageReferenceName == packageReferenceName {
+			return selectedPackage
+		}
+	}
+	return nil
+}
+
+func getRunbookSnapshotSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"frozen_runbook_process_id": {
+			Computed:    true,
+			Description: "The ID of the snapshot of the runbook process taken when the snapshot was created.",
+			Type:        schema.TypeString,
+		},
+		"id": getIDSchema(),
+		"name": {
+			Computed:    true,
+			Description: "The name of the snapshot. Defaults to the next name suggested by Octopus.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"notes": {
+			Description: "The notes of the snapshot, in Markdown.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"package": {
+			Computed:    true,
+			Description: "The version of a package of the runbook process to include in the snapshot. Packages that are not given use the version included in the last snapshot of the runbook.",
+			Elem:        getSelectedPackageSchema(),
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeSet,
+		},
+		"project_id": {
+			Computed:    true,
+			Description: "The ID of the project of the runbook.",
+			Type:        schema.TypeString,
+		},
+		"project_variable_set_snapshot_id": {
+			Computed:    true,
+			Description: "The ID of the snapshot of the project variables taken when the snapshot was created.",
+			Type:        schema.TypeString,
+		},
+		"publish": {
+			Default:     true,
+			Description: "Whether the snapshot is published, making it the snapshot that is used when the runbook is run.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"runbook_id": {
+			Description:      "The ID of the runbook to take a snapshot of.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"space_id": {
+			Computed:    true,
+			Description: "The space ID associated with this runbook snapshot.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"triggers": {
+			Description: "Arbitrary values that take and publish a new snapshot when they change, such as the version of the runbook process.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeMap,
+		},
+	}
+}
+
+func setRunbookSnapshot(d *schema.ResourceData, runbookSnapshot *runbooks.RunbookSnapshot) error {
+	d.Set("frozen_runbook_process_id", runbookSnapshot.FrozenRunbookProcessID)
+	d.Set("name", runbookSnapshot.Name)
+	d.Set("notes", runbookSnapshot.Notes)
+	d.Set("project_id", runbookSnapshot.ProjectID)
+	d.Set("project_variable_set_snapshot_id", runbookSnapshot.ProjectVariableSetSnapshotID)
+	d.Set("runbook_id", runbookSnapshot.RunbookID)
+	d.Set("space_id", runbookSnapshot.SpaceID)
+
+	// only the packages that are configured are kept in state, so that the versions of other packages are not a change
+	selectedPackages := runbookSnapshot.SelectedPackages
+	if v, ok := d.GetOk("package"); ok {
+		configuredPackages := expandSelectedPackages(v.(*schema.Set))
+		selectedPackages = []*packages.SelectedPackage{}
+		for _, selectedPackage := range runbookSnapshot.SelectedPackages {
+			if selectedPackage != nil && findSelectedPackage(configuredPackages, selectedPackage.ActionName, selectedPackage.PackageReferenceName) != nil {
+				selectedPackages = append(selectedPackages, selectedPackage)
+			}
+		}
+	}
+
+	if err := d.Set("package", flattenSelectedPackages(selectedPackages)); err != nil {
+		return fmt.Errorf("error setting package: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/releases"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbooks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandRunbookSnapshot(t *testing.T) {
+	runbook := runbooks.NewRunbook("Restart web server", "Projects-1")
+	runbook.ID = "Runbooks-1"
+	runbook.SpaceID = "Spaces-1"
+
+	template := &runbooks.RunbookSnapshotTemplate{
+		NextNameIncrement: "Snapshot 7H3JK2A",
+		Packages: []*releases.ReleaseTemplatePackage{
+			{ActionName: "Restart", PackageID: "scripts", VersionSelectedLastRelease: "1.0.0"},
+			{ActionName: "Restart", PackageID: "config", PackageReferenceName: "config", VersionSelectedLastRelease: "0.3.0"},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, getRunbookSnapshotSchema(), map[string]interface{}{
+		"notes":      "Restarts the app pool as well",
+		"runbook_id": "Runbooks-1",
+		"package": []interface{}{
+			map[string]interface{}{
+				"action_name":            "Restart",
+				"package_reference_name": "config",
+				"version":                "0.4.0",
+			},
+		},
+	})
+
+	// packages that are not configured use the version of the last snapshot
+	runbookSnapshot, err := expandRunbookSnapshot(d, runbook, template)
+	require.NoError(t, err)
+	require.Equal(t, "Snapshot 7H3JK2A", runbookSnapshot.Name)
+	require.Equal(t, "Restarts the app pool as well", runbookSnapshot.Notes)
+	require.Equal(t, "Projects-1", runbookSnapshot.ProjectID)
+	require.Equal(t, "Runbooks-1", runbookSnapshot.RunbookID)
+	require.Equal(t, []*packages.SelectedPackage{
+		{ActionName: "Restart", Version: "1.0.0"},
+		{ActionName: "Restart", PackageReferenceName: "config", Version: "0.4.0"},
+	}, runbookSnapshot.SelectedPackages)
+
+	// only the configured packages are kept in state
+	runbookSnapshot.ID = "RunbookSnapshots-1"
+	require.NoError(t, setRunbookSnapshot(d, runbookSnapshot))
+	require.Equal(t, 1, d.Get("package").(*schema.Set).Len())
+	require.Equal(t, "Snapshot 7H3JK2A", d.Get("name"))
+
+	state := schema.TestResourceDataRaw(t, getRunbookSnapshotSchema(), map[string]interface{}{})
+	require.NoError(t, setRunbookSnapshot(state, runbookSnapshot))
+	require.Equal(t, 2, state.Get("package").(*schema.Set).Len())
+
+	// a package that has never been included in a snapshot needs a version
+	template.Packages[0].VersionSelectedLastRelease = ""
+	_, err = expandRunbookSnapshot(d, runbook, template)
+	require.ErrorContains(t, err, "a version must be given for the package 'scripts' of the action 'Restart'")
+
+	// a configured package must be one of the runbook process
+	template.Packages = template.Packages[:1]
+	d = schema.TestResourceDataRaw(t, getRunbookSnapshotSchema(), map[string]interface{}{
+		"name":       "Hotfix",
+		"runbook_id": "Runbooks-1",
+		"package": []interface{}{
+			map[string]interface{}{"action_name": "Restart", "version": "1.0.1"},
+			map[string]interface{}{"action_name": "Deploy", "version": "1.0.1"},
+		},
+	})
+	_, err = expandRunbookSnapshot(d, runbook, template)
+	require.ErrorContains(t, err, "the process of runbook Runbooks-1 has no package '' for the action 'Deploy'")
+}
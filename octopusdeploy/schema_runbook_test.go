@@ -0,0 +1,50 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbooks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandRunbook(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getRunbookSchema(), map[string]interface{}{
+		"default_guided_failure_mode": "On",
+		"environment_scope":           "Specified",
+		"environments":                []interface{}{"Environments-1"},
+		"multi_tenancy_mode":          "Tenanted",
+		"name":                        "Test",
+		"project_id":                  "Projects-1",
+		"retention_policy": []interface{}{
+			map[string]interface{}{
+				"quantity_to_keep":    10,
+				"should_keep_forever": false,
+			},
+		},
+	})
+
+	runbook := expandRunbook(context.Background(), d)
+	require.Equal(t, "On", runbook.DefaultGuidedFailureMode)
+	require.Equal(t, "Specified", runbook.EnvironmentScope)
+	require.Equal(t, []string{"Environments-1"}, runbook.Environments)
+	require.EqualValues(t, "Tenanted", runbook.MultiTenancyMode)
+	require.NotNil(t, runbook.RunRetentionPolicy)
+	require.EqualValues(t, 10, runbook.RunRetentionPolicy.QuantityToKeep)
+}
+
+func TestSetRunbookRetentionPolicy(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getRunbookSchema(), map[string]interface{}{})
+	runbook := runbooks.NewRunbook("Test", "Projects-1")
+
+	runbook.RunRetentionPolicy = &runbooks.RunbookRetentionPeriod{QuantityToKeep: 25}
+	require.NoError(t, setRunbook(context.Background(), d, runbook))
+	require.Equal(t, 25, d.Get("retention_policy.0.quantity_to_keep"))
+
+	runbook.RunRetentionPolicy = &runbooks.RunbookRetentionPeriod{ShouldKeepForever: true}
+	require.NoError(t, setRunbook(context.Background(), d, runbook))
+	require.Equal(t, true, d.Get("retention_policy.0.should_keep_forever"))
+
+	require.Nil(t, flattenRunbookRetentionPeriod(nil))
+}
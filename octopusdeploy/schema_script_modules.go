@@ -90,7 +90,8 @@ func getScriptModuleDataSchema() map[string]*schema.Schema {
 		},
 		"partial_name": getQueryPartialName(),
 		"skip":         getQuerySkip(),
-		"take":         getQueryTake(),
+		"space_id":     getQuerySpaceID(),
+		"take":         getQueryTakeAll(),
 	}
 }
 
@@ -0,0 +1,140 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/octopusservernodes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func flattenServerNodes(serverNodes []*octopusservernodes.OctopusServerNodeResource) []interface{} {
+	flattenedServerNodes := []interface{}{}
+	for _, serverNode := range serverNodes {
+		if serverNode == nil {
+			continue
+		}
+
+		flattenedServerNodes = append(flattenedServerNodes, map[string]interface{}{
+			"id":                     serverNode.GetID(),
+			"is_in_maintenance_mode": serverNode.IsInMaintenanceMode,
+			"max_concurrent_tasks":   int(serverNode.MaxConcurrentTasks),
+			"name":                   serverNode.Name,
+		})
+	}
+
+	return flattenedServerNodes
+}
+
+func getServerDataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_version": {
+			Computed:    true,
+			Description: "The version of the API of the Octopus Server.",
+			Type:        schema.TypeString,
+		},
+		"has_long_term_support": {
+			Computed:    true,
+			Description: "Whether the version of the Octopus Server has long-term support.",
+			Type:        schema.TypeBool,
+		},
+		"id": getDataSchemaID(),
+		"installation_id": {
+			Computed:    true,
+			Description: "The ID of the installation of the Octopus Server.",
+			Type:        schema.TypeString,
+		},
+		"is_early_access_program": {
+			Computed:    true,
+			Description: "Whether the version of the Octopus Server is part of the early access program.",
+			Type:        schema.TypeBool,
+		},
+		"node": {
+			Computed:    true,
+			Description: "The nodes of the Octopus Server. Empty if the API key is not permitted to view the nodes.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Computed:    true,
+						Description: "The ID of the node.",
+						Type:        schema.TypeString,
+					},
+					"is_in_maintenance_mode": {
+						Computed:    true,
+						Description: "Whether the node is in maintenance mode.",
+						Type:        schema.TypeBool,
+					},
+					"max_concurrent_tasks": {
+						Computed:    true,
+						Description: "The maximum number of tasks that the node runs at once.",
+						Type:        schema.TypeInt,
+					},
+					"name": {
+						Computed:    true,
+						Description: "The name of the node.",
+						Type:        schema.TypeString,
+					},
+				},
+			},
+			Type: schema.TypeList,
+		},
+		"version": {
+			Computed:    true,
+			Description: "The version of the Octopus Server (e.g. `2023.2.12345`).",
+			Type:        schema.TypeString,
+		},
+		"version_major": {
+			Computed:    true,
+			Description: "The major version of the Octopus Server (e.g. `2023`), for comparison against a minimum version.",
+			Type:        schema.TypeInt,
+		},
+		"version_minor": {
+			Computed:    true,
+			Description: "The minor version of the Octopus Server (e.g. `2`), for comparison against a minimum version.",
+			Type:        schema.TypeInt,
+		},
+	}
+}
+
+// parseServerVersion returns the major and minor parts of a version of Octopus Server, such as 2023.2.12345.
+func parseServerVersion(version string) (int, int, error) {
+	parts := strings.Split(version, ".")
+	if len(parts) < 2 {
+		return 0, 0, fmt.Errorf("unable to parse the Octopus Server version '%s'", version)
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to parse the Octopus Server version '%s': %s", version, err)
+	}
+
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to parse the Octopus Server version '%s': %s", version, err)
+	}
+
+	return major, minor, nil
+}
+
+func setServer(d *schema.ResourceData, root *client.RootResource) error {
+	d.Set("api_version", root.APIVersion)
+	d.Set("has_long_term_support", root.HasLongTermSupport)
+	d.Set("is_early_access_program", root.IsEarlyAccessProgram)
+	d.Set("version", root.Version)
+
+	if root.InstallationID != nil {
+		d.Set("installation_id", root.InstallationID.String())
+	}
+
+	major, minor, err := parseServerVersion(root.Version)
+	if err != nil {
+		return err
+	}
+
+	d.Set("version_major", major)
+	d.Set("version_minor", minor)
+
+	return nil
+}
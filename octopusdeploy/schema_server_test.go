@@ -0,0 +1,53 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/octopusservernodes"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseServerVersion(t *testing.T) {
+	major, minor, err := parseServerVersion("2023.2.12345")
+	require.NoError(t, err)
+	require.Equal(t, 2023, major)
+	require.Equal(t, 2, minor)
+
+	major, minor, err = parseServerVersion("0.0.0-local")
+	require.NoError(t, err)
+	require.Equal(t, 0, major)
+	require.Equal(t, 0, minor)
+
+	_, _, err = parseServerVersion("2023")
+	require.Error(t, err)
+
+	_, _, err = parseServerVersion("latest.x")
+	require.Error(t, err)
+}
+
+func TestSetServer(t *testing.T) {
+	installationID := uuid.MustParse("2a7f2a1c-6d1d-4b4e-9d4a-1fbd3f1a6c11")
+	root := client.NewRootResource()
+	root.APIVersion = "3.0.0"
+	root.InstallationID = &installationID
+	root.Version = "2023.2.12345"
+
+	serverNode := octopusservernodes.NewOctopusServerNodeResource()
+	serverNode.ID = "OctopusServerNodes-1"
+	serverNode.MaxConcurrentTasks = 5
+	serverNode.Name = "octopus-0"
+
+	d := schema.TestResourceDataRaw(t, getServerDataSchema(), map[string]interface{}{})
+	require.NoError(t, setServer(d, root))
+	require.NoError(t, d.Set("node", flattenServerNodes([]*octopusservernodes.OctopusServerNodeResource{serverNode})))
+
+	require.Equal(t, "2a7f2a1c-6d1d-4b4e-9d4a-1fbd3f1a6c11", d.Get("installation_id"))
+	require.Equal(t, "2023.2.12345", d.Get("version"))
+	require.Equal(t, 2023, d.Get("version_major"))
+	require.Equal(t, 2, d.Get("version_minor"))
+	require.Equal(t, "octopus-0", d.Get("node.0.name"))
+	require.Equal(t, 5, d.Get("node.0.max_concurrent_tasks"))
+}
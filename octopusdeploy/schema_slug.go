@@ -0,0 +1,23 @@
+package octopusdeploy
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func getSlugDataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": getDataSchemaID(),
+		"name": {
+			Description:      "The name to derive the slug from (e.g. the name of a project or environment).",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"slug": {
+			Computed:    true,
+			Description: "The slug that Octopus derives from the name.",
+			Type:        schema.TypeString,
+		},
+	}
+}
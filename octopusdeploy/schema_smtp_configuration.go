@@ -0,0 +1,94 @@
+package octopusdeploy
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/configuration"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// smtpConfigurationID is the ID of the SMTP configuration, of which there is exactly one per Octopus instance.
+const smtpConfigurationID = "smtpconfiguration"
+
+func expandSmtpConfiguration(d *schema.ResourceData) *configuration.SmtpConfiguration {
+	smtpConfiguration := &configuration.SmtpConfiguration{
+		EnableSsl:     d.Get("enable_ssl").(bool),
+		SendEmailFrom: d.Get("send_email_from").(string),
+		SmtpHost:      d.Get("host").(string),
+		SmtpLogin:     d.Get("login").(string),
+		SmtpPassword:  core.NewSensitiveValue(getSensitiveString(d, "password")),
+		SmtpPort:      d.Get("port").(int),
+		Timeout:       d.Get("timeout").(int),
+	}
+	smtpConfiguration.ID = smtpConfigurationID
+
+	return smtpConfiguration
+}
+
+func getSmtpConfigurationSchema() map[string]*schema.Schema {
+	smtpConfigurationSchema := map[string]*schema.Schema{
+		"enable_ssl": {
+			Default:     false,
+			Description: "Whether the connection to the SMTP server is secured with SSL/TLS.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"host": {
+			Description:      "The host name of the SMTP server.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"id": getIDSchema(),
+		"login": {
+			Description: "The user name used to authenticate with the SMTP server.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"password": {
+			Description: "The password used to authenticate with the SMTP server.",
+			Optional:    true,
+			Sensitive:   true,
+			Type:        schema.TypeString,
+		},
+		"port": {
+			Default:          25,
+			Description:      "The port of the SMTP server.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsPortNumber),
+		},
+		"send_email_from": {
+			Description:      "The address that emails sent by Octopus are sent from.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"timeout": {
+			Default:          12000,
+			Description:      "The time, in milliseconds, to wait for the SMTP server to respond.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+	}
+
+	addSecretVersions(smtpConfigurationSchema, "password")
+
+	return smtpConfigurationSchema
+}
+
+// setSmtpConfiguration refreshes the state from the SMTP configuration held by Octopus. The password is never returned
+// by Octopus, so it is left as configured unless its version is set.
+func setSmtpConfiguration(d *schema.ResourceData, smtpConfiguration *configuration.SmtpConfiguration) {
+	d.Set("enable_ssl", smtpConfiguration.EnableSsl)
+	d.Set("host", smtpConfiguration.SmtpHost)
+	d.Set("login", smtpConfiguration.SmtpLogin)
+	d.Set("port", smtpConfiguration.SmtpPort)
+	d.Set("send_email_from", smtpConfiguration.SendEmailFrom)
+	d.Set("timeout", smtpConfiguration.Timeout)
+
+	clearVersionedSecrets(d, "password")
+
+	d.SetId(smtpConfigurationID)
+}
@@ -88,7 +88,7 @@ func getSpacesDataSourceSchema() map[string]*schema.Schema {
 		"ids":          getQueryIDs(),
 		"partial_name": getQueryPartialName(),
 		"skip":         getQuerySkip(),
-		"take":         getQueryTake(),
+		"take":         getQueryTakeAll(),
 		"spaces": {
 			Computed:    true,
 			Description: "A list of spaces that match the filter(s).",
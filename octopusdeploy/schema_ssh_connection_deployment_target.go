@@ -49,7 +49,7 @@ func flattenSSHConnectionDeploymentTarget(deploymentTarget *machines.DeploymentT
 
 func getSSHConnectionDeploymentTargetDataSchema() map[string]*schema.Schema {
 	dataSchema := getSSHConnectionDeploymentTargetSchema()
-	setDataSchema(&dataSchema)
+	setDeploymentTargetDataSchema(&dataSchema)
 
 	deploymentTargetDataSchema := getDeploymentTargetDataSchema()
 
@@ -13,11 +13,15 @@ import (
 func expandSSHKeyAccount(d *schema.ResourceData) *accounts.SSHKeyAccount {
 	name := d.Get("name").(string)
 	username := d.Get("username").(string)
-	privateKeyFile := core.NewSensitiveValue(d.Get("private_key_passphrase").(string))
+	privateKeyFile := core.NewSensitiveValue(getSensitiveString(d, "private_key_file"))
 
 	account, _ := accounts.NewSSHKeyAccount(name, username, privateKeyFile)
 	account.ID = d.Id()
 
+	if v := getSensitiveString(d, "private_key_passphrase"); len(v) > 0 {
+		account.SetPrivateKeyPassphrase(core.NewSensitiveValue(v))
+	}
+
 	if v, ok := d.GetOk("tenanted_deployment_participation"); ok {
 		account.TenantedDeploymentMode = core.TenantedDeploymentMode(v.(string))
 	}
@@ -34,7 +38,7 @@ func expandSSHKeyAccount(d *schema.ResourceData) *accounts.SSHKeyAccount {
 }
 
 func getSSHKeyAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	accountSchema := map[string]*schema.Schema{
 		"description":  getDescriptionSchema("SSH key account"),
 		"environments": getEnvironmentsSchema(),
 		"id":           getIDSchema(),
@@ -56,6 +60,10 @@ func getSSHKeyAccountSchema() map[string]*schema.Schema {
 		"tenant_tags":                       getTenantTagsSchema(),
 		"username":                          getUsernameSchema(true),
 	}
+
+	addSecretVersions(accountSchema, "private_key_file", "private_key_passphrase")
+
+	return accountSchema
 }
 
 func setSSHKeyAccount(ctx context.Context, d *schema.ResourceData, account *accounts.SSHKeyAccount) error {
@@ -79,5 +87,7 @@ func setSSHKeyAccount(ctx context.Context, d *schema.ResourceData, account *acco
 
 	d.Set("username", account.Username)
 
+	clearVersionedSecrets(d, "private_key_file", "private_key_passphrase")
+
 	return nil
 }
@@ -0,0 +1,118 @@
+package octopusdeploy
+
+import (
+	"path"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actions"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actiontemplates"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// flattenStepTemplate flattens a step template, along with the community step template that it was installed from, if
+// any.
+func flattenStepTemplate(actionTemplate *actiontemplates.ActionTemplate, communityActionTemplate *actions.CommunityActionTemplate) map[string]interface{} {
+	if actionTemplate == nil {
+		return nil
+	}
+
+	parameterNames := []string{}
+	for _, parameter := range actionTemplate.Parameters {
+		parameterNames = append(parameterNames, parameter.Name)
+	}
+
+	flattenedStepTemplate := map[string]interface{}{
+		"action_type":                  actionTemplate.ActionType,
+		"community_action_template_id": actionTemplate.CommunityActionTemplateID,
+		"description":                  actionTemplate.Description,
+		"id":                           actionTemplate.GetID(),
+		"name":                         actionTemplate.Name,
+		"parameter_names":              parameterNames,
+		"space_id":                     actionTemplate.SpaceID,
+		"version":                      int(actionTemplate.Version),
+	}
+
+	if communityActionTemplate != nil {
+		flattenedStepTemplate["website"] = communityActionTemplate.Website
+	}
+
+	return flattenedStepTemplate
+}
+
+func getStepTemplateDataSchema() map[string]*schema.Schema {
+	dataSchema := getStepTemplateSchema()
+	setDataSchema(&dataSchema)
+
+	return map[string]*schema.Schema{
+		"id":           getDataSchemaID(),
+		"ids":          getQueryIDs(),
+		"name":         getQueryName(),
+		"partial_name": getQueryPartialName(),
+		"skip":         getQuerySkip(),
+		"space_id":     getQuerySpaceID(),
+		"step_templates": {
+			Computed:    true,
+			Description: "A list of step templates that match the filter(s).",
+			Elem:        &schema.Resource{Schema: dataSchema},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"take": getQueryTakeAll(),
+		"website": {
+			Description: "A filter to search for step templates installed from the community library by the website of the community step template, either its full URL or the ID at the end of it.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func getStepTemplateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"action_type": {
+			Description: "The type of the action of the step template (e.g. `Octopus.Script`).",
+			Type:        schema.TypeString,
+		},
+		"community_action_template_id": {
+			Description: "The ID of the community step template that the step template was installed from. Empty for custom step templates.",
+			Type:        schema.TypeString,
+		},
+		"description": getDescriptionSchema("step template"),
+		"id":          getIDSchema(),
+		"name": {
+			Description: "The name of the step template.",
+			Type:        schema.TypeString,
+		},
+		"parameter_names": {
+			Description: "The names of the parameters of the step template.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"space_id": getSpaceIDSchema(),
+		"version": {
+			Description: "The version of the step template.",
+			Type:        schema.TypeInt,
+		},
+		"website": {
+			Description: "The website of the community step template that the step template was installed from. Empty for custom step templates.",
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+// isCommunityStepTemplateWebsite reports whether the given website identifies a community step template. The website
+// may be the full URL of the community step template or the ID at the end of it.
+func isCommunityStepTemplateWebsite(communityActionTemplate *actions.CommunityActionTemplate, website string) bool {
+	if communityActionTemplate == nil || len(website) == 0 {
+		return false
+	}
+
+	if strings.EqualFold(communityActionTemplate.Website, website) {
+		return true
+	}
+
+	if communityActionTemplate.ExternalId != nil && strings.EqualFold(communityActionTemplate.ExternalId.String(), website) {
+		return true
+	}
+
+	return strings.EqualFold(path.Base(strings.TrimSuffix(communityActionTemplate.Website, "/")), website)
+}
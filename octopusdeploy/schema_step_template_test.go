@@ -0,0 +1,44 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actions"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actiontemplates"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsCommunityStepTemplateWebsite(t *testing.T) {
+	externalID := uuid.MustParse("99e6f203-3061-4018-9e34-4a3a9c3c3179")
+	communityActionTemplate := actions.NewCommunityActionTemplate("Slack - Send Simple Notification", "Octopus.Script")
+	communityActionTemplate.ExternalId = &externalID
+	communityActionTemplate.Website = "https://library.octopus.com/step-templates/99e6f203-3061-4018-9e34-4a3a9c3c3179"
+
+	require.True(t, isCommunityStepTemplateWebsite(communityActionTemplate, communityActionTemplate.Website))
+	require.True(t, isCommunityStepTemplateWebsite(communityActionTemplate, "99E6F203-3061-4018-9E34-4A3A9C3C3179"))
+	require.False(t, isCommunityStepTemplateWebsite(communityActionTemplate, "step-templates"))
+	require.False(t, isCommunityStepTemplateWebsite(communityActionTemplate, ""))
+	require.False(t, isCommunityStepTemplateWebsite(nil, communityActionTemplate.Website))
+}
+
+func TestFlattenStepTemplate(t *testing.T) {
+	actionTemplate := actiontemplates.NewActionTemplate("Notify Slack", "Octopus.Script")
+	actionTemplate.ID = "ActionTemplates-1"
+	actionTemplate.CommunityActionTemplateID = "CommunityActionTemplates-1"
+	actionTemplate.Version = 3
+	actionTemplate.Parameters = []actiontemplates.ActionTemplateParameter{{Name: "HookUrl"}, {Name: "Channel"}}
+
+	communityActionTemplate := actions.NewCommunityActionTemplate("Slack - Send Simple Notification", "Octopus.Script")
+	communityActionTemplate.Website = "https://library.octopus.com/step-templates/99e6f203-3061-4018-9e34-4a3a9c3c3179"
+
+	flattenedStepTemplate := flattenStepTemplate(actionTemplate, communityActionTemplate)
+	require.Equal(t, "ActionTemplates-1", flattenedStepTemplate["id"])
+	require.Equal(t, "CommunityActionTemplates-1", flattenedStepTemplate["community_action_template_id"])
+	require.Equal(t, 3, flattenedStepTemplate["version"])
+	require.Equal(t, []string{"HookUrl", "Channel"}, flattenedStepTemplate["parameter_names"])
+	require.Equal(t, communityActionTemplate.Website, flattenedStepTemplate["website"])
+
+	actionTemplate.CommunityActionTemplateID = ""
+	require.NotContains(t, flattenStepTemplate(actionTemplate, nil), "website")
+}
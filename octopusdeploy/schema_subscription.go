@@ -0,0 +1,184 @@
+package octopusdeploy
+
+import (
+	sub "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/subscriptions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func flattenSubscription(subscription *sub.Subscription) map[string]interface{} {
+	if subscription == nil {
+		return nil
+	}
+
+	eventNotificationSubscription := subscription.EventNotificationSubscription
+	filter := eventNotificationSubscription.Filter
+
+	return map[string]interface{}{
+		"email_frequency_period":           eventNotificationSubscription.EmailFrequencyPeriod,
+		"email_priority":                   eventNotificationSubscription.EmailPriority,
+		"email_show_dates_in_time_zone_id": eventNotificationSubscription.EmailShowDatesInTimeZoneID,
+		"email_teams":                      eventNotificationSubscription.EmailTeams,
+		"filter": []interface{}{map[string]interface{}{
+			"document_types":   filter.DocumentTypes,
+			"environments":     filter.Environments,
+			"event_agents":     filter.EventAgents,
+			"event_categories": filter.EventCategories,
+			"event_groups":     filter.EventGroups,
+			"project_groups":   filter.ProjectGroups,
+			"projects":         filter.Projects,
+			"tags":             filter.Tags,
+			"tenants":          filter.Tenants,
+			"users":            filter.Users,
+		}},
+		"id":                 subscription.GetID(),
+		"is_disabled":        subscription.IsDisabled,
+		"name":               subscription.Name,
+		"space_id":           subscription.SpaceID,
+		"webhook_header_key": eventNotificationSubscription.WebhookHeaderKey,
+		"webhook_teams":      eventNotificationSubscription.WebhookTeams,
+		"webhook_timeout":    eventNotificationSubscription.WebhookTimeout,
+		"webhook_uri":        eventNotificationSubscription.WebhookURI,
+	}
+}
+
+func getSubscriptionDataSchema() map[string]*schema.Schema {
+	dataSchema := getSubscriptionSchema()
+	setDataSchema(&dataSchema)
+
+	return map[string]*schema.Schema{
+		"id":           getDataSchemaID(),
+		"ids":          getQueryIDs(),
+		"partial_name": getQueryPartialName(),
+		"skip":         getQuerySkip(),
+		"space_id":     getQuerySpaceID(),
+		"subscriptions": {
+			Computed:    true,
+			Description: "A list of subscriptions that match the filter(s).",
+			Elem:        &schema.Resource{Schema: dataSchema},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"take": getQueryTakeAll(),
+	}
+}
+
+// getSubscriptionFilterSchema returns the schema of the filter of a subscription. An empty list matches events of any
+// value.
+func getSubscriptionFilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"document_types": {
+			Computed:    true,
+			Description: "The types of documents whose events are notified about.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"environments": {
+			Computed:    true,
+			Description: "The IDs of the environments whose events are notified about.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"event_agents": {
+			Computed:    true,
+			Description: "The agents (such as `Octopus Web Portal` or `Octopus CLI`) whose events are notified about.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"event_categories": {
+			Computed:    true,
+			Description: "The categories of the events that are notified about (e.g. `DeploymentFailed`).",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"event_groups": {
+			Computed:    true,
+			Description: "The groups of the events that are notified about (e.g. `Deployment`).",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"project_groups": {
+			Computed:    true,
+			Description: "The IDs of the project groups whose events are notified about.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"projects": {
+			Computed:    true,
+			Description: "The IDs of the projects whose events are notified about.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"tags": {
+			Computed:    true,
+			Description: "The canonical names of the tenant tags whose events are notified about.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"tenants": {
+			Computed:    true,
+			Description: "The IDs of the tenants whose events are notified about.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"users": {
+			Computed:    true,
+			Description: "The IDs of the users whose events are notified about.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+	}
+}
+
+func getSubscriptionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"email_frequency_period": {
+			Description: "How often a digest of events is emailed, as a time span (e.g. `01:00:00`).",
+			Type:        schema.TypeString,
+		},
+		"email_priority": {
+			Description: "The priority of the digest emails.",
+			Type:        schema.TypeString,
+		},
+		"email_show_dates_in_time_zone_id": {
+			Description: "The ID of the time zone that dates are shown in within digest emails.",
+			Type:        schema.TypeString,
+		},
+		"email_teams": {
+			Description: "The IDs of the teams that digest emails are sent to.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"filter": {
+			Description: "The events that the subscription notifies about.",
+			Elem:        &schema.Resource{Schema: getSubscriptionFilterSchema()},
+			Type:        schema.TypeList,
+		},
+		"id": getIDSchema(),
+		"is_disabled": {
+			Description: "Whether the subscription is disabled.",
+			Type:        schema.TypeBool,
+		},
+		"name": {
+			Description: "The name of the subscription.",
+			Type:        schema.TypeString,
+		},
+		"space_id": getSpaceIDSchema(),
+		"webhook_header_key": {
+			Description: "The name of the header sent with webhook requests. The value of the header is not returned.",
+			Type:        schema.TypeString,
+		},
+		"webhook_teams": {
+			Description: "The IDs of the teams whose events are sent to the webhook. Events of any team are sent if empty.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"webhook_timeout": {
+			Description: "The timeout of webhook requests, as a time span (e.g. `00:00:10`).",
+			Type:        schema.TypeString,
+		},
+		"webhook_uri": {
+			Description: "The URI that events are sent to.",
+			Type:        schema.TypeString,
+		},
+	}
+}
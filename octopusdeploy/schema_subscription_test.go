@@ -0,0 +1,59 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"testing"
+
+	sub "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/subscriptions"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenSubscription(t *testing.T) {
+	// a subscription as it is returned by Octopus
+	var subscription sub.Subscription
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"Id": "Subscriptions-1",
+		"Name": "Failed deployments",
+		"Type": "Event",
+		"IsDisabled": false,
+		"SpaceId": "Spaces-1",
+		"EventNotificationSubscription": {
+			"Filter": {
+				"Users": [],
+				"Projects": ["Projects-1"],
+				"ProjectGroups": [],
+				"Environments": ["Environments-1"],
+				"EventGroups": [],
+				"EventCategories": ["DeploymentFailed"],
+				"EventAgents": [],
+				"Tenants": [],
+				"Tags": [],
+				"DocumentTypes": []
+			},
+			"EmailTeams": ["Teams-1"],
+			"EmailFrequencyPeriod": "01:00:00",
+			"EmailPriority": "Normal",
+			"EmailShowDatesInTimeZoneId": "UTC",
+			"WebhookURI": "https://hooks.example.com/octopus",
+			"WebhookTeams": [],
+			"WebhookTimeout": "00:00:10",
+			"WebhookHeaderKey": "Authorization",
+			"WebhookHeaderValue": "secret"
+		}
+	}`), &subscription))
+
+	flattenedSubscription := flattenSubscription(&subscription)
+	require.Equal(t, "Subscriptions-1", flattenedSubscription["id"])
+	require.Equal(t, "Failed deployments", flattenedSubscription["name"])
+	require.Equal(t, "Spaces-1", flattenedSubscription["space_id"])
+	require.Equal(t, []string{"Teams-1"}, flattenedSubscription["email_teams"])
+	require.Equal(t, "01:00:00", flattenedSubscription["email_frequency_period"])
+	require.Equal(t, "https://hooks.example.com/octopus", flattenedSubscription["webhook_uri"])
+	require.Equal(t, "Authorization", flattenedSubscription["webhook_header_key"])
+	require.NotContains(t, flattenedSubscription, "webhook_header_value")
+
+	filter := flattenedSubscription["filter"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, []string{"DeploymentFailed"}, filter["event_categories"])
+	require.Equal(t, []string{"Environments-1"}, filter["environments"])
+	require.Equal(t, []string{"Projects-1"}, filter["projects"])
+}
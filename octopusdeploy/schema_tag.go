@@ -24,6 +24,7 @@ func getTagSchema() map[string]*schema.Schema {
 			Optional: true,
 			Type:     schema.TypeInt,
 		},
+		"space_id": getSpaceIDInputSchema(),
 		"tag_set_id": {
 			Description: "The ID of the associated tag set.",
 			Required:    true,
@@ -50,6 +50,7 @@ func getTagSetDataSchema() map[string]*schema.Schema {
 		"ids":          getQueryIDs(),
 		"partial_name": getQueryPartialName(),
 		"skip":         getQuerySkip(),
+		"space_id":     getQuerySpaceID(),
 		"tag_sets": {
 			Computed:    true,
 			Description: "A list of tag sets that match the filter(s).",
@@ -57,7 +58,7 @@ func getTagSetDataSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Type:        schema.TypeList,
 		},
-		"take": getQueryTake(),
+		"take": getQueryTakeAll(),
 	}
 }
 
@@ -0,0 +1,156 @@
+package octopusdeploy
+
+import (
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func flattenTask(task *tasks.Task) map[string]interface{} {
+	if task == nil {
+		return nil
+	}
+
+	flattenedTask := map[string]interface{}{
+		"description":               task.Description,
+		"error_message":             task.ErrorMessage,
+		"has_pending_interruptions": task.HasPendingInterruptions,
+		"id":                        task.GetID(),
+		"name":                      task.Name,
+		"space_id":                  task.SpaceID,
+		"state":                     task.State,
+	}
+
+	if task.IsCompleted != nil {
+		flattenedTask["is_completed"] = *task.IsCompleted
+	}
+
+	if task.QueueTime != nil {
+		flattenedTask["queue_time"] = task.QueueTime.Format(time.RFC3339)
+	}
+
+	if task.StartTime != nil {
+		flattenedTask["start_time"] = task.StartTime.Format(time.RFC3339)
+	}
+
+	if task.CompletedTime != nil {
+		flattenedTask["completed_time"] = task.CompletedTime.Format(time.RFC3339)
+	}
+
+	return flattenedTask
+}
+
+func getTaskDataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"environment_id": {
+			Description: "A filter to search by an environment ID.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"id":  getDataSchemaID(),
+		"ids": getQueryIDs(),
+		"is_active": {
+			Description: "A filter to search for tasks that are queued or executing.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"is_running": {
+			Description: "A filter to search for tasks that are executing.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"name": {
+			Description: "A filter to search by the type of task (e.g. `Deploy`, `Health`, or `RunbookRun`).",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"project_id": getQueryProjectID(),
+		"skip":       getQuerySkip(),
+		"space_id":   getQuerySpaceID(),
+		"states": {
+			Description: "A filter to search by a list of task states. Valid task states are `Canceled`, `Cancelling`, `Executing`, `Failed`, `Queued`, `Success`, or `TimedOut`.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+					"Canceled",
+					"Cancelling",
+					"Executing",
+					"Failed",
+					"Queued",
+					"Success",
+					"TimedOut",
+				}, false)),
+			},
+			Optional: true,
+			Type:     schema.TypeList,
+		},
+		"take": getQueryTake(),
+		"tasks": {
+			Computed:    true,
+			Description: "A list of server tasks that match the filter(s).",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"completed_time": {
+						Computed:    true,
+						Description: "The time that the task completed, in RFC 3339 format.",
+						Type:        schema.TypeString,
+					},
+					"description": {
+						Computed:    true,
+						Description: "The description of the task.",
+						Type:        schema.TypeString,
+					},
+					"error_message": {
+						Computed:    true,
+						Description: "The error message of the task, if it failed.",
+						Type:        schema.TypeString,
+					},
+					"has_pending_interruptions": {
+						Computed:    true,
+						Description: "Whether the task is waiting for a manual intervention or guided failure.",
+						Type:        schema.TypeBool,
+					},
+					"id": {
+						Computed:    true,
+						Description: "The ID of the task.",
+						Type:        schema.TypeString,
+					},
+					"is_completed": {
+						Computed:    true,
+						Description: "Whether the task has completed.",
+						Type:        schema.TypeBool,
+					},
+					"name": {
+						Computed:    true,
+						Description: "The type of the task (e.g. `Deploy`).",
+						Type:        schema.TypeString,
+					},
+					"queue_time": {
+						Computed:    true,
+						Description: "The time that the task was queued to start, in RFC 3339 format.",
+						Type:        schema.TypeString,
+					},
+					"space_id": {
+						Computed:    true,
+						Description: "The space ID associated with this task.",
+						Type:        schema.TypeString,
+					},
+					"start_time": {
+						Computed:    true,
+						Description: "The time that the task started, in RFC 3339 format.",
+						Type:        schema.TypeString,
+					},
+					"state": {
+						Computed:    true,
+						Description: "The state of the task (e.g. `Queued` or `Executing`).",
+						Type:        schema.TypeString,
+					},
+				},
+			},
+			Type: schema.TypeList,
+		},
+		"tenant_id": getQueryTenant(),
+	}
+}
@@ -0,0 +1,33 @@
+package octopusdeploy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenTask(t *testing.T) {
+	queueTime := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	isCompleted := false
+
+	task := tasks.NewTask()
+	task.ID = "ServerTasks-1"
+	task.Description = "Deploy Website release 1.2.3 to Production"
+	task.IsCompleted = &isCompleted
+	task.Name = "Deploy"
+	task.QueueTime = &queueTime
+	task.State = "Queued"
+
+	flattenedTask := flattenTask(task)
+	require.Equal(t, "ServerTasks-1", flattenedTask["id"])
+	require.Equal(t, "Deploy", flattenedTask["name"])
+	require.Equal(t, "Queued", flattenedTask["state"])
+	require.Equal(t, false, flattenedTask["is_completed"])
+	require.Equal(t, "2023-05-01T10:00:00Z", flattenedTask["queue_time"])
+	require.NotContains(t, flattenedTask, "start_time")
+	require.NotContains(t, flattenedTask, "completed_time")
+
+	require.Nil(t, flattenTask(nil))
+}
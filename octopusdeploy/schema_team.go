@@ -35,7 +35,7 @@ func expandTeam(d *schema.ResourceData) *teams.Team {
 	}
 
 	if v, ok := d.GetOk("external_security_group"); ok {
-		team.ExternalSecurityGroups = expandExternalSecurityGroups(v.([]interface{}))
+		team.ExternalSecurityGroups = expandExternalSecurityGroups(v.(*schema.Set).List())
 	}
 
 	if v, ok := d.GetOk("space_id"); ok {
@@ -80,7 +80,7 @@ func getTeamDataSchema() map[string]*schema.Schema {
 		"partial_name":   getQueryPartialName(),
 		"skip":           getQuerySkip(),
 		"spaces":         getQuerySpaces(),
-		"take":           getQueryTake(),
+		"take":           getQueryTakeAll(),
 		"teams": {
 			Computed:    true,
 			Description: "A list of teams that match the filter(s).",
@@ -172,9 +172,10 @@ func getTeamSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 		},
 		"external_security_group": {
-			Optional: true,
-			Elem:     &schema.Resource{Schema: getExternalSecurityGroupsSchema()},
-			Type:     schema.TypeList,
+			Description: "The groups of an external authentication provider, such as Active Directory or Microsoft Entra ID, whose members are members of this team.",
+			Elem:        &schema.Resource{Schema: getExternalSecurityGroupsSchema()},
+			Optional:    true,
+			Type:        schema.TypeSet,
 		},
 		"id": getIDSchema(),
 		"name": {
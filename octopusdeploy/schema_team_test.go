@@ -0,0 +1,37 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamExternalSecurityGroups(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getTeamSchema(), map[string]interface{}{
+		"name": "Deployers",
+		"external_security_group": []interface{}{
+			map[string]interface{}{"id": "S-1-5-21-1", "display_name": "Deployers"},
+			map[string]interface{}{"id": "7d2f4b4c-0000-0000-0000-000000000000", "display_name": "Platform", "display_id_and_name": true},
+		},
+	})
+
+	externalSecurityGroups := d.Get("external_security_group").(*schema.Set)
+	team := expandTeam(d)
+	require.ElementsMatch(t, []core.NamedReferenceItem{
+		{DisplayName: "Deployers", ID: "S-1-5-21-1"},
+		{DisplayIDAndName: true, DisplayName: "Platform", ID: "7d2f4b4c-0000-0000-0000-000000000000"},
+	}, team.ExternalSecurityGroups)
+
+	// the order in which Octopus returns the groups is not significant
+	team.ExternalSecurityGroups[0], team.ExternalSecurityGroups[1] = team.ExternalSecurityGroups[1], team.ExternalSecurityGroups[0]
+	require.NoError(t, setTeam(context.Background(), d, team))
+	require.True(t, externalSecurityGroups.Equal(d.Get("external_security_group")))
+
+	// a team without groups clears the groups held by Octopus
+	d = schema.TestResourceDataRaw(t, getTeamSchema(), map[string]interface{}{"name": "Deployers"})
+	require.NotNil(t, expandTeam(d).ExternalSecurityGroups)
+	require.Empty(t, expandTeam(d).ExternalSecurityGroups)
+}
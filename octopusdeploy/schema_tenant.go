@@ -66,6 +66,7 @@ func getTenantDataSchema() map[string]*schema.Schema {
 		"partial_name":          getQueryPartialName(),
 		"project_id":            getQueryProjectID(),
 		"skip":                  getQuerySkip(),
+		"space_id":              getQuerySpaceID(),
 		"tags":                  getQueryTags(),
 		"tenants": {
 			Computed:    true,
@@ -74,7 +75,7 @@ func getTenantDataSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Type:        schema.TypeList,
 		},
-		"take": getQueryTake(),
+		"take": getQueryTakeAll(),
 	}
 }
 
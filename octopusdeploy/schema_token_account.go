@@ -11,7 +11,7 @@ import (
 
 func expandTokenAccount(d *schema.ResourceData) *accounts.TokenAccount {
 	name := d.Get("name").(string)
-	token := core.NewSensitiveValue(d.Get("token").(string))
+	token := core.NewSensitiveValue(getSensitiveString(d, "token"))
 
 	account, _ := accounts.NewTokenAccount(name, token)
 	account.ID = d.Id()
@@ -44,7 +44,7 @@ func expandTokenAccount(d *schema.ResourceData) *accounts.TokenAccount {
 }
 
 func getTokenAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	accountSchema := map[string]*schema.Schema{
 		"description":                       getDescriptionSchema("token account"),
 		"environments":                      getEnvironmentsSchema(),
 		"id":                                getIDSchema(),
@@ -55,6 +55,10 @@ func getTokenAccountSchema() map[string]*schema.Schema {
 		"tenant_tags":                       getTenantTagsSchema(),
 		"token":                             getTokenSchema(true),
 	}
+
+	addSecretVersions(accountSchema, "token")
+
+	return accountSchema
 }
 
 func setTokenAccount(ctx context.Context, d *schema.ResourceData, account *accounts.TokenAccount) error {
@@ -76,5 +80,7 @@ func setTokenAccount(ctx context.Context, d *schema.ResourceData, account *accou
 		return fmt.Errorf("error setting tenant_tags: %s", err)
 	}
 
+	clearVersionedSecrets(d, "token")
+
 	return nil
 }
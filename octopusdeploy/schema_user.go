@@ -35,8 +35,8 @@ func expandUser(d *schema.ResourceData) *users.User {
 		user.IsService = v.(bool)
 	}
 
-	if v, ok := d.GetOk("password"); ok {
-		user.Password = v.(string)
+	if v := getSensitiveString(d, "password"); len(v) > 0 {
+		user.Password = v
 	}
 
 	return user
@@ -62,13 +62,14 @@ func flattenUser(user *users.User) map[string]interface{} {
 func getUserDataSchema() map[string]*schema.Schema {
 	dataSchema := getUserSchema()
 	setDataSchema(&dataSchema)
+	delete(dataSchema, "password_version")
 
 	return map[string]*schema.Schema{
 		"filter": getQueryFilter(),
 		"id":     getDataSchemaID(),
 		"ids":    getQueryIDs(),
 		"skip":   getQuerySkip(),
-		"take":   getQueryTake(),
+		"take":   getQueryTakeAll(),
 		"users": {
 			Computed:    true,
 			Description: "A list of users that match the filter(s).",
@@ -80,7 +81,7 @@ func getUserDataSchema() map[string]*schema.Schema {
 }
 
 func getUserSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	userSchema := map[string]*schema.Schema{
 		"can_password_be_edited": {
 			Computed: true,
 			Type:     schema.TypeBool,
@@ -108,6 +109,10 @@ func getUserSchema() map[string]*schema.Schema {
 		"username": getUsernameSchema(true),
 		"password": getPasswordSchema(false),
 	}
+
+	addSecretVersions(userSchema, "password")
+
+	return userSchema
 }
 
 func setUser(ctx context.Context, d *schema.ResourceData, user *users.User) error {
@@ -127,5 +132,7 @@ func setUser(ctx context.Context, d *schema.ResourceData, user *users.User) erro
 
 	d.SetId(user.GetID())
 
+	clearVersionedSecrets(d, "password")
+
 	return nil
 }
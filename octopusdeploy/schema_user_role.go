@@ -78,7 +78,7 @@ func getUserRoleDataSchema() map[string]*schema.Schema {
 		"ids":          getQueryIDs(),
 		"partial_name": getQueryPartialName(),
 		"skip":         getQuerySkip(),
-		"take":         getQueryTake(),
+		"take":         getQueryTakeAll(),
 		"user_roles": {
 			Computed:    true,
 			Description: "A list of user roles that match the filter(s).",
@@ -14,7 +14,7 @@ func expandUsernamePasswordAccount(d *schema.ResourceData) accounts.IUsernamePas
 
 	account, _ := accounts.NewUsernamePasswordAccount(name)
 	account.SetID(d.Id())
-	account.SetPassword(core.NewSensitiveValue(d.Get("password").(string)))
+	account.SetPassword(core.NewSensitiveValue(getSensitiveString(d, "password")))
 
 	if v, ok := d.GetOk("description"); ok {
 		account.SetDescription(v.(string))
@@ -71,11 +71,13 @@ func setUsernamePasswordAccount(ctx context.Context, d *schema.ResourceData, acc
 
 	d.SetId(account.GetID())
 
+	clearVersionedSecrets(d, "password")
+
 	return nil
 }
 
 func getUsernamePasswordAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	accountSchema := map[string]*schema.Schema{
 		"description":                       getDescriptionSchema("username/password account"),
 		"environments":                      getEnvironmentsSchema(),
 		"id":                                getIDSchema(),
@@ -87,4 +89,8 @@ func getUsernamePasswordAccountSchema() map[string]*schema.Schema {
 		"tenant_tags":                       getTenantTagsSchema(),
 		"username":                          getUsernameSchema(true),
 	}
+
+	addSecretVersions(accountSchema, "password")
+
+	return accountSchema
 }
@@ -155,7 +155,7 @@ func getEnvironmentsSchema() *schema.Schema {
 		Description: "A list of environment IDs associated with this resource.",
 		Elem:        &schema.Schema{Type: schema.TypeString},
 		Optional:    true,
-		Type:        schema.TypeList,
+		Type:        schema.TypeSet,
 	}
 }
 
@@ -292,6 +292,15 @@ func getSpaceIDSchema() *schema.Schema {
 	}
 }
 
+func getSpaceIDInputSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The space ID associated with this resource. Defaults to the space of the provider.",
+		ForceNew:    true,
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+}
+
 func getStatusSchema() *schema.Schema {
 	return &schema.Schema{
 		Computed:    true,
@@ -370,7 +379,7 @@ func getTenantsSchema() *schema.Schema {
 		Description: "A list of tenant IDs associated with this resource.",
 		Elem:        &schema.Schema{Type: schema.TypeString},
 		Optional:    true,
-		Type:        schema.TypeList,
+		Type:        schema.TypeSet,
 	}
 }
 
@@ -380,7 +389,7 @@ func getTenantTagsSchema() *schema.Schema {
 		Description: "A list of tenant tags associated with this resource.",
 		Elem:        &schema.Schema{Type: schema.TypeString},
 		Optional:    true,
-		Type:        schema.TypeList,
+		Type:        schema.TypeSet,
 	}
 }
 
@@ -442,6 +451,7 @@ func setDataSchema(schema *map[string]*schema.Schema) {
 		field.DefaultFunc = nil
 		field.AtLeastOneOf = nil
 		field.ConflictsWith = nil
+		field.DiffSuppressFunc = nil
 		field.ExactlyOneOf = nil
 		field.MaxItems = 0
 		field.MinItems = 0
@@ -3,8 +3,10 @@ package octopusdeploy
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -37,6 +39,9 @@ func expandVariable(d *schema.ResourceData) *variables.Variable {
 	if variable.IsSensitive {
 		variable.Type = "Sensitive"
 		variable.Value = d.Get("sensitive_value").(string)
+		if isSensitiveValueVersioned(d) {
+			variable.Value = getConfiguredSensitiveValue(d)
+		}
 	} else {
 		variable.Value = d.Get("value").(string)
 	}
@@ -51,19 +56,52 @@ func expandVariable(d *schema.ResourceData) *variables.Variable {
 }
 
 func getVariableDataSchema() map[string]*schema.Schema {
-	dataSchema := getVariableSchema()
-	setDataSchema(&dataSchema)
-
 	return map[string]*schema.Schema{
-		"id":  getDataSchemaID(),
-		"ids": getQueryIDs(),
-		"variables": {
+		"description": {
+			Computed:    true,
+			Description: "The description of the variable.",
+			Type:        schema.TypeString,
+		},
+		"id": {
+			Computed:    true,
+			Description: "The ID of the variable.",
+			Type:        schema.TypeString,
+		},
+		"is_sensitive": {
 			Computed:    true,
-			Description: "A list of variables that match the filter(s).",
-			Elem:        &schema.Resource{Schema: dataSchema},
+			Description: "Whether the variable is sensitive. The values of sensitive variables are not returned by Octopus.",
+			Type:        schema.TypeBool,
+		},
+		"name": {
+			Description:      "The name of the variable to find. Names are matched without regard to case.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"owner_id": {
+			Description:      "The ID of the owner of the variable, such as a project or library variable set.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"scope": {
+			Description: "A scope that the variable must share at least one value with. Variables of any scope match if no scope is given.",
+			Elem:        &schema.Resource{Schema: getVariableScopeSchema()},
+			MaxItems:    1,
 			Optional:    true,
 			Type:        schema.TypeList,
 		},
+		"space_id": getQuerySpaceID(),
+		"type": {
+			Computed:    true,
+			Description: "The type of the variable.",
+			Type:        schema.TypeString,
+		},
+		"value": {
+			Computed:    true,
+			Description: "The value of the variable. Empty for sensitive variables.",
+			Type:        schema.TypeString,
+		},
 	}
 }
 
@@ -136,23 +174,26 @@ func getVariableSchema() map[string]*schema.Schema {
 											},
 										},
 									},
-									Description: "If the `control_type` is `Select`, then this value defines an option.",
+									Description: "If the `control_type` is `Select`, then this value defines an option. At least one option is required for `Select` and none may be defined for other control types.",
 									Optional:    true,
 									Type:        schema.TypeList,
 								},
 							},
 						},
-						MaxItems: 1,
-						Optional: true,
-						Type:     schema.TypeList,
+						Description: "Controls how the prompted variable is rendered.",
+						MaxItems:    1,
+						Optional:    true,
+						Type:        schema.TypeList,
 					},
 					"is_required": {
-						Type:     schema.TypeBool,
-						Optional: true,
+						Description: "Whether a value must be provided when the variable is prompted for.",
+						Type:        schema.TypeBool,
+						Optional:    true,
 					},
 					"label": {
-						Type:     schema.TypeString,
-						Optional: true,
+						Description: "The label shown next to the prompted variable.",
+						Type:        schema.TypeString,
+						Optional:    true,
 					},
 				},
 			},
@@ -167,14 +208,24 @@ func getVariableSchema() map[string]*schema.Schema {
 			Type:     schema.TypeList,
 		},
 		"sensitive_value": {
+			ConflictsWith:    []string{"value"},
+			Description:      "The value of a sensitive variable. When `sensitive_value_version` is set, this value is not stored in state.",
+			DiffSuppressFunc: suppressVersionedSensitiveValueDiff,
+			Optional:         true,
+			Sensitive:        true,
+			Type:             schema.TypeString,
+		},
+		"sensitive_value_version": {
 			ConflictsWith: []string{"value"},
+			Description:   "An arbitrary value (e.g. the version of the secret in a vault) that, when set, keeps `sensitive_value` out of state. `sensitive_value` is still read from the configuration and is stored in saved plans. Change it to send a rotated `sensitive_value` to Octopus.",
 			Optional:      true,
-			Sensitive:     true,
 			Type:          schema.TypeString,
 		},
-		"type": getVariableTypeSchema(),
+		"space_id": getSpaceIDInputSchema(),
+		"type":     getVariableTypeSchema(),
 		"value": {
 			ConflictsWith: []string{"sensitive_value"},
+			Description:   "The value of the variable. For `AmazonWebServicesAccount`, `AzureAccount` and `GoogleCloudAccount` variables this is an account ID, for `Certificate` variables a certificate ID and for `WorkerPool` variables a worker pool ID.",
 			Optional:      true,
 			Type:          schema.TypeString,
 		},
@@ -210,3 +261,52 @@ func setVariable(ctx context.Context, d *schema.ResourceData, variable *variable
 
 	return nil
 }
+
+// isSensitiveValueVersioned reports whether the sensitive value of a variable is kept out of state, which is the case
+// whenever a sensitive value version is configured.
+func isSensitiveValueVersioned(d *schema.ResourceData) bool {
+	return isVersionedSecret(d, "sensitive_value")
+}
+
+// getConfiguredSensitiveValue returns the sensitive value from the configuration rather than from state, since it is
+// not stored in state when its version is set.
+func getConfiguredSensitiveValue(d *schema.ResourceData) string {
+	if sensitiveValue, ok := getSensitiveValueFromConfig(d.GetRawConfig()); ok {
+		return sensitiveValue
+	}
+	return d.Get("sensitive_value").(string)
+}
+
+func getSensitiveValueFromConfig(rawConfig cty.Value) (string, bool) {
+	return getStringFromConfig(rawConfig, "sensitive_value")
+}
+
+// suppressVersionedSensitiveValueDiff hides changes to a sensitive value that is not stored in state; it is sent to
+// Octopus whenever the variable is created or updated, such as when its version is changed.
+func suppressVersionedSensitiveValueDiff(k, old, new string, d *schema.ResourceData) bool {
+	return isSensitiveValueVersioned(d)
+}
+
+// variableTypeIDPrefixes maps the variable types that reference another resource to the prefix of that resource's ID.
+var variableTypeIDPrefixes = map[string]string{
+	"AmazonWebServicesAccount": "Accounts",
+	"AzureAccount":             "Accounts",
+	"Certificate":              "Certificates",
+	"GoogleCloudAccount":       "Accounts",
+	"WorkerPool":               "WorkerPools",
+}
+
+// validateVariableValue ensures that the value of a variable that references another resource is the ID of a resource
+// of the expected kind (e.g. Accounts-1 for an AzureAccount variable).
+func validateVariableValue(variableType string, value string) error {
+	prefix, ok := variableTypeIDPrefixes[variableType]
+	if !ok || len(value) == 0 {
+		return nil
+	}
+
+	if !regexp.MustCompile(fmt.Sprintf(`^%s-\d+$`, prefix)).MatchString(value) {
+		return fmt.Errorf("the value of a variable of type '%s' must be the ID of a resource matching %s-<number>, got '%s'", variableType, prefix, value)
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package octopusdeploy
 
 import (
+	"fmt"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
 )
 
@@ -36,6 +38,11 @@ func expandPromptedVariableSettings(values interface{}) *variables.VariablePromp
 		return nil
 	}
 
+	// an empty prompt block prompts for the variable without a label or description
+	if flattenedValues[0] == nil {
+		return &variables.VariablePromptOptions{}
+	}
+
 	promptedVariableSettings := flattenedValues[0].(map[string]interface{})
 	return &variables.VariablePromptOptions{
 		Description:     promptedVariableSettings["description"].(string),
@@ -109,3 +116,31 @@ func flattenSelectOptions(selectOptions []*variables.SelectOption) []map[string]
 	}
 	return options
 }
+
+// validatePromptedDisplaySettings ensures that select options are defined when, and only when, the control type of a
+// prompted variable is Select.
+func validatePromptedDisplaySettings(prompt interface{}) error {
+	prompts, ok := prompt.([]interface{})
+	if !ok || len(prompts) == 0 || prompts[0] == nil {
+		return nil
+	}
+
+	displaySettings, ok := prompts[0].(map[string]interface{})["display_settings"].([]interface{})
+	if !ok || len(displaySettings) == 0 || displaySettings[0] == nil {
+		return nil
+	}
+
+	flattenedDisplaySettings := displaySettings[0].(map[string]interface{})
+	controlType := variables.ControlType(flattenedDisplaySettings["control_type"].(string))
+	selectOptions, _ := flattenedDisplaySettings["select_option"].([]interface{})
+
+	if controlType == variables.ControlTypeSelect && len(selectOptions) == 0 {
+		return fmt.Errorf("at least one select_option is required when control_type is 'Select'")
+	}
+
+	if controlType != variables.ControlTypeSelect && len(selectOptions) > 0 {
+		return fmt.Errorf("select_option may only be defined when control_type is 'Select'")
+	}
+
+	return nil
+}
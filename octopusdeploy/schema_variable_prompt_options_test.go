@@ -92,3 +92,62 @@ func TestFlattenSelectOptionsWithNilInput(t *testing.T) {
 	result := flattenSelectOptions(nil)
 	require.Empty(t, result)
 }
+
+func TestExpandPromptedVariableSettingsWithEmptyBlock(t *testing.T) {
+	result := expandPromptedVariableSettings([]interface{}{nil})
+	require.NotNil(t, result)
+	require.Nil(t, result.DisplaySettings)
+}
+
+func TestExpandPromptedVariableSettingsWithDisplaySettings(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"description": "The environment to restore",
+			"display_settings": []interface{}{
+				map[string]interface{}{
+					"control_type": "MultiLineText",
+				},
+			},
+			"is_required": true,
+			"label":       "Environment",
+		},
+	}
+	result := expandPromptedVariableSettings(input)
+	require.NotNil(t, result)
+	require.True(t, result.IsRequired)
+	require.Equal(t, "Environment", result.Label)
+	require.Equal(t, variables.ControlTypeMultiLineText, result.DisplaySettings.ControlType)
+
+	flattened := flattenPromptedVariableSettings(result)
+	require.Len(t, flattened, 1)
+	require.Equal(t, "Environment", flattened[0].(map[string]interface{})["label"])
+}
+
+func TestValidatePromptedDisplaySettings(t *testing.T) {
+	require.NoError(t, validatePromptedDisplaySettings(nil))
+	require.NoError(t, validatePromptedDisplaySettings([]interface{}{nil}))
+
+	prompt := func(controlType string, selectOptions []interface{}) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"display_settings": []interface{}{
+					map[string]interface{}{
+						"control_type":  controlType,
+						"select_option": selectOptions,
+					},
+				},
+			},
+		}
+	}
+	selectOptions := []interface{}{
+		map[string]interface{}{
+			"display_name": "Name-1",
+			"value":        "Value-1",
+		},
+	}
+
+	require.NoError(t, validatePromptedDisplaySettings(prompt("Checkbox", nil)))
+	require.NoError(t, validatePromptedDisplaySettings(prompt("Select", selectOptions)))
+	require.Error(t, validatePromptedDisplaySettings(prompt("Select", nil)))
+	require.Error(t, validatePromptedDisplaySettings(prompt("SingleLineText", selectOptions)))
+}
@@ -3,6 +3,7 @@ package octopusdeploy
 import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func expandVariableScope(flattenedVariableScope interface{}) variables.VariableScope {
@@ -17,12 +18,13 @@ func expandVariableScope(flattenedVariableScope interface{}) variables.VariableS
 
 	if flattenedMap, ok := list[0].(map[string]interface{}); ok {
 		return variables.VariableScope{
-			Actions:      getSliceFromTerraformTypeList(flattenedMap["actions"]),
-			Channels:     getSliceFromTerraformTypeList(flattenedMap["channels"]),
-			Environments: getSliceFromTerraformTypeList(flattenedMap["environments"]),
-			Machines:     getSliceFromTerraformTypeList(flattenedMap["machines"]),
-			Roles:        getSliceFromTerraformTypeList(flattenedMap["roles"]),
-			TenantTags:   getSliceFromTerraformTypeList(flattenedMap["tenant_tags"]),
+			Actions:       getSliceFromTerraformTypeList(flattenedMap["actions"]),
+			Channels:      getSliceFromTerraformTypeList(flattenedMap["channels"]),
+			Environments:  getSliceFromTerraformTypeList(flattenedMap["environments"]),
+			Machines:      getSliceFromTerraformTypeList(flattenedMap["machines"]),
+			ProcessOwners: getSliceFromTerraformTypeList(flattenedMap["processes"]),
+			Roles:         getSliceFromTerraformTypeList(flattenedMap["roles"]),
+			TenantTags:    getSliceFromTerraformTypeList(flattenedMap["tenant_tags"]),
 		}
 	}
 
@@ -52,6 +54,10 @@ func flattenVariableScope(scope variables.VariableScope) []interface{} {
 		flattenedScope["machines"] = scope.Machines
 	}
 
+	if len(scope.ProcessOwners) > 0 {
+		flattenedScope["processes"] = scope.ProcessOwners
+	}
+
 	if len(scope.Roles) > 0 {
 		flattenedScope["roles"] = scope.Roles
 	}
@@ -66,40 +72,52 @@ func flattenVariableScope(scope variables.VariableScope) []interface{} {
 func getVariableScopeSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"actions": {
-			Description: "A list of actions that are scoped to this variable value.",
+			Description: "A list of action (step) IDs that are scoped to this variable value.",
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Optional:    true,
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 		},
 		"channels": {
 			Description: "A list of channels that are scoped to this variable value.",
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Optional:    true,
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 		},
 		"environments": {
 			Description: "A list of environments that are scoped to this variable value.",
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Optional:    true,
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 		},
 		"machines": {
-			Description: "A list of machines that are scoped to this variable value.",
-			Elem:        &schema.Schema{Type: schema.TypeString},
-			Optional:    true,
-			Type:        schema.TypeList,
+			Description: "A list of deployment target IDs (e.g. `Machines-1`) that are scoped to this variable value.",
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+			Optional: true,
+			Type:     schema.TypeSet,
+		},
+		"processes": {
+			Description: "A list of processes that are scoped to this variable value: a project ID for the deployment process of that project, or a runbook ID for the process of that runbook.",
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+			Optional: true,
+			Type:     schema.TypeSet,
 		},
 		"roles": {
 			Description: "A list of roles that are scoped to this variable value.",
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Optional:    true,
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 		},
 		"tenant_tags": {
 			Description: "A list of tenant tags that are scoped to this variable value.",
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Optional:    true,
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 		},
 	}
 }
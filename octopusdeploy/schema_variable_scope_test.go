@@ -68,3 +68,34 @@ func TestFlattenVariableScope(t *testing.T) {
 	assert.Len(t, flattenedVariableScope, 1)
 	assert.Len(t, flattenedVariableScope[0], 1)
 }
+
+func TestExpandVariableScopeMachines(t *testing.T) {
+	flattenedVariableScope := []interface{}{map[string]interface{}{
+		"environments": []interface{}{"Environments-1"},
+		"machines":     []interface{}{"Machines-1", "Machines-2"},
+	}}
+
+	scope := expandVariableScope(flattenedVariableScope)
+	assert.Equal(t, []string{"Machines-1", "Machines-2"}, scope.Machines)
+	assert.Equal(t, []string{"Environments-1"}, scope.Environments)
+
+	flattenedVariableScope = flattenVariableScope(scope)
+	assert.Equal(t, []string{"Machines-1", "Machines-2"}, flattenedVariableScope[0].(map[string]interface{})["machines"])
+}
+
+func TestExpandVariableScopeProcesses(t *testing.T) {
+	flattenedVariableScope := []interface{}{map[string]interface{}{
+		"actions":   []interface{}{"Actions-1"},
+		"processes": []interface{}{"Projects-1", "Runbooks-1"},
+	}}
+
+	scope := expandVariableScope(flattenedVariableScope)
+	assert.False(t, scope.IsEmpty())
+	assert.Equal(t, []string{"Projects-1", "Runbooks-1"}, scope.ProcessOwners)
+	assert.Equal(t, []string{"Actions-1"}, scope.Actions)
+
+	flattenedVariableScope = flattenVariableScope(scope)
+	assert.Equal(t, []string{"Projects-1", "Runbooks-1"}, flattenedVariableScope[0].(map[string]interface{})["processes"])
+
+	assert.Nil(t, flattenVariableScope(variables.VariableScope{}))
+}
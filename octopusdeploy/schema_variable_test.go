@@ -0,0 +1,65 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSensitiveValueFromConfig(t *testing.T) {
+	_, ok := getSensitiveValueFromConfig(cty.NullVal(cty.DynamicPseudoType))
+	require.False(t, ok)
+
+	sensitiveValue, ok := getSensitiveValueFromConfig(cty.ObjectVal(map[string]cty.Value{
+		"sensitive_value": cty.StringVal("secret"),
+	}))
+	require.True(t, ok)
+	require.Equal(t, "secret", sensitiveValue)
+
+	sensitiveValue, ok = getSensitiveValueFromConfig(cty.ObjectVal(map[string]cty.Value{
+		"sensitive_value": cty.NullVal(cty.String),
+	}))
+	require.True(t, ok)
+	require.Empty(t, sensitiveValue)
+}
+
+func TestSuppressVersionedSensitiveValueDiff(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getVariableSchema(), map[string]interface{}{
+		"is_sensitive":            true,
+		"name":                    "Secret",
+		"sensitive_value":         "secret",
+		"sensitive_value_version": "1",
+		"type":                    "Sensitive",
+	})
+	require.True(t, isSensitiveValueVersioned(d))
+	require.True(t, suppressVersionedSensitiveValueDiff("sensitive_value", "", "secret", d))
+
+	// the value is not kept once its version is set
+	require.Empty(t, d.Get("sensitive_value"))
+
+	d = schema.TestResourceDataRaw(t, getVariableSchema(), map[string]interface{}{
+		"is_sensitive":    true,
+		"name":            "Secret",
+		"sensitive_value": "secret",
+		"type":            "Sensitive",
+	})
+	require.False(t, isSensitiveValueVersioned(d))
+	require.False(t, suppressVersionedSensitiveValueDiff("sensitive_value", "", "secret", d))
+	require.Equal(t, "secret", expandVariable(d).Value)
+}
+
+func TestValidateVariableValue(t *testing.T) {
+	require.NoError(t, validateVariableValue("String", "anything"))
+	require.NoError(t, validateVariableValue("AzureAccount", ""))
+	require.NoError(t, validateVariableValue("AmazonWebServicesAccount", "Accounts-1"))
+	require.NoError(t, validateVariableValue("AzureAccount", "Accounts-12"))
+	require.NoError(t, validateVariableValue("GoogleCloudAccount", "Accounts-123"))
+	require.NoError(t, validateVariableValue("Certificate", "Certificates-1"))
+	require.NoError(t, validateVariableValue("WorkerPool", "WorkerPools-1"))
+
+	require.Error(t, validateVariableValue("AzureAccount", "my-account"))
+	require.Error(t, validateVariableValue("Certificate", "Accounts-1"))
+	require.Error(t, validateVariableValue("WorkerPool", "WorkerPools-"))
+}
@@ -0,0 +1,210 @@
+package octopusdeploy
+
+import (
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// expandVariables builds the variables of a variable set from their HCL representation. Variables that have not yet
+// been created are given a new ID, which is also written back to their HCL representation so that they can be tracked
+// once the variable set is saved.
+func expandVariables(flattenedVariables []interface{}) []*variables.Variable {
+	expandedVariables := []*variables.Variable{}
+	for _, flattenedVariable := range flattenedVariables {
+		if flattenedVariable == nil {
+			continue
+		}
+
+		variableMap := flattenedVariable.(map[string]interface{})
+
+		variable := variables.NewVariable(variableMap["name"].(string))
+		variable.Description = variableMap["description"].(string)
+		variable.IsEditable = variableMap["is_editable"].(bool)
+		variable.IsSensitive = variableMap["is_sensitive"].(bool)
+		variable.Prompt = expandPromptedVariableSettings(variableMap["prompt"])
+		variable.Scope = expandVariableScope(variableMap["scope"])
+		variable.Type = variableMap["type"].(string)
+
+		if variable.IsSensitive {
+			variable.Type = "Sensitive"
+			variable.Value = variableMap["sensitive_value"].(string)
+		} else {
+			variable.Value = variableMap["value"].(string)
+		}
+
+		variable.ID = variableMap["id"].(string)
+		if len(variable.ID) == 0 {
+			variable.ID = uuid.New().String()
+			variableMap["id"] = variable.ID
+		}
+
+		expandedVariables = append(expandedVariables, variable)
+	}
+
+	return expandedVariables
+}
+
+// setConfiguredSensitiveValues replaces the sensitive values of the variables whose versions are set with those in
+// the configuration, since they are not stored in state.
+func setConfiguredSensitiveValues(d *schema.ResourceData, flattenedVariables []interface{}) {
+	for i, flattenedVariable := range flattenedVariables {
+		if flattenedVariable == nil {
+			continue
+		}
+
+		key := fmt.Sprintf("variable.%d.sensitive_value", i)
+		if isVersionedSecret(d, key) {
+			flattenedVariable.(map[string]interface{})["sensitive_value"] = getSensitiveString(d, key)
+		}
+	}
+}
+
+// flattenVariables serializes the variables of a variable set into their HCL representation. Variables keep the order
+// of the previous state so that reordering by Octopus does not produce a diff, and sensitive values (which Octopus
+// never returns) are carried over from the previous state unless their versions are set.
+func flattenVariables(variableSet []*variables.Variable, previousVariables []interface{}) []interface{} {
+	variablesByID := map[string]*variables.Variable{}
+	for _, variable := range variableSet {
+		variablesByID[variable.ID] = variable
+	}
+
+	sensitiveValues := map[string]string{}
+	sensitiveValueVersions := map[string]string{}
+	orderedVariables := []*variables.Variable{}
+	for _, previousVariable := range previousVariables {
+		if previousVariable == nil {
+			continue
+		}
+
+		id := previousVariable.(map[string]interface{})["id"].(string)
+		sensitiveValues[id], _ = previousVariable.(map[string]interface{})["sensitive_value"].(string)
+		sensitiveValueVersions[id], _ = previousVariable.(map[string]interface{})["sensitive_value_version"].(string)
+		if variable, ok := variablesByID[id]; ok {
+			orderedVariables = append(orderedVariables, variable)
+			delete(variablesByID, id)
+		}
+	}
+
+	for _, variable := range variableSet {
+		if _, ok := variablesByID[variable.ID]; ok {
+			orderedVariables = append(orderedVariables, variable)
+		}
+	}
+
+	flattenedVariables := []interface{}{}
+	for _, variable := range orderedVariables {
+		flattenedVariable := map[string]interface{}{
+			"description":             variable.Description,
+			"id":                      variable.ID,
+			"is_editable":             variable.IsEditable,
+			"is_sensitive":            variable.IsSensitive,
+			"name":                    variable.Name,
+			"prompt":                  flattenPromptedVariableSettings(variable.Prompt),
+			"scope":                   flattenVariableScope(variable.Scope),
+			"sensitive_value_version": sensitiveValueVersions[variable.ID],
+			"type":                    variable.Type,
+		}
+
+		if variable.IsSensitive {
+			if len(sensitiveValueVersions[variable.ID]) == 0 {
+				flattenedVariable["sensitive_value"] = sensitiveValues[variable.ID]
+			}
+		} else {
+			flattenedVariable["value"] = variable.Value
+		}
+
+		flattenedVariables = append(flattenedVariables, flattenedVariable)
+	}
+
+	return flattenedVariables
+}
+
+func getVariablesSchema() map[string]*schema.Schema {
+	variableSchema := getVariableSchema()
+
+	variableElemSchema := map[string]*schema.Schema{
+		"description": getDescriptionSchema("variable"),
+		"id": {
+			Computed:    true,
+			Description: "The unique ID of this variable.",
+			Type:        schema.TypeString,
+		},
+		"is_editable":  variableSchema["is_editable"],
+		"is_sensitive": getIsSensitiveSchema(),
+		"name":         getNameSchema(true),
+		"prompt":       variableSchema["prompt"],
+		"scope":        variableSchema["scope"],
+		"sensitive_value": {
+			Description: "The value of a sensitive variable. Octopus does not return sensitive values, so changes made outside of Terraform are not detected.",
+			Optional:    true,
+			Sensitive:   true,
+			Type:        schema.TypeString,
+		},
+		"type": getVariableTypeSchema(),
+		"value": {
+			Description: "The value of a variable that is not sensitive. For account, certificate and worker pool variables this is the ID of the referenced resource.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+	}
+
+	addSecretVersions(variableElemSchema, "sensitive_value")
+
+	return map[string]*schema.Schema{
+		"id": getIDSchema(),
+		"owner_id": {
+			Description:      "The ID of the project or library variable set that owns the variables.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"space_id": {
+			Computed:    true,
+			Description: "The space ID associated with this variable set.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"variable": {
+			Description: "The variables of the variable set. Variables of the owner that are not listed are removed.",
+			Elem:        &schema.Resource{Schema: variableElemSchema},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+	}
+}
+
+func validateVariables(flattenedVariables []interface{}) error {
+	for _, flattenedVariable := range flattenedVariables {
+		if flattenedVariable == nil {
+			continue
+		}
+
+		variableMap := flattenedVariable.(map[string]interface{})
+		name := variableMap["name"].(string)
+		isSensitive := variableMap["is_sensitive"].(bool)
+		variableType := variableMap["type"].(string)
+
+		if isSensitive && variableType != "Sensitive" {
+			return fmt.Errorf("variable '%s': when is_sensitive is set to true, type needs to be 'Sensitive'", name)
+		}
+
+		if !isSensitive && variableType == "Sensitive" {
+			return fmt.Errorf("variable '%s': when type is set to 'Sensitive', is_sensitive needs to be true", name)
+		}
+
+		if err := validateVariableValue(variableType, variableMap["value"].(string)); err != nil {
+			return fmt.Errorf("variable '%s': %s", name, err)
+		}
+
+		if err := validatePromptedDisplaySettings(variableMap["prompt"]); err != nil {
+			return fmt.Errorf("variable '%s': %s", name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func testFlattenedVariable(id string, name string, isSensitive bool, value string) map[string]interface{} {
+	flattenedVariable := map[string]interface{}{
+		"description":             "",
+		"id":                      id,
+		"is_editable":             true,
+		"is_sensitive":            isSensitive,
+		"name":                    name,
+		"prompt":                  []interface{}{},
+		"scope":                   []interface{}{},
+		"sensitive_value":         "",
+		"sensitive_value_version": "",
+		"type":                    "String",
+		"value":                   "",
+	}
+
+	if isSensitive {
+		flattenedVariable["sensitive_value"] = value
+		flattenedVariable["type"] = "Sensitive"
+	} else {
+		flattenedVariable["value"] = value
+	}
+
+	return flattenedVariable
+}
+
+func TestExpandVariables(t *testing.T) {
+	flattenedVariables := []interface{}{
+		testFlattenedVariable("existing", "Existing", false, "plain"),
+		testFlattenedVariable("", "New", true, "secret"),
+		nil,
+	}
+
+	expandedVariables := expandVariables(flattenedVariables)
+	require.Len(t, expandedVariables, 2)
+	require.Equal(t, "existing", expandedVariables[0].ID)
+	require.Equal(t, "plain", expandedVariables[0].Value)
+	require.NotEmpty(t, expandedVariables[1].ID)
+	require.Equal(t, "secret", expandedVariables[1].Value)
+	require.Equal(t, "Sensitive", expandedVariables[1].Type)
+
+	// the generated ID is written back so that the sensitive value can be carried over once saved
+	require.Equal(t, expandedVariables[1].ID, flattenedVariables[1].(map[string]interface{})["id"])
+}
+
+func TestFlattenVariables(t *testing.T) {
+	first := variables.NewVariable("First")
+	first.ID = "first"
+	first.Value = "1"
+
+	second := variables.NewVariable("Second")
+	second.ID = "second"
+	second.IsSensitive = true
+	second.Type = "Sensitive"
+
+	third := variables.NewVariable("Third")
+	third.ID = "third"
+
+	previousVariables := []interface{}{
+		testFlattenedVariable("second", "Second", true, "secret"),
+		testFlattenedVariable("first", "First", false, "1"),
+		testFlattenedVariable("removed", "Removed", false, ""),
+	}
+
+	flattenedVariables := flattenVariables([]*variables.Variable{first, second, third}, previousVariables)
+	require.Len(t, flattenedVariables, 3)
+	require.Equal(t, "second", flattenedVariables[0].(map[string]interface{})["id"])
+	require.Equal(t, "secret", flattenedVariables[0].(map[string]interface{})["sensitive_value"])
+	require.NotContains(t, flattenedVariables[0], "value")
+	require.Equal(t, "first", flattenedVariables[1].(map[string]interface{})["id"])
+	require.Equal(t, "1", flattenedVariables[1].(map[string]interface{})["value"])
+	require.Equal(t, "third", flattenedVariables[2].(map[string]interface{})["id"])
+
+	require.Empty(t, flattenVariables(nil, previousVariables))
+}
+
+func TestFlattenVersionedSensitiveValues(t *testing.T) {
+	secret := variables.NewVariable("Secret")
+	secret.ID = "secret"
+	secret.IsSensitive = true
+	secret.Type = "Sensitive"
+
+	previousVariable := testFlattenedVariable("secret", "Secret", true, "secret")
+	previousVariable["sensitive_value_version"] = "1"
+
+	// a sensitive value is not carried over once its version is set, but the version is
+	flattenedVariables := flattenVariables([]*variables.Variable{secret}, []interface{}{previousVariable})
+	require.Len(t, flattenedVariables, 1)
+	require.NotContains(t, flattenedVariables[0], "sensitive_value")
+	require.Equal(t, "1", flattenedVariables[0].(map[string]interface{})["sensitive_value_version"])
+}
+
+func TestSetConfiguredSensitiveValues(t *testing.T) {
+	d := resourceVariables().Data(&terraform.InstanceState{
+		ID: "Projects-1",
+		Attributes: map[string]string{
+			"owner_id":                           "Projects-1",
+			"variable.#":                         "2",
+			"variable.0.is_sensitive":            "true",
+			"variable.0.name":                    "Versioned",
+			"variable.0.sensitive_value_version": "1",
+			"variable.0.type":                    "Sensitive",
+			"variable.1.is_sensitive":            "true",
+			"variable.1.name":                    "Kept",
+			"variable.1.sensitive_value":         "kept",
+			"variable.1.type":                    "Sensitive",
+		},
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"variable": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{"sensitive_value": cty.StringVal("configured")}),
+				cty.ObjectVal(map[string]cty.Value{"sensitive_value": cty.StringVal("ignored")}),
+			}),
+		}),
+	})
+
+	// only the sensitive values whose versions are set are read from the configuration
+	flattenedVariables := d.Get("variable").([]interface{})
+	setConfiguredSensitiveValues(d, flattenedVariables)
+	require.Equal(t, "configured", flattenedVariables[0].(map[string]interface{})["sensitive_value"])
+	require.Equal(t, "kept", flattenedVariables[1].(map[string]interface{})["sensitive_value"])
+	require.True(t, suppressVersionedSecretDiff("variable.0.sensitive_value", "", "configured", d))
+	require.False(t, suppressVersionedSecretDiff("variable.1.sensitive_value", "kept", "changed", d))
+}
+
+func TestValidateVariables(t *testing.T) {
+	require.NoError(t, validateVariables([]interface{}{testFlattenedVariable("", "Plain", false, "value"), nil}))
+	require.NoError(t, validateVariables([]interface{}{testFlattenedVariable("", "Secret", true, "value")}))
+
+	invalidVariable := testFlattenedVariable("", "Invalid", true, "value")
+	invalidVariable["type"] = "String"
+	require.Error(t, validateVariables([]interface{}{invalidVariable}))
+}
@@ -18,6 +18,9 @@ func expandVersionControlSettingsForProjectConversion(ctx context.Context, d *sc
 	if v, ok := d.GetOk("git_anonymous_persistence_settings"); ok {
 		persistenceSettings = expandGitPersistenceSettings(ctx, v, expandAnonymousGitCredential)
 	}
+	if v, ok := d.GetOk("git_github_persistence_settings"); ok {
+		persistenceSettings = expandGitPersistenceSettings(ctx, v, expandGitHubGitCredential)
+	}
 
 	return persistenceSettings
 }
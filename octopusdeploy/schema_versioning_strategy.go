@@ -1,7 +1,11 @@
 package octopusdeploy
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -39,21 +43,76 @@ func flattenVersioningStrategy(versioningStrategy *projects.VersioningStrategy)
 func getVersionStrategySchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"donor_package": {
-			Computed: true,
-			Elem:     &schema.Resource{Schema: getDeploymentActionPackageSchema()},
-			MaxItems: 1,
-			Optional: true,
-			Type:     schema.TypeList,
+			Computed:    true,
+			Description: "The package whose version is used as the release number. Conflicts with `template`.",
+			Elem:        &schema.Resource{Schema: getDeploymentActionPackageSchema()},
+			MaxItems:    1,
+			Optional:    true,
+			Type:        schema.TypeList,
 		},
 		"donor_package_step_id": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the step whose package version is used as the release number. Superseded by `donor_package`.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 		"template": {
-			Computed: true,
-			Optional: true,
-			Type:     schema.TypeString,
+			Computed:    true,
+			Description: "The template used to generate release numbers, e.g. `#{Octopus.Version.LastMajor}.#{Octopus.Version.LastMinor}.#{Octopus.Version.NextPatch}`. Conflicts with `donor_package`.",
+			Optional:    true,
+			Type:        schema.TypeString,
 		},
 	}
 }
+
+// validateVersioningStrategy ensures that a configured versioning strategy uses either a template or a donor package,
+// as Octopus ignores the template whenever a donor package is set. Only the configuration is inspected since Octopus
+// may return both once a donor package is in use.
+func validateVersioningStrategy(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() {
+		return nil
+	}
+
+	return validateVersioningStrategyConfig(rawConfig.GetAttr("versioning_strategy"))
+}
+
+func validateVersioningStrategyConfig(versioningStrategies cty.Value) error {
+	if versioningStrategies.IsNull() || !versioningStrategies.IsKnown() {
+		return nil
+	}
+
+	for _, versioningStrategy := range versioningStrategies.AsValueSlice() {
+		if !versioningStrategy.IsKnown() {
+			continue
+		}
+
+		if !versioningStrategy.GetAttr("donor_package").IsKnown() || !versioningStrategy.GetAttr("donor_package_step_id").IsKnown() || !versioningStrategy.GetAttr("template").IsKnown() {
+			continue
+		}
+
+		isSet := func(name string) bool {
+			v := versioningStrategy.GetAttr(name)
+			if v.IsNull() {
+				return false
+			}
+			if v.Type() == cty.String {
+				return len(v.AsString()) > 0
+			}
+			return v.LengthInt() > 0
+		}
+
+		hasDonorPackage := isSet("donor_package") || isSet("donor_package_step_id")
+		hasTemplate := isSet("template")
+
+		if hasDonorPackage && hasTemplate {
+			return fmt.Errorf("versioning_strategy must set either template or donor_package, not both")
+		}
+
+		if !hasDonorPackage && !hasTemplate {
+			return fmt.Errorf("versioning_strategy must set either template or donor_package")
+		}
+	}
+
+	return nil
+}
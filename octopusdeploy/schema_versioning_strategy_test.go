@@ -0,0 +1,35 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateVersioningStrategyConfig(t *testing.T) {
+	donorPackageType := cty.List(cty.Object(map[string]cty.Type{
+		"deployment_action": cty.String,
+		"package_reference": cty.String,
+	}))
+
+	versioningStrategy := func(template cty.Value, donorPackage cty.Value) cty.Value {
+		return cty.SetVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"donor_package":         donorPackage,
+			"donor_package_step_id": cty.NullVal(cty.String),
+			"template":              template,
+		})})
+	}
+
+	donorPackage := cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+		"deployment_action": cty.StringVal("Deploy Web App"),
+		"package_reference": cty.StringVal(""),
+	})})
+
+	require.NoError(t, validateVersioningStrategyConfig(cty.NullVal(cty.Set(cty.DynamicPseudoType))))
+	require.NoError(t, validateVersioningStrategyConfig(versioningStrategy(cty.StringVal("1.0.#{Octopus.Version.NextPatch}"), cty.NullVal(donorPackageType))))
+	require.NoError(t, validateVersioningStrategyConfig(versioningStrategy(cty.NullVal(cty.String), donorPackage)))
+	require.NoError(t, validateVersioningStrategyConfig(versioningStrategy(cty.UnknownVal(cty.String), donorPackage)))
+	require.Error(t, validateVersioningStrategyConfig(versioningStrategy(cty.StringVal("1.0.i"), donorPackage)))
+	require.Error(t, validateVersioningStrategyConfig(versioningStrategy(cty.NullVal(cty.String), cty.NullVal(donorPackageType))))
+}
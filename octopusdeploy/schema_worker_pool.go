@@ -33,7 +33,8 @@ func getWorkerPoolDataSchema() map[string]*schema.Schema {
 		"name":         getQueryName(),
 		"partial_name": getQueryPartialName(),
 		"skip":         getQuerySkip(),
-		"take":         getQueryTake(),
+		"space_id":     getQuerySpaceID(),
+		"take":         getQueryTakeAll(),
 		"worker_pools": {
 			Computed:    true,
 			Description: "A list of worker pools that match the filter(s).",
@@ -0,0 +1,98 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// addSecretVersions adds a version attribute for each of the given sensitive attributes. When its version is
+// set, a sensitive attribute is kept out of state and is sent to Octopus whenever the resource is created or updated.
+func addSecretVersions(attributes map[string]*schema.Schema, keys ...string) {
+	for _, key := range keys {
+		attributes[key].DiffSuppressFunc = suppressVersionedSecretDiff
+
+		attributes[key+"_version"] = &schema.Schema{
+			Description: fmt.Sprintf("An arbitrary value (e.g. the version of the secret in a vault) that, when set, keeps `%s` out of state. `%s` is still read from the configuration and is stored in saved plans. Change it to send a rotated `%s` to Octopus.", key, key, key),
+			Optional:    true,
+			Type:        schema.TypeString,
+		}
+	}
+}
+
+// isVersionedSecret reports whether the sensitive attribute is kept out of state, which is the case whenever its version is
+// configured.
+func isVersionedSecret(d *schema.ResourceData, key string) bool {
+	version, ok := d.Get(key + "_version").(string)
+	return ok && len(version) > 0
+}
+
+// getSensitiveString returns the value of a sensitive attribute. The value is read from the configuration rather than
+// from state when its version is set, since it is not stored in state.
+func getSensitiveString(d *schema.ResourceData, key string) string {
+	if isVersionedSecret(d, key) {
+		if value, ok := getStringFromConfig(d.GetRawConfig(), key); ok {
+			return value
+		}
+	}
+	return d.Get(key).(string)
+}
+
+// clearVersionedSecrets removes the given sensitive attributes from state when their versions are set. It is called
+// whenever the state of a resource is set, so that a secret stored before its version was configured is removed too.
+func clearVersionedSecrets(d *schema.ResourceData, keys ...string) {
+	for _, key := range keys {
+		if isVersionedSecret(d, key) {
+			d.Set(key, nil)
+		}
+	}
+}
+
+// expandKeptSensitiveValue returns the configured value of a sensitive attribute, or a sensitive value without a new
+// value, which tells Octopus to keep the value it already holds, when the attribute is omitted.
+func expandKeptSensitiveValue(d *schema.ResourceData, key string) *core.SensitiveValue {
+	if value := getSensitiveString(d, key); len(value) > 0 {
+		return core.NewSensitiveValue(value)
+	}
+
+	return &core.SensitiveValue{HasValue: true}
+}
+
+// getStringFromConfig returns the string at the given path of the configuration, such as `password` or
+// `variable.0.sensitive_value`. It reports false when the path is not in the configuration.
+func getStringFromConfig(rawConfig cty.Value, key string) (string, bool) {
+	value := rawConfig
+	for _, step := range strings.Split(key, ".") {
+		if value.IsNull() || !value.IsKnown() {
+			return "", false
+		}
+
+		if index, err := strconv.Atoi(step); err == nil {
+			if !value.Type().IsListType() || value.LengthInt() <= index {
+				return "", false
+			}
+			value = value.Index(cty.NumberIntVal(int64(index)))
+			continue
+		}
+
+		if !value.Type().IsObjectType() || !value.Type().HasAttribute(step) {
+			return "", false
+		}
+		value = value.GetAttr(step)
+	}
+
+	if value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.String) {
+		return "", true
+	}
+
+	return value.AsString(), true
+}
+
+// suppressVersionedSecretDiff hides changes to a sensitive attribute that is not stored in state.
+func suppressVersionedSecretDiff(k, old, new string, d *schema.ResourceData) bool {
+	return isVersionedSecret(d, k)
+}
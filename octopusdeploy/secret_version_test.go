@@ -0,0 +1,154 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/accounts"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddSecretVersions(t *testing.T) {
+	attributes := map[string]*schema.Schema{
+		"password": getPasswordSchema(true),
+	}
+	addSecretVersions(attributes, "password")
+
+	require.Contains(t, attributes, "password_version")
+	require.True(t, attributes["password_version"].Optional)
+	require.NotNil(t, attributes["password"].DiffSuppressFunc)
+}
+
+func TestGetStringFromConfig(t *testing.T) {
+	_, ok := getStringFromConfig(cty.NullVal(cty.DynamicPseudoType), "token")
+	require.False(t, ok)
+
+	_, ok = getStringFromConfig(cty.ObjectVal(map[string]cty.Value{
+		"name": cty.StringVal("Token"),
+	}), "token")
+	require.False(t, ok)
+
+	value, ok := getStringFromConfig(cty.ObjectVal(map[string]cty.Value{
+		"token": cty.StringVal("secret"),
+	}), "token")
+	require.True(t, ok)
+	require.Equal(t, "secret", value)
+
+	value, ok = getStringFromConfig(cty.ObjectVal(map[string]cty.Value{
+		"token": cty.NullVal(cty.String),
+	}), "token")
+	require.True(t, ok)
+	require.Empty(t, value)
+
+	nestedConfig := cty.ObjectVal(map[string]cty.Value{
+		"settings": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{"password": cty.StringVal("secret")}),
+		}),
+	})
+
+	value, ok = getStringFromConfig(nestedConfig, "settings.0.password")
+	require.True(t, ok)
+	require.Equal(t, "secret", value)
+
+	_, ok = getStringFromConfig(nestedConfig, "settings.1.password")
+	require.False(t, ok)
+
+	_, ok = getStringFromConfig(nestedConfig, "settings.password")
+	require.False(t, ok)
+}
+
+func TestSuppressVersionedSecretDiff(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getTokenAccountSchema(), map[string]interface{}{
+		"name":          "Token",
+		"token":         "secret",
+		"token_version": "1",
+	})
+	require.True(t, isVersionedSecret(d, "token"))
+	require.True(t, suppressVersionedSecretDiff("token", "", "secret", d))
+
+	// the value is not kept once its version is set
+	require.Empty(t, d.Get("token"))
+
+	d = schema.TestResourceDataRaw(t, getTokenAccountSchema(), map[string]interface{}{
+		"name":  "Token",
+		"token": "secret",
+	})
+	require.False(t, isVersionedSecret(d, "token"))
+	require.False(t, suppressVersionedSecretDiff("token", "", "secret", d))
+	require.Equal(t, "secret", getSensitiveString(d, "token"))
+
+	// attributes without a version attribute are always kept in state
+	d = schema.TestResourceDataRaw(t, getDeploymentTargetSchema(), map[string]interface{}{})
+	require.False(t, isVersionedSecret(d, "name"))
+}
+
+func TestClearVersionedSecrets(t *testing.T) {
+	account, err := accounts.NewTokenAccount("Token", core.NewSensitiveValue("secret"))
+	require.NoError(t, err)
+	account.ID = "Accounts-1"
+
+	// a secret stored before its version was configured is removed from state when the state is set
+	d := resourceTokenAccount().Data(&terraform.InstanceState{
+		ID: "Accounts-1",
+		Attributes: map[string]string{
+			"name":          "Token",
+			"token":         "secret",
+			"token_version": "1",
+		},
+	})
+	require.NoError(t, setTokenAccount(context.Background(), d, account))
+	require.Empty(t, d.State().Attributes["token"])
+	require.Equal(t, "1", d.State().Attributes["token_version"])
+
+	// a secret without a version is kept in state
+	d = resourceTokenAccount().Data(&terraform.InstanceState{
+		ID: "Accounts-1",
+		Attributes: map[string]string{
+			"name":  "Token",
+			"token": "secret",
+		},
+	})
+	require.NoError(t, setTokenAccount(context.Background(), d, account))
+	require.Equal(t, "secret", d.State().Attributes["token"])
+
+	// only the secrets of a resource whose versions are set are removed
+	d = resourceSSHKeyAccount().Data(&terraform.InstanceState{
+		ID: "Accounts-2",
+		Attributes: map[string]string{
+			"name":                           "SSH",
+			"private_key_file":               "key",
+			"private_key_passphrase":         "passphrase",
+			"private_key_passphrase_version": "1",
+			"username":                       "octopus",
+		},
+	})
+	sshKeyAccount, err := accounts.NewSSHKeyAccount("SSH", "octopus", core.NewSensitiveValue("key"))
+	require.NoError(t, err)
+	require.NoError(t, setSSHKeyAccount(context.Background(), d, sshKeyAccount))
+	require.Equal(t, "key", d.State().Attributes["private_key_file"])
+	require.Empty(t, d.State().Attributes["private_key_passphrase"])
+}
+
+func TestExpandSSHKeyAccount(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getSSHKeyAccountSchema(), map[string]interface{}{
+		"name":                   "SSH",
+		"private_key_file":       "key",
+		"private_key_passphrase": "passphrase",
+		"username":               "octopus",
+	})
+
+	account := expandSSHKeyAccount(d)
+	require.Equal(t, core.NewSensitiveValue("key"), account.PrivateKeyFile)
+	require.Equal(t, core.NewSensitiveValue("passphrase"), account.PrivateKeyPassphrase)
+
+	d = schema.TestResourceDataRaw(t, getSSHKeyAccountSchema(), map[string]interface{}{
+		"name":             "SSH",
+		"private_key_file": "key",
+		"username":         "octopus",
+	})
+	require.Nil(t, expandSSHKeyAccount(d).PrivateKeyPassphrase)
+}
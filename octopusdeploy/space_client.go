@@ -0,0 +1,74 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+)
+
+// spaceClients holds the clients of the spaces other than the one that the provider is scoped to that resources and
+// data sources have targeted.
+type spaceClients struct {
+	clients map[string]*client.Client
+	mutex   sync.Mutex
+}
+
+// spaceIDGetter is satisfied by both schema.ResourceData and schema.ResourceDiff, so that the client for the space of
+// a resource can also be found while its changes are planned.
+type spaceIDGetter interface {
+	GetOk(key string) (interface{}, bool)
+}
+
+// getSpaceClient returns the client for the space given by the space_id of a resource or data source. This is the
+// client of the provider unless space_id is set to a space other than the one that the provider is scoped to.
+func getSpaceClient(m interface{}, d spaceIDGetter) (*client.Client, error) {
+	meta := m.(*providerMeta)
+	octopus := meta.client
+
+	spaceID, ok := d.GetOk("space_id")
+	if !ok {
+		return octopus, nil
+	}
+
+	httpSession := octopus.HttpSession()
+	apiURL, providerSpaceID := splitClientBaseURL(httpSession.BaseURL)
+	if spaceID.(string) == providerSpaceID {
+		return octopus, nil
+	}
+
+	clients := meta.spaceClients
+	clients.mutex.Lock()
+	defer clients.mutex.Unlock()
+
+	if spaceClient, ok := clients.clients[spaceID.(string)]; ok {
+		return spaceClient, nil
+	}
+
+	spaceClient, err := client.NewClient(httpSession.HttpClient, apiURL, httpSession.DefaultHeaders[constants.ClientAPIKeyHTTPHeader], spaceID.(string))
+	if err != nil {
+		return nil, fmt.Errorf("error creating a client for the space %s: %s", spaceID, err)
+	}
+
+	clients.clients[spaceID.(string)] = spaceClient
+	return spaceClient, nil
+}
+
+// splitClientBaseURL splits the base URL of a client, such as https://octopus.example.com/api/Spaces-1, into the
+// address of the Octopus Server and the ID of the space that the client is scoped to, if any.
+func splitClientBaseURL(baseURL *url.URL) (*url.URL, string) {
+	apiURL := *baseURL
+	segments := strings.Split(strings.TrimRight(apiURL.Path, "/"), "/")
+
+	spaceID := ""
+	if len(segments) > 1 && segments[len(segments)-2] == "api" {
+		spaceID = segments[len(segments)-1]
+		segments = segments[:len(segments)-1]
+	}
+
+	apiURL.Path = strings.Join(segments[:len(segments)-1], "/")
+	return &apiURL, spaceID
+}
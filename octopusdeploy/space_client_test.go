@@ -0,0 +1,26 @@
+package octopusdeploy
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitClientBaseURL(t *testing.T) {
+	for baseURL, expected := range map[string][]string{
+		"https://octopus.example.com/api":                {"https://octopus.example.com", ""},
+		"https://octopus.example.com/api/Spaces-1":       {"https://octopus.example.com", "Spaces-1"},
+		"https://example.com/octopus/api/Spaces-22":      {"https://example.com/octopus", "Spaces-22"},
+		"https://example.com/api/octopus/api":            {"https://example.com/api/octopus", ""},
+		"https://example.com/api/octopus/api/Spaces-3":   {"https://example.com/api/octopus", "Spaces-3"},
+		"https://octopus.example.com:8443/api/Spaces-1/": {"https://octopus.example.com:8443", "Spaces-1"},
+	} {
+		parsedURL, err := url.Parse(baseURL)
+		require.NoError(t, err)
+
+		apiURL, spaceID := splitClientBaseURL(parsedURL)
+		require.Equal(t, expected[0], apiURL.String(), baseURL)
+		require.Equal(t, expected[1], spaceID, baseURL)
+	}
+}
@@ -0,0 +1,106 @@
+package octopusdeploy
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// getListToSetStateUpgrader returns the state upgrader from the given schema version of a resource in which the lists
+// of strings at the given paths were changed to sets. A path names the attributes of nested blocks separated by dots,
+// where * matches any nested block (e.g. "step.*.environments"). The schema of the earlier version is the given
+// schema with these sets as lists, which is how state written by that version is read.
+//
+// Lists and sets of strings are both stored in state as arrays, so their values are kept apart from duplicates, which a
+// set cannot hold.
+func getListToSetStateUpgrader(version int, attributes map[string]*schema.Schema, paths ...string) schema.StateUpgrader {
+	priorAttributes := attributes
+	for _, path := range paths {
+		priorAttributes = getListSchemaForSet(priorAttributes, strings.Split(path, "."))
+	}
+
+	return schema.StateUpgrader{
+		Type: (&schema.Resource{Schema: priorAttributes}).CoreConfigSchema().ImpliedType(),
+		Upgrade: func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+			for _, path := range paths {
+				removeDuplicateStateValues(rawState, strings.Split(path, "."))
+			}
+			return rawState, nil
+		},
+		Version: version,
+	}
+}
+
+// getListSchemaForSet returns a copy of the attributes in which the sets at the given path are lists. The attributes
+// themselves are not changed.
+func getListSchemaForSet(attributes map[string]*schema.Schema, path []string) map[string]*schema.Schema {
+	listAttributes := make(map[string]*schema.Schema, len(attributes))
+	for key, attribute := range attributes {
+		listAttributes[key] = attribute
+	}
+
+	for key, attribute := range attributes {
+		if path[0] != "*" && path[0] != key {
+			continue
+		}
+
+		if len(path) == 1 {
+			if attribute.Type == schema.TypeSet {
+				listAttribute := *attribute
+				listAttribute.Set = nil
+				listAttribute.Type = schema.TypeList
+				listAttributes[key] = &listAttribute
+			}
+			continue
+		}
+
+		if elem, ok := attribute.Elem.(*schema.Resource); ok {
+			listAttribute := *attribute
+			listAttribute.Elem = &schema.Resource{Schema: getListSchemaForSet(elem.Schema, path[1:])}
+			listAttributes[key] = &listAttribute
+		}
+	}
+
+	return listAttributes
+}
+
+// removeDuplicateStateValues removes duplicate strings from the arrays at the given path of raw state.
+func removeDuplicateStateValues(rawState map[string]interface{}, path []string) {
+	for key, value := range rawState {
+		if path[0] != "*" && path[0] != key {
+			continue
+		}
+
+		values, ok := value.([]interface{})
+		if !ok {
+			continue
+		}
+
+		if len(path) == 1 {
+			rawState[key] = getUniqueStateValues(values)
+			continue
+		}
+
+		for _, block := range values {
+			if blockState, ok := block.(map[string]interface{}); ok {
+				removeDuplicateStateValues(blockState, path[1:])
+			}
+		}
+	}
+}
+
+func getUniqueStateValues(values []interface{}) []interface{} {
+	uniqueValues := []interface{}{}
+	seen := map[string]bool{}
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			if seen[s] {
+				continue
+			}
+			seen[s] = true
+		}
+		uniqueValues = append(uniqueValues, value)
+	}
+	return uniqueValues
+}
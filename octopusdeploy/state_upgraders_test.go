@@ -0,0 +1,150 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func getStateUpgraderTestSchema() map[string]*schema.Schema {
+	stringSet := func() *schema.Schema {
+		return &schema.Schema{Elem: &schema.Schema{Type: schema.TypeString}, Optional: true, Type: schema.TypeSet}
+	}
+
+	return map[string]*schema.Schema{
+		"name":  {Optional: true, Type: schema.TypeString},
+		"roles": stringSet(),
+		"step": {
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"action": {
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{"channels": stringSet(), "tags": stringSet()},
+						},
+						Optional: true,
+						Type:     schema.TypeList,
+					},
+					"run_script_action": {
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{"channels": stringSet(), "tags": stringSet()},
+						},
+						Optional: true,
+						Type:     schema.TypeList,
+					},
+				},
+			},
+			Optional: true,
+			Type:     schema.TypeList,
+		},
+		"tenants": stringSet(),
+	}
+}
+
+func TestGetListToSetStateUpgrader(t *testing.T) {
+	attributes := getStateUpgraderTestSchema()
+	upgrader := getListToSetStateUpgrader(0, attributes, "roles", "step.*.channels")
+
+	// the earlier version holds lists only at the given paths
+	require.Equal(t, 0, upgrader.Version)
+	require.True(t, upgrader.Type.AttributeType("roles").IsListType())
+	require.True(t, upgrader.Type.AttributeType("tenants").IsSetType())
+	for _, action := range []string{"action", "run_script_action"} {
+		actionType := upgrader.Type.AttributeType("step").ElementType().AttributeType(action).ElementType()
+		require.True(t, actionType.AttributeType("channels").IsListType())
+		require.True(t, actionType.AttributeType("tags").IsSetType())
+	}
+
+	// the schema of the current version is not changed
+	require.Equal(t, schema.TypeSet, attributes["roles"].Type)
+	require.Equal(t, schema.TypeSet, attributes["step"].Elem.(*schema.Resource).Schema["action"].Elem.(*schema.Resource).Schema["channels"].Type)
+
+	rawState, err := upgrader.Upgrade(context.Background(), map[string]interface{}{
+		"name":  "Web",
+		"roles": []interface{}{"web", "api", "web"},
+		"step": []interface{}{
+			map[string]interface{}{
+				"action": []interface{}{
+					map[string]interface{}{
+						"channels": []interface{}{"Channels-1", "Channels-1"},
+						"tags":     []interface{}{"a", "a"},
+					},
+				},
+				"run_script_action": []interface{}{},
+			},
+		},
+		"tenants": nil,
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"name":  "Web",
+		"roles": []interface{}{"web", "api"},
+		"step": []interface{}{
+			map[string]interface{}{
+				"action": []interface{}{
+					map[string]interface{}{
+						"channels": []interface{}{"Channels-1"},
+						"tags":     []interface{}{"a", "a"},
+					},
+				},
+				"run_script_action": []interface{}{},
+			},
+		},
+		"tenants": nil,
+	}, rawState)
+}
+
+func TestListToSetStateUpgrade(t *testing.T) {
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"octopusdeploy_test": {
+				Schema:        getStateUpgraderTestSchema(),
+				SchemaVersion: 1,
+				StateUpgraders: []schema.StateUpgrader{
+					getListToSetStateUpgrader(0, getStateUpgraderTestSchema(), "roles", "step.*.channels"),
+				},
+			},
+		},
+	}
+	require.NoError(t, provider.InternalValidate())
+
+	// state written when roles was a list is read as a set
+	response, err := schema.NewGRPCProviderServer(provider).UpgradeResourceState(context.Background(), &tfprotov5.UpgradeResourceStateRequest{
+		RawState: &tfprotov5.RawState{JSON: []byte(`{"id":"Tests-1","name":"Web","roles":["web","api","web"],"step":[{"action":[{"channels":["Channels-2","Channels-1"],"tags":[]}],"run_script_action":[]}],"tenants":["Tenants-1"]}`)},
+		TypeName: "octopusdeploy_test",
+		Version:  0,
+	})
+	require.NoError(t, err)
+	require.Empty(t, response.Diagnostics)
+
+	state, err := msgpack.Unmarshal(response.UpgradedState.MsgPack, provider.ResourcesMap["octopusdeploy_test"].CoreConfigSchema().ImpliedType())
+	require.NoError(t, err)
+	require.Equal(t, cty.SetVal([]cty.Value{cty.StringVal("api"), cty.StringVal("web")}), state.GetAttr("roles"))
+	require.Equal(t, cty.SetVal([]cty.Value{cty.StringVal("Channels-1"), cty.StringVal("Channels-2")}), state.GetAttr("step").Index(cty.NumberIntVal(0)).GetAttr("action").Index(cty.NumberIntVal(0)).GetAttr("channels"))
+	require.Equal(t, cty.SetVal([]cty.Value{cty.StringVal("Tenants-1")}), state.GetAttr("tenants"))
+}
+
+func TestLifecycleStateUpgrade(t *testing.T) {
+	provider := Provider()
+
+	// the environments of phases were lists in version 0
+	response, err := schema.NewGRPCProviderServer(provider).UpgradeResourceState(context.Background(), &tfprotov5.UpgradeResourceStateRequest{
+		RawState: &tfprotov5.RawState{JSON: []byte(`{"id":"Lifecycles-1","name":"Default","phase":[{"automatic_deployment_targets":["Environments-2","Environments-1"],"id":"Phases-1","is_optional_phase":false,"minimum_environments_before_promotion":0,"name":"Production","optional_deployment_targets":[]}]}`)},
+		TypeName: "octopusdeploy_lifecycle",
+		Version:  0,
+	})
+	require.NoError(t, err)
+	require.Empty(t, response.Diagnostics)
+
+	state, err := msgpack.Unmarshal(response.UpgradedState.MsgPack, provider.ResourcesMap["octopusdeploy_lifecycle"].CoreConfigSchema().ImpliedType())
+	require.NoError(t, err)
+
+	phase := state.GetAttr("phase").Index(cty.NumberIntVal(0))
+	require.Equal(t, cty.StringVal("Phases-1"), phase.GetAttr("id"))
+	require.Equal(t, cty.SetVal([]cty.Value{cty.StringVal("Environments-1"), cty.StringVal("Environments-2")}), phase.GetAttr("automatic_deployment_targets"))
+	require.Equal(t, cty.SetValEmpty(cty.String), phase.GetAttr("optional_deployment_targets"))
+}
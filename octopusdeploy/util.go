@@ -4,6 +4,7 @@ import (
 	"hash/crc32"
 	"log"
 	"strings"
+	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -91,6 +92,25 @@ func isEmpty(s string) bool {
 	return len(strings.TrimSpace(s)) == 0
 }
 
+// slugify converts a name into the URL-friendly slug Octopus derives from it: lower case, with every run of
+// characters other than letters and digits collapsed into a single hyphen
+func slugify(name string) string {
+	var builder strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if pendingHyphen && builder.Len() > 0 {
+				builder.WriteRune('-')
+			}
+			builder.WriteRune(r)
+			pendingHyphen = false
+			continue
+		}
+		pendingHyphen = true
+	}
+	return builder.String()
+}
+
 func logResource(name string, resource interface{}) {
 	log.Printf("[DEBUG] %s: %#v", name, resource)
 }
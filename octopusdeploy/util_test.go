@@ -24,3 +24,10 @@ func TestGetSliceFromTerraformTypeList(t *testing.T) {
 	slice = getSliceFromTerraformTypeList(errList)
 	require.Nil(t, slice)
 }
+
+func TestSlugify(t *testing.T) {
+	require.Equal(t, "", slugify(""))
+	require.Equal(t, "deploy-web-app", slugify("Deploy Web App"))
+	require.Equal(t, "run-a-script", slugify("  Run a Script! "))
+	require.Equal(t, "step-2-1-migrate-db", slugify("Step 2.1 - Migrate DB"))
+}
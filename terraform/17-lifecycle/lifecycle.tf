@@ -3,7 +3,7 @@ resource "octopusdeploy_lifecycle" "simple_lifecycle" {
   name        = "Simple"
 
   release_retention_policy {
-    quantity_to_keep    = 1
+    quantity_to_keep    = 0
     should_keep_forever = true
     unit                = "Days"
   }
@@ -20,7 +20,7 @@ resource "octopusdeploy_lifecycle" "simple_lifecycle" {
     name                         = octopusdeploy_environment.development_environment.name
 
     release_retention_policy {
-      quantity_to_keep    = 1
+      quantity_to_keep    = 0
       should_keep_forever = true
       unit                = "Days"
     }
@@ -38,7 +38,7 @@ resource "octopusdeploy_lifecycle" "simple_lifecycle" {
     name                         = octopusdeploy_environment.test_environment.name
 
     release_retention_policy {
-      quantity_to_keep    = 1
+      quantity_to_keep    = 0
       should_keep_forever = true
       unit                = "Days"
     }
@@ -56,7 +56,7 @@ resource "octopusdeploy_lifecycle" "simple_lifecycle" {
     name                         = octopusdeploy_environment.production_environment.name
 
     release_retention_policy {
-      quantity_to_keep    = 30
+      quantity_to_keep    = 0
       should_keep_forever = true
       unit                = "Days"
     }